@@ -64,444 +64,1818 @@ func (x *_GenesisState_3_list) IsValid() bool {
 	return x.list != nil
 }
 
-var (
-	md_GenesisState                protoreflect.MessageDescriptor
-	fd_GenesisState_params         protoreflect.FieldDescriptor
-	fd_GenesisState_standard_denom protoreflect.FieldDescriptor
-	fd_GenesisState_pool           protoreflect.FieldDescriptor
-	fd_GenesisState_sequence       protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
 
-func init() {
-	file_canto_coinswap_v1_genesis_proto_init()
-	md_GenesisState = File_canto_coinswap_v1_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
-	fd_GenesisState_standard_denom = md_GenesisState.Fields().ByName("standard_denom")
-	fd_GenesisState_pool = md_GenesisState.Fields().ByName("pool")
-	fd_GenesisState_sequence = md_GenesisState.Fields().ByName("sequence")
+type _GenesisState_5_list struct {
+	list *[]*ProtocolFee
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_GenesisState GenesisState
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProtocolFee)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_canto_coinswap_v1_genesis_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProtocolFee)
+	*x.list = append(*x.list, concreteValue)
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(ProtocolFee)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-type fastReflection_GenesisState_messageType struct{}
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(ProtocolFee)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*TwapRecord
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TwapRecord)
+	(*x.list)[i] = concreteValue
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TwapRecord)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_GenesisState_params, value) {
-			return
-		}
-	}
-	if x.StandardDenom != "" {
-		value := protoreflect.ValueOfString(x.StandardDenom)
-		if !f(fd_GenesisState_standard_denom, value) {
-			return
-		}
-	}
-	if len(x.Pool) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.Pool})
-		if !f(fd_GenesisState_pool, value) {
-			return
-		}
-	}
-	if x.Sequence != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Sequence)
-		if !f(fd_GenesisState_sequence, value) {
-			return
-		}
-	}
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(TwapRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "canto.coinswap.v1.GenesisState.params":
-		return x.Params != nil
-	case "canto.coinswap.v1.GenesisState.standard_denom":
-		return x.StandardDenom != ""
-	case "canto.coinswap.v1.GenesisState.pool":
-		return len(x.Pool) != 0
-	case "canto.coinswap.v1.GenesisState.sequence":
-		return x.Sequence != uint64(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.coinswap.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message canto.coinswap.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "canto.coinswap.v1.GenesisState.params":
-		x.Params = nil
-	case "canto.coinswap.v1.GenesisState.standard_denom":
-		x.StandardDenom = ""
-	case "canto.coinswap.v1.GenesisState.pool":
-		x.Pool = nil
-	case "canto.coinswap.v1.GenesisState.sequence":
-		x.Sequence = uint64(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.coinswap.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message canto.coinswap.v1.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(TwapRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "canto.coinswap.v1.GenesisState.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "canto.coinswap.v1.GenesisState.standard_denom":
-		value := x.StandardDenom
-		return protoreflect.ValueOfString(value)
-	case "canto.coinswap.v1.GenesisState.pool":
-		if len(x.Pool) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_3_list{})
-		}
-		listValue := &_GenesisState_3_list{list: &x.Pool}
-		return protoreflect.ValueOfList(listValue)
-	case "canto.coinswap.v1.GenesisState.sequence":
-		value := x.Sequence
-		return protoreflect.ValueOfUint64(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.coinswap.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message canto.coinswap.v1.GenesisState does not contain field %s", descriptor.FullName()))
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*PoolStats
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "canto.coinswap.v1.GenesisState.params":
-		x.Params = value.Message().Interface().(*Params)
-	case "canto.coinswap.v1.GenesisState.standard_denom":
-		x.StandardDenom = value.Interface().(string)
-	case "canto.coinswap.v1.GenesisState.pool":
-		lv := value.List()
-		clv := lv.(*_GenesisState_3_list)
-		x.Pool = *clv.list
-	case "canto.coinswap.v1.GenesisState.sequence":
-		x.Sequence = value.Uint()
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.coinswap.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message canto.coinswap.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PoolStats)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PoolStats)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(PoolStats)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "canto.coinswap.v1.GenesisState.params":
-		if x.Params == nil {
-			x.Params = new(Params)
-		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	case "canto.coinswap.v1.GenesisState.pool":
-		if x.Pool == nil {
-			x.Pool = []*Pool{}
-		}
-		value := &_GenesisState_3_list{list: &x.Pool}
-		return protoreflect.ValueOfList(value)
-	case "canto.coinswap.v1.GenesisState.standard_denom":
-		panic(fmt.Errorf("field standard_denom of message canto.coinswap.v1.GenesisState is not mutable"))
-	case "canto.coinswap.v1.GenesisState.sequence":
-		panic(fmt.Errorf("field sequence of message canto.coinswap.v1.GenesisState is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.coinswap.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message canto.coinswap.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(PoolStats)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*PoolStats
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "canto.coinswap.v1.GenesisState.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "canto.coinswap.v1.GenesisState.standard_denom":
-		return protoreflect.ValueOfString("")
-	case "canto.coinswap.v1.GenesisState.pool":
-		list := []*Pool{}
-		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
-	case "canto.coinswap.v1.GenesisState.sequence":
-		return protoreflect.ValueOfUint64(uint64(0))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.coinswap.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message canto.coinswap.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PoolStats)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PoolStats)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(PoolStats)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in canto.coinswap.v1.GenesisState", d.FullName()))
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(PoolStats)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*PriceReference
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	panic("unreachable")
+	return len(*x.list)
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceReference)
+	(*x.list)[i] = concreteValue
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
-	return x != nil
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceReference)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.Params != nil {
-			l = options.Size(x.Params)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.StandardDenom)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Pool) > 0 {
-			for _, e := range x.Pool {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Sequence != 0 {
-			n += 1 + runtime.Sov(uint64(x.Sequence))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(PriceReference)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.Sequence != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
-			i--
-			dAtA[i] = 0x20
-		}
-		if len(x.Pool) > 0 {
-			for iNdEx := len(x.Pool) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Pool[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
-		if len(x.StandardDenom) > 0 {
-			i -= len(x.StandardDenom)
-			copy(dAtA[i:], x.StandardDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StandardDenom)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(PriceReference)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*LimitOrder
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LimitOrder)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LimitOrder)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(LimitOrder)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(LimitOrder)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*ConcentratedPoolState
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ConcentratedPoolState)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ConcentratedPoolState)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(ConcentratedPoolState)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(ConcentratedPoolState)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*Tick
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Tick)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Tick)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(Tick)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(Tick)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*Position
+}
+
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Position)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Position)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(Position)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(Position)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_16_list)(nil)
+
+type _GenesisState_16_list struct {
+	list *[]*Gauge
+}
+
+func (x *_GenesisState_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Gauge)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Gauge)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_16_list) AppendMutable() protoreflect.Value {
+	v := new(Gauge)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_16_list) NewElement() protoreflect.Value {
+	v := new(Gauge)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_18_list)(nil)
+
+type _GenesisState_18_list struct {
+	list *[]*Lock
+}
+
+func (x *_GenesisState_18_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Lock)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Lock)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_18_list) AppendMutable() protoreflect.Value {
+	v := new(Lock)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_18_list) NewElement() protoreflect.Value {
+	v := new(Lock)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_20_list)(nil)
+
+type _GenesisState_20_list struct {
+	list *[]*LockRewards
+}
+
+func (x *_GenesisState_20_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_20_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_20_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockRewards)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_20_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockRewards)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_20_list) AppendMutable() protoreflect.Value {
+	v := new(LockRewards)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_20_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_20_list) NewElement() protoreflect.Value {
+	v := new(LockRewards)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_20_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                          protoreflect.MessageDescriptor
+	fd_GenesisState_params                   protoreflect.FieldDescriptor
+	fd_GenesisState_standard_denom           protoreflect.FieldDescriptor
+	fd_GenesisState_pool                     protoreflect.FieldDescriptor
+	fd_GenesisState_sequence                 protoreflect.FieldDescriptor
+	fd_GenesisState_protocol_fees            protoreflect.FieldDescriptor
+	fd_GenesisState_twap_records             protoreflect.FieldDescriptor
+	fd_GenesisState_pool_stats               protoreflect.FieldDescriptor
+	fd_GenesisState_current_pool_stats       protoreflect.FieldDescriptor
+	fd_GenesisState_price_references         protoreflect.FieldDescriptor
+	fd_GenesisState_limit_orders             protoreflect.FieldDescriptor
+	fd_GenesisState_limit_order_sequence     protoreflect.FieldDescriptor
+	fd_GenesisState_concentrated_pool_states protoreflect.FieldDescriptor
+	fd_GenesisState_ticks                    protoreflect.FieldDescriptor
+	fd_GenesisState_positions                protoreflect.FieldDescriptor
+	fd_GenesisState_position_sequence        protoreflect.FieldDescriptor
+	fd_GenesisState_gauges                   protoreflect.FieldDescriptor
+	fd_GenesisState_gauge_sequence           protoreflect.FieldDescriptor
+	fd_GenesisState_locks                    protoreflect.FieldDescriptor
+	fd_GenesisState_lock_sequence            protoreflect.FieldDescriptor
+	fd_GenesisState_lock_rewards             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_canto_coinswap_v1_genesis_proto_init()
+	md_GenesisState = File_canto_coinswap_v1_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_standard_denom = md_GenesisState.Fields().ByName("standard_denom")
+	fd_GenesisState_pool = md_GenesisState.Fields().ByName("pool")
+	fd_GenesisState_sequence = md_GenesisState.Fields().ByName("sequence")
+	fd_GenesisState_protocol_fees = md_GenesisState.Fields().ByName("protocol_fees")
+	fd_GenesisState_twap_records = md_GenesisState.Fields().ByName("twap_records")
+	fd_GenesisState_pool_stats = md_GenesisState.Fields().ByName("pool_stats")
+	fd_GenesisState_current_pool_stats = md_GenesisState.Fields().ByName("current_pool_stats")
+	fd_GenesisState_price_references = md_GenesisState.Fields().ByName("price_references")
+	fd_GenesisState_limit_orders = md_GenesisState.Fields().ByName("limit_orders")
+	fd_GenesisState_limit_order_sequence = md_GenesisState.Fields().ByName("limit_order_sequence")
+	fd_GenesisState_concentrated_pool_states = md_GenesisState.Fields().ByName("concentrated_pool_states")
+	fd_GenesisState_ticks = md_GenesisState.Fields().ByName("ticks")
+	fd_GenesisState_positions = md_GenesisState.Fields().ByName("positions")
+	fd_GenesisState_position_sequence = md_GenesisState.Fields().ByName("position_sequence")
+	fd_GenesisState_gauges = md_GenesisState.Fields().ByName("gauges")
+	fd_GenesisState_gauge_sequence = md_GenesisState.Fields().ByName("gauge_sequence")
+	fd_GenesisState_locks = md_GenesisState.Fields().ByName("locks")
+	fd_GenesisState_lock_sequence = md_GenesisState.Fields().ByName("lock_sequence")
+	fd_GenesisState_lock_rewards = md_GenesisState.Fields().ByName("lock_rewards")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_canto_coinswap_v1_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if x.StandardDenom != "" {
+		value := protoreflect.ValueOfString(x.StandardDenom)
+		if !f(fd_GenesisState_standard_denom, value) {
+			return
+		}
+	}
+	if len(x.Pool) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.Pool})
+		if !f(fd_GenesisState_pool, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_GenesisState_sequence, value) {
+			return
+		}
+	}
+	if len(x.ProtocolFees) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.ProtocolFees})
+		if !f(fd_GenesisState_protocol_fees, value) {
+			return
+		}
+	}
+	if len(x.TwapRecords) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.TwapRecords})
+		if !f(fd_GenesisState_twap_records, value) {
+			return
+		}
+	}
+	if len(x.PoolStats) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.PoolStats})
+		if !f(fd_GenesisState_pool_stats, value) {
+			return
+		}
+	}
+	if len(x.CurrentPoolStats) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.CurrentPoolStats})
+		if !f(fd_GenesisState_current_pool_stats, value) {
+			return
+		}
+	}
+	if len(x.PriceReferences) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.PriceReferences})
+		if !f(fd_GenesisState_price_references, value) {
+			return
+		}
+	}
+	if len(x.LimitOrders) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.LimitOrders})
+		if !f(fd_GenesisState_limit_orders, value) {
+			return
+		}
+	}
+	if x.LimitOrderSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LimitOrderSequence)
+		if !f(fd_GenesisState_limit_order_sequence, value) {
+			return
+		}
+	}
+	if len(x.ConcentratedPoolStates) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.ConcentratedPoolStates})
+		if !f(fd_GenesisState_concentrated_pool_states, value) {
+			return
+		}
+	}
+	if len(x.Ticks) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.Ticks})
+		if !f(fd_GenesisState_ticks, value) {
+			return
+		}
+	}
+	if len(x.Positions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.Positions})
+		if !f(fd_GenesisState_positions, value) {
+			return
+		}
+	}
+	if x.PositionSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PositionSequence)
+		if !f(fd_GenesisState_position_sequence, value) {
+			return
+		}
+	}
+	if len(x.Gauges) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.Gauges})
+		if !f(fd_GenesisState_gauges, value) {
+			return
+		}
+	}
+	if x.GaugeSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GaugeSequence)
+		if !f(fd_GenesisState_gauge_sequence, value) {
+			return
+		}
+	}
+	if len(x.Locks) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_18_list{list: &x.Locks})
+		if !f(fd_GenesisState_locks, value) {
+			return
+		}
+	}
+	if x.LockSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LockSequence)
+		if !f(fd_GenesisState_lock_sequence, value) {
+			return
+		}
+	}
+	if len(x.LockRewards) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_20_list{list: &x.LockRewards})
+		if !f(fd_GenesisState_lock_rewards, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "canto.coinswap.v1.GenesisState.params":
+		return x.Params != nil
+	case "canto.coinswap.v1.GenesisState.standard_denom":
+		return x.StandardDenom != ""
+	case "canto.coinswap.v1.GenesisState.pool":
+		return len(x.Pool) != 0
+	case "canto.coinswap.v1.GenesisState.sequence":
+		return x.Sequence != uint64(0)
+	case "canto.coinswap.v1.GenesisState.protocol_fees":
+		return len(x.ProtocolFees) != 0
+	case "canto.coinswap.v1.GenesisState.twap_records":
+		return len(x.TwapRecords) != 0
+	case "canto.coinswap.v1.GenesisState.pool_stats":
+		return len(x.PoolStats) != 0
+	case "canto.coinswap.v1.GenesisState.current_pool_stats":
+		return len(x.CurrentPoolStats) != 0
+	case "canto.coinswap.v1.GenesisState.price_references":
+		return len(x.PriceReferences) != 0
+	case "canto.coinswap.v1.GenesisState.limit_orders":
+		return len(x.LimitOrders) != 0
+	case "canto.coinswap.v1.GenesisState.limit_order_sequence":
+		return x.LimitOrderSequence != uint64(0)
+	case "canto.coinswap.v1.GenesisState.concentrated_pool_states":
+		return len(x.ConcentratedPoolStates) != 0
+	case "canto.coinswap.v1.GenesisState.ticks":
+		return len(x.Ticks) != 0
+	case "canto.coinswap.v1.GenesisState.positions":
+		return len(x.Positions) != 0
+	case "canto.coinswap.v1.GenesisState.position_sequence":
+		return x.PositionSequence != uint64(0)
+	case "canto.coinswap.v1.GenesisState.gauges":
+		return len(x.Gauges) != 0
+	case "canto.coinswap.v1.GenesisState.gauge_sequence":
+		return x.GaugeSequence != uint64(0)
+	case "canto.coinswap.v1.GenesisState.locks":
+		return len(x.Locks) != 0
+	case "canto.coinswap.v1.GenesisState.lock_sequence":
+		return x.LockSequence != uint64(0)
+	case "canto.coinswap.v1.GenesisState.lock_rewards":
+		return len(x.LockRewards) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.coinswap.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message canto.coinswap.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "canto.coinswap.v1.GenesisState.params":
+		x.Params = nil
+	case "canto.coinswap.v1.GenesisState.standard_denom":
+		x.StandardDenom = ""
+	case "canto.coinswap.v1.GenesisState.pool":
+		x.Pool = nil
+	case "canto.coinswap.v1.GenesisState.sequence":
+		x.Sequence = uint64(0)
+	case "canto.coinswap.v1.GenesisState.protocol_fees":
+		x.ProtocolFees = nil
+	case "canto.coinswap.v1.GenesisState.twap_records":
+		x.TwapRecords = nil
+	case "canto.coinswap.v1.GenesisState.pool_stats":
+		x.PoolStats = nil
+	case "canto.coinswap.v1.GenesisState.current_pool_stats":
+		x.CurrentPoolStats = nil
+	case "canto.coinswap.v1.GenesisState.price_references":
+		x.PriceReferences = nil
+	case "canto.coinswap.v1.GenesisState.limit_orders":
+		x.LimitOrders = nil
+	case "canto.coinswap.v1.GenesisState.limit_order_sequence":
+		x.LimitOrderSequence = uint64(0)
+	case "canto.coinswap.v1.GenesisState.concentrated_pool_states":
+		x.ConcentratedPoolStates = nil
+	case "canto.coinswap.v1.GenesisState.ticks":
+		x.Ticks = nil
+	case "canto.coinswap.v1.GenesisState.positions":
+		x.Positions = nil
+	case "canto.coinswap.v1.GenesisState.position_sequence":
+		x.PositionSequence = uint64(0)
+	case "canto.coinswap.v1.GenesisState.gauges":
+		x.Gauges = nil
+	case "canto.coinswap.v1.GenesisState.gauge_sequence":
+		x.GaugeSequence = uint64(0)
+	case "canto.coinswap.v1.GenesisState.locks":
+		x.Locks = nil
+	case "canto.coinswap.v1.GenesisState.lock_sequence":
+		x.LockSequence = uint64(0)
+	case "canto.coinswap.v1.GenesisState.lock_rewards":
+		x.LockRewards = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.coinswap.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message canto.coinswap.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "canto.coinswap.v1.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "canto.coinswap.v1.GenesisState.standard_denom":
+		value := x.StandardDenom
+		return protoreflect.ValueOfString(value)
+	case "canto.coinswap.v1.GenesisState.pool":
+		if len(x.Pool) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.Pool}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.coinswap.v1.GenesisState.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "canto.coinswap.v1.GenesisState.protocol_fees":
+		if len(x.ProtocolFees) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.ProtocolFees}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.coinswap.v1.GenesisState.twap_records":
+		if len(x.TwapRecords) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.TwapRecords}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.coinswap.v1.GenesisState.pool_stats":
+		if len(x.PoolStats) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.PoolStats}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.coinswap.v1.GenesisState.current_pool_stats":
+		if len(x.CurrentPoolStats) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.CurrentPoolStats}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.coinswap.v1.GenesisState.price_references":
+		if len(x.PriceReferences) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.PriceReferences}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.coinswap.v1.GenesisState.limit_orders":
+		if len(x.LimitOrders) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.LimitOrders}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.coinswap.v1.GenesisState.limit_order_sequence":
+		value := x.LimitOrderSequence
+		return protoreflect.ValueOfUint64(value)
+	case "canto.coinswap.v1.GenesisState.concentrated_pool_states":
+		if len(x.ConcentratedPoolStates) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.ConcentratedPoolStates}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.coinswap.v1.GenesisState.ticks":
+		if len(x.Ticks) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.Ticks}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.coinswap.v1.GenesisState.positions":
+		if len(x.Positions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.Positions}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.coinswap.v1.GenesisState.position_sequence":
+		value := x.PositionSequence
+		return protoreflect.ValueOfUint64(value)
+	case "canto.coinswap.v1.GenesisState.gauges":
+		if len(x.Gauges) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+		}
+		listValue := &_GenesisState_16_list{list: &x.Gauges}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.coinswap.v1.GenesisState.gauge_sequence":
+		value := x.GaugeSequence
+		return protoreflect.ValueOfUint64(value)
+	case "canto.coinswap.v1.GenesisState.locks":
+		if len(x.Locks) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_18_list{})
+		}
+		listValue := &_GenesisState_18_list{list: &x.Locks}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.coinswap.v1.GenesisState.lock_sequence":
+		value := x.LockSequence
+		return protoreflect.ValueOfUint64(value)
+	case "canto.coinswap.v1.GenesisState.lock_rewards":
+		if len(x.LockRewards) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_20_list{})
+		}
+		listValue := &_GenesisState_20_list{list: &x.LockRewards}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.coinswap.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message canto.coinswap.v1.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "canto.coinswap.v1.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "canto.coinswap.v1.GenesisState.standard_denom":
+		x.StandardDenom = value.Interface().(string)
+	case "canto.coinswap.v1.GenesisState.pool":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.Pool = *clv.list
+	case "canto.coinswap.v1.GenesisState.sequence":
+		x.Sequence = value.Uint()
+	case "canto.coinswap.v1.GenesisState.protocol_fees":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.ProtocolFees = *clv.list
+	case "canto.coinswap.v1.GenesisState.twap_records":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.TwapRecords = *clv.list
+	case "canto.coinswap.v1.GenesisState.pool_stats":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.PoolStats = *clv.list
+	case "canto.coinswap.v1.GenesisState.current_pool_stats":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.CurrentPoolStats = *clv.list
+	case "canto.coinswap.v1.GenesisState.price_references":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.PriceReferences = *clv.list
+	case "canto.coinswap.v1.GenesisState.limit_orders":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.LimitOrders = *clv.list
+	case "canto.coinswap.v1.GenesisState.limit_order_sequence":
+		x.LimitOrderSequence = value.Uint()
+	case "canto.coinswap.v1.GenesisState.concentrated_pool_states":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.ConcentratedPoolStates = *clv.list
+	case "canto.coinswap.v1.GenesisState.ticks":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.Ticks = *clv.list
+	case "canto.coinswap.v1.GenesisState.positions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.Positions = *clv.list
+	case "canto.coinswap.v1.GenesisState.position_sequence":
+		x.PositionSequence = value.Uint()
+	case "canto.coinswap.v1.GenesisState.gauges":
+		lv := value.List()
+		clv := lv.(*_GenesisState_16_list)
+		x.Gauges = *clv.list
+	case "canto.coinswap.v1.GenesisState.gauge_sequence":
+		x.GaugeSequence = value.Uint()
+	case "canto.coinswap.v1.GenesisState.locks":
+		lv := value.List()
+		clv := lv.(*_GenesisState_18_list)
+		x.Locks = *clv.list
+	case "canto.coinswap.v1.GenesisState.lock_sequence":
+		x.LockSequence = value.Uint()
+	case "canto.coinswap.v1.GenesisState.lock_rewards":
+		lv := value.List()
+		clv := lv.(*_GenesisState_20_list)
+		x.LockRewards = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.coinswap.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message canto.coinswap.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "canto.coinswap.v1.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "canto.coinswap.v1.GenesisState.pool":
+		if x.Pool == nil {
+			x.Pool = []*Pool{}
+		}
+		value := &_GenesisState_3_list{list: &x.Pool}
+		return protoreflect.ValueOfList(value)
+	case "canto.coinswap.v1.GenesisState.protocol_fees":
+		if x.ProtocolFees == nil {
+			x.ProtocolFees = []*ProtocolFee{}
+		}
+		value := &_GenesisState_5_list{list: &x.ProtocolFees}
+		return protoreflect.ValueOfList(value)
+	case "canto.coinswap.v1.GenesisState.twap_records":
+		if x.TwapRecords == nil {
+			x.TwapRecords = []*TwapRecord{}
+		}
+		value := &_GenesisState_6_list{list: &x.TwapRecords}
+		return protoreflect.ValueOfList(value)
+	case "canto.coinswap.v1.GenesisState.pool_stats":
+		if x.PoolStats == nil {
+			x.PoolStats = []*PoolStats{}
+		}
+		value := &_GenesisState_7_list{list: &x.PoolStats}
+		return protoreflect.ValueOfList(value)
+	case "canto.coinswap.v1.GenesisState.current_pool_stats":
+		if x.CurrentPoolStats == nil {
+			x.CurrentPoolStats = []*PoolStats{}
+		}
+		value := &_GenesisState_8_list{list: &x.CurrentPoolStats}
+		return protoreflect.ValueOfList(value)
+	case "canto.coinswap.v1.GenesisState.price_references":
+		if x.PriceReferences == nil {
+			x.PriceReferences = []*PriceReference{}
+		}
+		value := &_GenesisState_9_list{list: &x.PriceReferences}
+		return protoreflect.ValueOfList(value)
+	case "canto.coinswap.v1.GenesisState.limit_orders":
+		if x.LimitOrders == nil {
+			x.LimitOrders = []*LimitOrder{}
+		}
+		value := &_GenesisState_10_list{list: &x.LimitOrders}
+		return protoreflect.ValueOfList(value)
+	case "canto.coinswap.v1.GenesisState.concentrated_pool_states":
+		if x.ConcentratedPoolStates == nil {
+			x.ConcentratedPoolStates = []*ConcentratedPoolState{}
+		}
+		value := &_GenesisState_12_list{list: &x.ConcentratedPoolStates}
+		return protoreflect.ValueOfList(value)
+	case "canto.coinswap.v1.GenesisState.ticks":
+		if x.Ticks == nil {
+			x.Ticks = []*Tick{}
+		}
+		value := &_GenesisState_13_list{list: &x.Ticks}
+		return protoreflect.ValueOfList(value)
+	case "canto.coinswap.v1.GenesisState.positions":
+		if x.Positions == nil {
+			x.Positions = []*Position{}
+		}
+		value := &_GenesisState_14_list{list: &x.Positions}
+		return protoreflect.ValueOfList(value)
+	case "canto.coinswap.v1.GenesisState.gauges":
+		if x.Gauges == nil {
+			x.Gauges = []*Gauge{}
+		}
+		value := &_GenesisState_16_list{list: &x.Gauges}
+		return protoreflect.ValueOfList(value)
+	case "canto.coinswap.v1.GenesisState.locks":
+		if x.Locks == nil {
+			x.Locks = []*Lock{}
+		}
+		value := &_GenesisState_18_list{list: &x.Locks}
+		return protoreflect.ValueOfList(value)
+	case "canto.coinswap.v1.GenesisState.lock_rewards":
+		if x.LockRewards == nil {
+			x.LockRewards = []*LockRewards{}
+		}
+		value := &_GenesisState_20_list{list: &x.LockRewards}
+		return protoreflect.ValueOfList(value)
+	case "canto.coinswap.v1.GenesisState.standard_denom":
+		panic(fmt.Errorf("field standard_denom of message canto.coinswap.v1.GenesisState is not mutable"))
+	case "canto.coinswap.v1.GenesisState.sequence":
+		panic(fmt.Errorf("field sequence of message canto.coinswap.v1.GenesisState is not mutable"))
+	case "canto.coinswap.v1.GenesisState.limit_order_sequence":
+		panic(fmt.Errorf("field limit_order_sequence of message canto.coinswap.v1.GenesisState is not mutable"))
+	case "canto.coinswap.v1.GenesisState.position_sequence":
+		panic(fmt.Errorf("field position_sequence of message canto.coinswap.v1.GenesisState is not mutable"))
+	case "canto.coinswap.v1.GenesisState.gauge_sequence":
+		panic(fmt.Errorf("field gauge_sequence of message canto.coinswap.v1.GenesisState is not mutable"))
+	case "canto.coinswap.v1.GenesisState.lock_sequence":
+		panic(fmt.Errorf("field lock_sequence of message canto.coinswap.v1.GenesisState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.coinswap.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message canto.coinswap.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "canto.coinswap.v1.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "canto.coinswap.v1.GenesisState.standard_denom":
+		return protoreflect.ValueOfString("")
+	case "canto.coinswap.v1.GenesisState.pool":
+		list := []*Pool{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "canto.coinswap.v1.GenesisState.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "canto.coinswap.v1.GenesisState.protocol_fees":
+		list := []*ProtocolFee{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "canto.coinswap.v1.GenesisState.twap_records":
+		list := []*TwapRecord{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "canto.coinswap.v1.GenesisState.pool_stats":
+		list := []*PoolStats{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "canto.coinswap.v1.GenesisState.current_pool_stats":
+		list := []*PoolStats{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "canto.coinswap.v1.GenesisState.price_references":
+		list := []*PriceReference{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "canto.coinswap.v1.GenesisState.limit_orders":
+		list := []*LimitOrder{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "canto.coinswap.v1.GenesisState.limit_order_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "canto.coinswap.v1.GenesisState.concentrated_pool_states":
+		list := []*ConcentratedPoolState{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "canto.coinswap.v1.GenesisState.ticks":
+		list := []*Tick{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	case "canto.coinswap.v1.GenesisState.positions":
+		list := []*Position{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
+	case "canto.coinswap.v1.GenesisState.position_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "canto.coinswap.v1.GenesisState.gauges":
+		list := []*Gauge{}
+		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
+	case "canto.coinswap.v1.GenesisState.gauge_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "canto.coinswap.v1.GenesisState.locks":
+		list := []*Lock{}
+		return protoreflect.ValueOfList(&_GenesisState_18_list{list: &list})
+	case "canto.coinswap.v1.GenesisState.lock_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "canto.coinswap.v1.GenesisState.lock_rewards":
+		list := []*LockRewards{}
+		return protoreflect.ValueOfList(&_GenesisState_20_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.coinswap.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message canto.coinswap.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in canto.coinswap.v1.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.StandardDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Pool) > 0 {
+			for _, e := range x.Pool {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if len(x.ProtocolFees) > 0 {
+			for _, e := range x.ProtocolFees {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TwapRecords) > 0 {
+			for _, e := range x.TwapRecords {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PoolStats) > 0 {
+			for _, e := range x.PoolStats {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CurrentPoolStats) > 0 {
+			for _, e := range x.CurrentPoolStats {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PriceReferences) > 0 {
+			for _, e := range x.PriceReferences {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LimitOrders) > 0 {
+			for _, e := range x.LimitOrders {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LimitOrderSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.LimitOrderSequence))
+		}
+		if len(x.ConcentratedPoolStates) > 0 {
+			for _, e := range x.ConcentratedPoolStates {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Ticks) > 0 {
+			for _, e := range x.Ticks {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Positions) > 0 {
+			for _, e := range x.Positions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PositionSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.PositionSequence))
+		}
+		if len(x.Gauges) > 0 {
+			for _, e := range x.Gauges {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.GaugeSequence != 0 {
+			n += 2 + runtime.Sov(uint64(x.GaugeSequence))
+		}
+		if len(x.Locks) > 0 {
+			for _, e := range x.Locks {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LockSequence != 0 {
+			n += 2 + runtime.Sov(uint64(x.LockSequence))
+		}
+		if len(x.LockRewards) > 0 {
+			for _, e := range x.LockRewards {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.LockRewards) > 0 {
+			for iNdEx := len(x.LockRewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LockRewards[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xa2
+			}
+		}
+		if x.LockSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LockSequence))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
+		if len(x.Locks) > 0 {
+			for iNdEx := len(x.Locks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Locks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if x.GaugeSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GaugeSequence))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if len(x.Gauges) > 0 {
+			for iNdEx := len(x.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Gauges[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if x.PositionSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PositionSequence))
+			i--
+			dAtA[i] = 0x78
+		}
+		if len(x.Positions) > 0 {
+			for iNdEx := len(x.Positions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Positions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.Ticks) > 0 {
+			for iNdEx := len(x.Ticks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Ticks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.ConcentratedPoolStates) > 0 {
+			for iNdEx := len(x.ConcentratedPoolStates) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ConcentratedPoolStates[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.LimitOrderSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LimitOrderSequence))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.LimitOrders) > 0 {
+			for iNdEx := len(x.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LimitOrders[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.PriceReferences) > 0 {
+			for iNdEx := len(x.PriceReferences) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PriceReferences[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.CurrentPoolStats) > 0 {
+			for iNdEx := len(x.CurrentPoolStats) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CurrentPoolStats[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.PoolStats) > 0 {
+			for iNdEx := len(x.PoolStats) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PoolStats[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.TwapRecords) > 0 {
+			for iNdEx := len(x.TwapRecords) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TwapRecords[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.ProtocolFees) > 0 {
+			for iNdEx := len(x.ProtocolFees) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ProtocolFees[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Pool) > 0 {
+			for iNdEx := len(x.Pool) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Pool[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.StandardDenom) > 0 {
+			i -= len(x.StandardDenom)
+			copy(dAtA[i:], x.StandardDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StandardDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
 		x := input.Message.Interface().(*GenesisState)
@@ -523,28 +1897,406 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StandardDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StandardDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Pool = append(x.Pool, &Pool{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pool[len(x.Pool)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolFees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProtocolFees = append(x.ProtocolFees, &ProtocolFee{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProtocolFees[len(x.ProtocolFees)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TwapRecords", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TwapRecords = append(x.TwapRecords, &TwapRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TwapRecords[len(x.TwapRecords)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolStats", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PoolStats = append(x.PoolStats, &PoolStats{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PoolStats[len(x.PoolStats)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrentPoolStats", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CurrentPoolStats = append(x.CurrentPoolStats, &PoolStats{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CurrentPoolStats[len(x.CurrentPoolStats)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceReferences", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PriceReferences = append(x.PriceReferences, &PriceReference{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PriceReferences[len(x.PriceReferences)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LimitOrders = append(x.LimitOrders, &LimitOrder{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LimitOrders[len(x.LimitOrders)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LimitOrderSequence", wireType)
+				}
+				x.LimitOrderSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LimitOrderSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConcentratedPoolStates", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.ConcentratedPoolStates = append(x.ConcentratedPoolStates, &ConcentratedPoolState{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ConcentratedPoolStates[len(x.ConcentratedPoolStates)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 13:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ticks", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -571,18 +2323,69 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				x.Ticks = append(x.Ticks, &Tick{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Ticks[len(x.Ticks)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Positions = append(x.Positions, &Position{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Positions[len(x.Positions)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PositionSequence", wireType)
+				}
+				x.PositionSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PositionSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StandardDenom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -592,27 +2395,48 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.StandardDenom = string(dAtA[iNdEx:postIndex])
+				x.Gauges = append(x.Gauges, &Gauge{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Gauges[len(x.Gauges)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 3:
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GaugeSequence", wireType)
+				}
+				x.GaugeSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GaugeSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 18:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -639,16 +2463,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Pool = append(x.Pool, &Pool{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pool[len(x.Pool)-1]); err != nil {
+				x.Locks = append(x.Locks, &Lock{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Locks[len(x.Locks)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
+			case 19:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockSequence", wireType)
 				}
-				x.Sequence = 0
+				x.LockSequence = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -658,11 +2482,45 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Sequence |= uint64(b&0x7F) << shift
+					x.LockSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockRewards", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockRewards = append(x.LockRewards, &LockRewards{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LockRewards[len(x.LockRewards)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -717,10 +2575,40 @@ type GenesisState struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Params        *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	StandardDenom string  `protobuf:"bytes,2,opt,name=standard_denom,json=standardDenom,proto3" json:"standard_denom,omitempty"`
-	Pool          []*Pool `protobuf:"bytes,3,rep,name=pool,proto3" json:"pool,omitempty"`
-	Sequence      uint64  `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Params        *Params        `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	StandardDenom string         `protobuf:"bytes,2,opt,name=standard_denom,json=standardDenom,proto3" json:"standard_denom,omitempty"`
+	Pool          []*Pool        `protobuf:"bytes,3,rep,name=pool,proto3" json:"pool,omitempty"`
+	Sequence      uint64         `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	ProtocolFees  []*ProtocolFee `protobuf:"bytes,5,rep,name=protocol_fees,json=protocolFees,proto3" json:"protocol_fees,omitempty"`
+	TwapRecords   []*TwapRecord  `protobuf:"bytes,6,rep,name=twap_records,json=twapRecords,proto3" json:"twap_records,omitempty"`
+	// statistics of the ended epochs
+	PoolStats []*PoolStats `protobuf:"bytes,7,rep,name=pool_stats,json=poolStats,proto3" json:"pool_stats,omitempty"`
+	// statistics of the ongoing epoch
+	CurrentPoolStats []*PoolStats `protobuf:"bytes,8,rep,name=current_pool_stats,json=currentPoolStats,proto3" json:"current_pool_stats,omitempty"`
+	// reference prices of the pool circuit breakers
+	PriceReferences []*PriceReference `protobuf:"bytes,9,rep,name=price_references,json=priceReferences,proto3" json:"price_references,omitempty"`
+	// open limit orders
+	LimitOrders []*LimitOrder `protobuf:"bytes,10,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders,omitempty"`
+	// id of the next limit order
+	LimitOrderSequence uint64 `protobuf:"varint,11,opt,name=limit_order_sequence,json=limitOrderSequence,proto3" json:"limit_order_sequence,omitempty"`
+	// pricing states of the concentrated liquidity pools
+	ConcentratedPoolStates []*ConcentratedPoolState `protobuf:"bytes,12,rep,name=concentrated_pool_states,json=concentratedPoolStates,proto3" json:"concentrated_pool_states,omitempty"`
+	// initialized ticks of the concentrated liquidity pools
+	Ticks []*Tick `protobuf:"bytes,13,rep,name=ticks,proto3" json:"ticks,omitempty"`
+	// positions of the concentrated liquidity pools
+	Positions []*Position `protobuf:"bytes,14,rep,name=positions,proto3" json:"positions,omitempty"`
+	// id of the next position
+	PositionSequence uint64 `protobuf:"varint,15,opt,name=position_sequence,json=positionSequence,proto3" json:"position_sequence,omitempty"`
+	// reward gauges of the liquidity pool coins
+	Gauges []*Gauge `protobuf:"bytes,16,rep,name=gauges,proto3" json:"gauges,omitempty"`
+	// id of the next gauge
+	GaugeSequence uint64 `protobuf:"varint,17,opt,name=gauge_sequence,json=gaugeSequence,proto3" json:"gauge_sequence,omitempty"`
+	// locks of the liquidity pool coins
+	Locks []*Lock `protobuf:"bytes,18,rep,name=locks,proto3" json:"locks,omitempty"`
+	// id of the next lock
+	LockSequence uint64 `protobuf:"varint,19,opt,name=lock_sequence,json=lockSequence,proto3" json:"lock_sequence,omitempty"`
+	// rewards distributed to the locks of each liquidity pool coin denom
+	LockRewards []*LockRewards `protobuf:"bytes,20,rep,name=lock_rewards,json=lockRewards,proto3" json:"lock_rewards,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -771,6 +2659,118 @@ func (x *GenesisState) GetSequence() uint64 {
 	return 0
 }
 
+func (x *GenesisState) GetProtocolFees() []*ProtocolFee {
+	if x != nil {
+		return x.ProtocolFees
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTwapRecords() []*TwapRecord {
+	if x != nil {
+		return x.TwapRecords
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPoolStats() []*PoolStats {
+	if x != nil {
+		return x.PoolStats
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCurrentPoolStats() []*PoolStats {
+	if x != nil {
+		return x.CurrentPoolStats
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPriceReferences() []*PriceReference {
+	if x != nil {
+		return x.PriceReferences
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLimitOrders() []*LimitOrder {
+	if x != nil {
+		return x.LimitOrders
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLimitOrderSequence() uint64 {
+	if x != nil {
+		return x.LimitOrderSequence
+	}
+	return 0
+}
+
+func (x *GenesisState) GetConcentratedPoolStates() []*ConcentratedPoolState {
+	if x != nil {
+		return x.ConcentratedPoolStates
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTicks() []*Tick {
+	if x != nil {
+		return x.Ticks
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPositions() []*Position {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPositionSequence() uint64 {
+	if x != nil {
+		return x.PositionSequence
+	}
+	return 0
+}
+
+func (x *GenesisState) GetGauges() []*Gauge {
+	if x != nil {
+		return x.Gauges
+	}
+	return nil
+}
+
+func (x *GenesisState) GetGaugeSequence() uint64 {
+	if x != nil {
+		return x.GaugeSequence
+	}
+	return 0
+}
+
+func (x *GenesisState) GetLocks() []*Lock {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLockSequence() uint64 {
+	if x != nil {
+		return x.LockSequence
+	}
+	return 0
+}
+
+func (x *GenesisState) GetLockRewards() []*LockRewards {
+	if x != nil {
+		return x.LockRewards
+	}
+	return nil
+}
+
 var File_canto_coinswap_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_canto_coinswap_v1_genesis_proto_rawDesc = []byte{
@@ -780,7 +2780,7 @@ var file_canto_coinswap_v1_genesis_proto_rawDesc = []byte{
 	0x70, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
 	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x63, 0x61, 0x6e, 0x74,
 	0x6f, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
-	0x69, 0x6e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd8, 0x01, 0x0a,
+	0x69, 0x6e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdd, 0x09, 0x0a,
 	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a,
 	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
 	0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76,
@@ -794,19 +2794,84 @@ var file_canto_coinswap_v1_genesis_proto_rawDesc = []byte{
 	0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x42,
 	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x73,
 	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73,
-	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x42, 0xba, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e,
-	0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76,
-	0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x2d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x77,
-	0x61, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70, 0x76, 0x31,
-	0xa2, 0x02, 0x03, 0x43, 0x43, 0x58, 0xaa, 0x02, 0x11, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x43,
-	0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x43, 0x61, 0x6e,
-	0x74, 0x6f, 0x5c, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x1d, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x5c, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x13, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x3a, 0x3a, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70,
-	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x65, 0x65, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x65,
+	0x65, 0x73, 0x12, 0x46, 0x0a, 0x0c, 0x74, 0x77, 0x61, 0x70, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f,
+	0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x77, 0x61,
+	0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x74,
+	0x77, 0x61, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x70, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x50, 0x0a,
+	0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x61, 0x6e, 0x74,
+	0x6f, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
+	0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x52, 0x0a, 0x10, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x61, 0x6e, 0x74,
+	0x6f, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0c, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x61, 0x6e, 0x74,
+	0x6f, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x68, 0x0a,
+	0x18, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x65, 0x64,
+	0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x16, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x6f,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x74, 0x69, 0x63, 0x6b, 0x73,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x63,
+	0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x3f, 0x0a, 0x09,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x67, 0x61,
+	0x75, 0x67, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x61, 0x6e,
+	0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x61, 0x75, 0x67, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x67, 0x61, 0x75, 0x67,
+	0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x67, 0x61, 0x75, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x67, 0x61, 0x75, 0x67,
+	0x65, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f,
+	0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63,
+	0x6b, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x61, 0x6e, 0x74,
+	0x6f, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0xba, 0x01, 0x0a,
+	0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x63,
+	0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x69, 0x6e, 0x73,
+	0x77, 0x61, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x58, 0xaa, 0x02, 0x11, 0x43, 0x61,
+	0x6e, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x11, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x5c, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x77, 0x61, 0x70,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x5c, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x3a, 0x3a, 0x43, 0x6f, 0x69,
+	0x6e, 0x73, 0x77, 0x61, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -823,18 +2888,41 @@ func file_canto_coinswap_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_canto_coinswap_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_canto_coinswap_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: canto.coinswap.v1.GenesisState
-	(*Params)(nil),       // 1: canto.coinswap.v1.Params
-	(*Pool)(nil),         // 2: canto.coinswap.v1.Pool
+	(*GenesisState)(nil),          // 0: canto.coinswap.v1.GenesisState
+	(*Params)(nil),                // 1: canto.coinswap.v1.Params
+	(*Pool)(nil),                  // 2: canto.coinswap.v1.Pool
+	(*ProtocolFee)(nil),           // 3: canto.coinswap.v1.ProtocolFee
+	(*TwapRecord)(nil),            // 4: canto.coinswap.v1.TwapRecord
+	(*PoolStats)(nil),             // 5: canto.coinswap.v1.PoolStats
+	(*PriceReference)(nil),        // 6: canto.coinswap.v1.PriceReference
+	(*LimitOrder)(nil),            // 7: canto.coinswap.v1.LimitOrder
+	(*ConcentratedPoolState)(nil), // 8: canto.coinswap.v1.ConcentratedPoolState
+	(*Tick)(nil),                  // 9: canto.coinswap.v1.Tick
+	(*Position)(nil),              // 10: canto.coinswap.v1.Position
+	(*Gauge)(nil),                 // 11: canto.coinswap.v1.Gauge
+	(*Lock)(nil),                  // 12: canto.coinswap.v1.Lock
+	(*LockRewards)(nil),           // 13: canto.coinswap.v1.LockRewards
 }
 var file_canto_coinswap_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: canto.coinswap.v1.GenesisState.params:type_name -> canto.coinswap.v1.Params
-	2, // 1: canto.coinswap.v1.GenesisState.pool:type_name -> canto.coinswap.v1.Pool
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	1,  // 0: canto.coinswap.v1.GenesisState.params:type_name -> canto.coinswap.v1.Params
+	2,  // 1: canto.coinswap.v1.GenesisState.pool:type_name -> canto.coinswap.v1.Pool
+	3,  // 2: canto.coinswap.v1.GenesisState.protocol_fees:type_name -> canto.coinswap.v1.ProtocolFee
+	4,  // 3: canto.coinswap.v1.GenesisState.twap_records:type_name -> canto.coinswap.v1.TwapRecord
+	5,  // 4: canto.coinswap.v1.GenesisState.pool_stats:type_name -> canto.coinswap.v1.PoolStats
+	5,  // 5: canto.coinswap.v1.GenesisState.current_pool_stats:type_name -> canto.coinswap.v1.PoolStats
+	6,  // 6: canto.coinswap.v1.GenesisState.price_references:type_name -> canto.coinswap.v1.PriceReference
+	7,  // 7: canto.coinswap.v1.GenesisState.limit_orders:type_name -> canto.coinswap.v1.LimitOrder
+	8,  // 8: canto.coinswap.v1.GenesisState.concentrated_pool_states:type_name -> canto.coinswap.v1.ConcentratedPoolState
+	9,  // 9: canto.coinswap.v1.GenesisState.ticks:type_name -> canto.coinswap.v1.Tick
+	10, // 10: canto.coinswap.v1.GenesisState.positions:type_name -> canto.coinswap.v1.Position
+	11, // 11: canto.coinswap.v1.GenesisState.gauges:type_name -> canto.coinswap.v1.Gauge
+	12, // 12: canto.coinswap.v1.GenesisState.locks:type_name -> canto.coinswap.v1.Lock
+	13, // 13: canto.coinswap.v1.GenesisState.lock_rewards:type_name -> canto.coinswap.v1.LockRewards
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_canto_coinswap_v1_genesis_proto_init() }
@@ -19,10 +19,26 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_AddLiquidity_FullMethodName    = "/canto.coinswap.v1.Msg/AddLiquidity"
-	Msg_RemoveLiquidity_FullMethodName = "/canto.coinswap.v1.Msg/RemoveLiquidity"
-	Msg_SwapCoin_FullMethodName        = "/canto.coinswap.v1.Msg/SwapCoin"
-	Msg_UpdateParams_FullMethodName    = "/canto.coinswap.v1.Msg/UpdateParams"
+	Msg_AddLiquidity_FullMethodName            = "/canto.coinswap.v1.Msg/AddLiquidity"
+	Msg_RemoveLiquidity_FullMethodName         = "/canto.coinswap.v1.Msg/RemoveLiquidity"
+	Msg_SwapCoin_FullMethodName                = "/canto.coinswap.v1.Msg/SwapCoin"
+	Msg_AddLiquiditySingle_FullMethodName      = "/canto.coinswap.v1.Msg/AddLiquiditySingle"
+	Msg_RemoveLiquiditySingle_FullMethodName   = "/canto.coinswap.v1.Msg/RemoveLiquiditySingle"
+	Msg_SwapExactAmountInRoute_FullMethodName  = "/canto.coinswap.v1.Msg/SwapExactAmountInRoute"
+	Msg_SwapExactAmountOutRoute_FullMethodName = "/canto.coinswap.v1.Msg/SwapExactAmountOutRoute"
+	Msg_UpdateParams_FullMethodName            = "/canto.coinswap.v1.Msg/UpdateParams"
+	Msg_UpdatePoolParams_FullMethodName        = "/canto.coinswap.v1.Msg/UpdatePoolParams"
+	Msg_SetPoolStatus_FullMethodName           = "/canto.coinswap.v1.Msg/SetPoolStatus"
+	Msg_PlaceLimitOrder_FullMethodName         = "/canto.coinswap.v1.Msg/PlaceLimitOrder"
+	Msg_CancelLimitOrder_FullMethodName        = "/canto.coinswap.v1.Msg/CancelLimitOrder"
+	Msg_CreatePosition_FullMethodName          = "/canto.coinswap.v1.Msg/CreatePosition"
+	Msg_IncreasePosition_FullMethodName        = "/canto.coinswap.v1.Msg/IncreasePosition"
+	Msg_DecreasePosition_FullMethodName        = "/canto.coinswap.v1.Msg/DecreasePosition"
+	Msg_CollectFees_FullMethodName             = "/canto.coinswap.v1.Msg/CollectFees"
+	Msg_CreateGauge_FullMethodName             = "/canto.coinswap.v1.Msg/CreateGauge"
+	Msg_LockLiquidity_FullMethodName           = "/canto.coinswap.v1.Msg/LockLiquidity"
+	Msg_UnlockLiquidity_FullMethodName         = "/canto.coinswap.v1.Msg/UnlockLiquidity"
+	Msg_ClaimLockRewards_FullMethodName        = "/canto.coinswap.v1.Msg/ClaimLockRewards"
 )
 
 // MsgClient is the client API for Msg service.
@@ -38,11 +54,59 @@ type MsgClient interface {
 	// SwapCoin defines a method for swapping a token with the other token from
 	// the liquidity pool
 	SwapCoin(ctx context.Context, in *MsgSwapOrder, opts ...grpc.CallOption) (*MsgSwapCoinResponse, error)
+	// AddLiquiditySingle defines a method for depositing a single token to the
+	// liquidity pool, swapping part of it for the other token of the pool
+	AddLiquiditySingle(ctx context.Context, in *MsgAddLiquiditySingle, opts ...grpc.CallOption) (*MsgAddLiquiditySingleResponse, error)
+	// RemoveLiquiditySingle defines a method for withdrawing a single token from
+	// the liquidity pool, swapping the other withdrawn token for it
+	RemoveLiquiditySingle(ctx context.Context, in *MsgRemoveLiquiditySingle, opts ...grpc.CallOption) (*MsgRemoveLiquiditySingleResponse, error)
+	// SwapExactAmountInRoute defines a method for selling an exact amount of a
+	// token for another one, routing through the standard denom pools
+	SwapExactAmountInRoute(ctx context.Context, in *MsgSwapExactAmountInRoute, opts ...grpc.CallOption) (*MsgSwapExactAmountInRouteResponse, error)
+	// SwapExactAmountOutRoute defines a method for buying an exact amount of a
+	// token with another one, routing through the standard denom pools
+	SwapExactAmountOutRoute(ctx context.Context, in *MsgSwapExactAmountOutRoute, opts ...grpc.CallOption) (*MsgSwapExactAmountOutRouteResponse, error)
 	// UpdateParams defines a governance operation for updating the x/coinswap
 	// module parameters. The authority is defined in the keeper.
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdatePoolParams defines a governance operation for updating the
+	// parameters of a single liquidity pool. The authority is defined in the
+	// keeper.
+	UpdatePoolParams(ctx context.Context, in *MsgUpdatePoolParams, opts ...grpc.CallOption) (*MsgUpdatePoolParamsResponse, error)
+	// SetPoolStatus defines a governance operation for pausing or resuming the
+	// operations of a single liquidity pool. The authority is defined in the
+	// keeper.
+	SetPoolStatus(ctx context.Context, in *MsgSetPoolStatus, opts ...grpc.CallOption) (*MsgSetPoolStatusResponse, error)
+	// PlaceLimitOrder defines a method for escrowing a token until the liquidity
+	// pool can sell a minimum amount of the other token for it
+	PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error)
+	// CancelLimitOrder defines a method for cancelling an open limit order and
+	// refunding its escrowed token
+	CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error)
+	// CreatePosition defines a method for providing liquidity to a concentrated
+	// liquidity pool between two ticks, creating the pool if it does not exist
+	CreatePosition(ctx context.Context, in *MsgCreatePosition, opts ...grpc.CallOption) (*MsgCreatePositionResponse, error)
+	// IncreasePosition defines a method for adding liquidity to a position
+	IncreasePosition(ctx context.Context, in *MsgIncreasePosition, opts ...grpc.CallOption) (*MsgIncreasePositionResponse, error)
+	// DecreasePosition defines a method for removing liquidity from a position
+	DecreasePosition(ctx context.Context, in *MsgDecreasePosition, opts ...grpc.CallOption) (*MsgDecreasePositionResponse, error)
+	// CollectFees defines a method for withdrawing the swap fees earned by a
+	// position
+	CollectFees(ctx context.Context, in *MsgCollectFees, opts ...grpc.CallOption) (*MsgCollectFeesResponse, error)
+	// CreateGauge defines a method for funding rewards distributed to the locks
+	// of a liquidity pool coin over a number of epochs
+	CreateGauge(ctx context.Context, in *MsgCreateGauge, opts ...grpc.CallOption) (*MsgCreateGaugeResponse, error)
+	// LockLiquidity defines a method for locking liquidity pool coins for a
+	// duration to earn the rewards of the gauges of their denom
+	LockLiquidity(ctx context.Context, in *MsgLockLiquidity, opts ...grpc.CallOption) (*MsgLockLiquidityResponse, error)
+	// UnlockLiquidity defines a method for withdrawing the coins of a lock past
+	// its duration along with its rewards
+	UnlockLiquidity(ctx context.Context, in *MsgUnlockLiquidity, opts ...grpc.CallOption) (*MsgUnlockLiquidityResponse, error)
+	// ClaimLockRewards defines a method for withdrawing the rewards earned by a
+	// lock
+	ClaimLockRewards(ctx context.Context, in *MsgClaimLockRewards, opts ...grpc.CallOption) (*MsgClaimLockRewardsResponse, error)
 }
 
 type msgClient struct {
@@ -80,6 +144,42 @@ func (c *msgClient) SwapCoin(ctx context.Context, in *MsgSwapOrder, opts ...grpc
 	return out, nil
 }
 
+func (c *msgClient) AddLiquiditySingle(ctx context.Context, in *MsgAddLiquiditySingle, opts ...grpc.CallOption) (*MsgAddLiquiditySingleResponse, error) {
+	out := new(MsgAddLiquiditySingleResponse)
+	err := c.cc.Invoke(ctx, Msg_AddLiquiditySingle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveLiquiditySingle(ctx context.Context, in *MsgRemoveLiquiditySingle, opts ...grpc.CallOption) (*MsgRemoveLiquiditySingleResponse, error) {
+	out := new(MsgRemoveLiquiditySingleResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveLiquiditySingle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SwapExactAmountInRoute(ctx context.Context, in *MsgSwapExactAmountInRoute, opts ...grpc.CallOption) (*MsgSwapExactAmountInRouteResponse, error) {
+	out := new(MsgSwapExactAmountInRouteResponse)
+	err := c.cc.Invoke(ctx, Msg_SwapExactAmountInRoute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SwapExactAmountOutRoute(ctx context.Context, in *MsgSwapExactAmountOutRoute, opts ...grpc.CallOption) (*MsgSwapExactAmountOutRouteResponse, error) {
+	out := new(MsgSwapExactAmountOutRouteResponse)
+	err := c.cc.Invoke(ctx, Msg_SwapExactAmountOutRoute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -89,6 +189,114 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdatePoolParams(ctx context.Context, in *MsgUpdatePoolParams, opts ...grpc.CallOption) (*MsgUpdatePoolParamsResponse, error) {
+	out := new(MsgUpdatePoolParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdatePoolParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPoolStatus(ctx context.Context, in *MsgSetPoolStatus, opts ...grpc.CallOption) (*MsgSetPoolStatusResponse, error) {
+	out := new(MsgSetPoolStatusResponse)
+	err := c.cc.Invoke(ctx, Msg_SetPoolStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error) {
+	out := new(MsgPlaceLimitOrderResponse)
+	err := c.cc.Invoke(ctx, Msg_PlaceLimitOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error) {
+	out := new(MsgCancelLimitOrderResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelLimitOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreatePosition(ctx context.Context, in *MsgCreatePosition, opts ...grpc.CallOption) (*MsgCreatePositionResponse, error) {
+	out := new(MsgCreatePositionResponse)
+	err := c.cc.Invoke(ctx, Msg_CreatePosition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) IncreasePosition(ctx context.Context, in *MsgIncreasePosition, opts ...grpc.CallOption) (*MsgIncreasePositionResponse, error) {
+	out := new(MsgIncreasePositionResponse)
+	err := c.cc.Invoke(ctx, Msg_IncreasePosition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DecreasePosition(ctx context.Context, in *MsgDecreasePosition, opts ...grpc.CallOption) (*MsgDecreasePositionResponse, error) {
+	out := new(MsgDecreasePositionResponse)
+	err := c.cc.Invoke(ctx, Msg_DecreasePosition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CollectFees(ctx context.Context, in *MsgCollectFees, opts ...grpc.CallOption) (*MsgCollectFeesResponse, error) {
+	out := new(MsgCollectFeesResponse)
+	err := c.cc.Invoke(ctx, Msg_CollectFees_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateGauge(ctx context.Context, in *MsgCreateGauge, opts ...grpc.CallOption) (*MsgCreateGaugeResponse, error) {
+	out := new(MsgCreateGaugeResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateGauge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LockLiquidity(ctx context.Context, in *MsgLockLiquidity, opts ...grpc.CallOption) (*MsgLockLiquidityResponse, error) {
+	out := new(MsgLockLiquidityResponse)
+	err := c.cc.Invoke(ctx, Msg_LockLiquidity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnlockLiquidity(ctx context.Context, in *MsgUnlockLiquidity, opts ...grpc.CallOption) (*MsgUnlockLiquidityResponse, error) {
+	out := new(MsgUnlockLiquidityResponse)
+	err := c.cc.Invoke(ctx, Msg_UnlockLiquidity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimLockRewards(ctx context.Context, in *MsgClaimLockRewards, opts ...grpc.CallOption) (*MsgClaimLockRewardsResponse, error) {
+	out := new(MsgClaimLockRewardsResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimLockRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -102,11 +310,59 @@ type MsgServer interface {
 	// SwapCoin defines a method for swapping a token with the other token from
 	// the liquidity pool
 	SwapCoin(context.Context, *MsgSwapOrder) (*MsgSwapCoinResponse, error)
+	// AddLiquiditySingle defines a method for depositing a single token to the
+	// liquidity pool, swapping part of it for the other token of the pool
+	AddLiquiditySingle(context.Context, *MsgAddLiquiditySingle) (*MsgAddLiquiditySingleResponse, error)
+	// RemoveLiquiditySingle defines a method for withdrawing a single token from
+	// the liquidity pool, swapping the other withdrawn token for it
+	RemoveLiquiditySingle(context.Context, *MsgRemoveLiquiditySingle) (*MsgRemoveLiquiditySingleResponse, error)
+	// SwapExactAmountInRoute defines a method for selling an exact amount of a
+	// token for another one, routing through the standard denom pools
+	SwapExactAmountInRoute(context.Context, *MsgSwapExactAmountInRoute) (*MsgSwapExactAmountInRouteResponse, error)
+	// SwapExactAmountOutRoute defines a method for buying an exact amount of a
+	// token with another one, routing through the standard denom pools
+	SwapExactAmountOutRoute(context.Context, *MsgSwapExactAmountOutRoute) (*MsgSwapExactAmountOutRouteResponse, error)
 	// UpdateParams defines a governance operation for updating the x/coinswap
 	// module parameters. The authority is defined in the keeper.
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdatePoolParams defines a governance operation for updating the
+	// parameters of a single liquidity pool. The authority is defined in the
+	// keeper.
+	UpdatePoolParams(context.Context, *MsgUpdatePoolParams) (*MsgUpdatePoolParamsResponse, error)
+	// SetPoolStatus defines a governance operation for pausing or resuming the
+	// operations of a single liquidity pool. The authority is defined in the
+	// keeper.
+	SetPoolStatus(context.Context, *MsgSetPoolStatus) (*MsgSetPoolStatusResponse, error)
+	// PlaceLimitOrder defines a method for escrowing a token until the liquidity
+	// pool can sell a minimum amount of the other token for it
+	PlaceLimitOrder(context.Context, *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error)
+	// CancelLimitOrder defines a method for cancelling an open limit order and
+	// refunding its escrowed token
+	CancelLimitOrder(context.Context, *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error)
+	// CreatePosition defines a method for providing liquidity to a concentrated
+	// liquidity pool between two ticks, creating the pool if it does not exist
+	CreatePosition(context.Context, *MsgCreatePosition) (*MsgCreatePositionResponse, error)
+	// IncreasePosition defines a method for adding liquidity to a position
+	IncreasePosition(context.Context, *MsgIncreasePosition) (*MsgIncreasePositionResponse, error)
+	// DecreasePosition defines a method for removing liquidity from a position
+	DecreasePosition(context.Context, *MsgDecreasePosition) (*MsgDecreasePositionResponse, error)
+	// CollectFees defines a method for withdrawing the swap fees earned by a
+	// position
+	CollectFees(context.Context, *MsgCollectFees) (*MsgCollectFeesResponse, error)
+	// CreateGauge defines a method for funding rewards distributed to the locks
+	// of a liquidity pool coin over a number of epochs
+	CreateGauge(context.Context, *MsgCreateGauge) (*MsgCreateGaugeResponse, error)
+	// LockLiquidity defines a method for locking liquidity pool coins for a
+	// duration to earn the rewards of the gauges of their denom
+	LockLiquidity(context.Context, *MsgLockLiquidity) (*MsgLockLiquidityResponse, error)
+	// UnlockLiquidity defines a method for withdrawing the coins of a lock past
+	// its duration along with its rewards
+	UnlockLiquidity(context.Context, *MsgUnlockLiquidity) (*MsgUnlockLiquidityResponse, error)
+	// ClaimLockRewards defines a method for withdrawing the rewards earned by a
+	// lock
+	ClaimLockRewards(context.Context, *MsgClaimLockRewards) (*MsgClaimLockRewardsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -123,9 +379,57 @@ func (UnimplementedMsgServer) RemoveLiquidity(context.Context, *MsgRemoveLiquidi
 func (UnimplementedMsgServer) SwapCoin(context.Context, *MsgSwapOrder) (*MsgSwapCoinResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SwapCoin not implemented")
 }
+func (UnimplementedMsgServer) AddLiquiditySingle(context.Context, *MsgAddLiquiditySingle) (*MsgAddLiquiditySingleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLiquiditySingle not implemented")
+}
+func (UnimplementedMsgServer) RemoveLiquiditySingle(context.Context, *MsgRemoveLiquiditySingle) (*MsgRemoveLiquiditySingleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLiquiditySingle not implemented")
+}
+func (UnimplementedMsgServer) SwapExactAmountInRoute(context.Context, *MsgSwapExactAmountInRoute) (*MsgSwapExactAmountInRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapExactAmountInRoute not implemented")
+}
+func (UnimplementedMsgServer) SwapExactAmountOutRoute(context.Context, *MsgSwapExactAmountOutRoute) (*MsgSwapExactAmountOutRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapExactAmountOutRoute not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) UpdatePoolParams(context.Context, *MsgUpdatePoolParams) (*MsgUpdatePoolParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePoolParams not implemented")
+}
+func (UnimplementedMsgServer) SetPoolStatus(context.Context, *MsgSetPoolStatus) (*MsgSetPoolStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPoolStatus not implemented")
+}
+func (UnimplementedMsgServer) PlaceLimitOrder(context.Context, *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceLimitOrder not implemented")
+}
+func (UnimplementedMsgServer) CancelLimitOrder(context.Context, *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLimitOrder not implemented")
+}
+func (UnimplementedMsgServer) CreatePosition(context.Context, *MsgCreatePosition) (*MsgCreatePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePosition not implemented")
+}
+func (UnimplementedMsgServer) IncreasePosition(context.Context, *MsgIncreasePosition) (*MsgIncreasePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncreasePosition not implemented")
+}
+func (UnimplementedMsgServer) DecreasePosition(context.Context, *MsgDecreasePosition) (*MsgDecreasePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecreasePosition not implemented")
+}
+func (UnimplementedMsgServer) CollectFees(context.Context, *MsgCollectFees) (*MsgCollectFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectFees not implemented")
+}
+func (UnimplementedMsgServer) CreateGauge(context.Context, *MsgCreateGauge) (*MsgCreateGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGauge not implemented")
+}
+func (UnimplementedMsgServer) LockLiquidity(context.Context, *MsgLockLiquidity) (*MsgLockLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockLiquidity not implemented")
+}
+func (UnimplementedMsgServer) UnlockLiquidity(context.Context, *MsgUnlockLiquidity) (*MsgUnlockLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockLiquidity not implemented")
+}
+func (UnimplementedMsgServer) ClaimLockRewards(context.Context, *MsgClaimLockRewards) (*MsgClaimLockRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimLockRewards not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -193,6 +497,78 @@ func _Msg_SwapCoin_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddLiquiditySingle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddLiquiditySingle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddLiquiditySingle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddLiquiditySingle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddLiquiditySingle(ctx, req.(*MsgAddLiquiditySingle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveLiquiditySingle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveLiquiditySingle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveLiquiditySingle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveLiquiditySingle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveLiquiditySingle(ctx, req.(*MsgRemoveLiquiditySingle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SwapExactAmountInRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapExactAmountInRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapExactAmountInRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SwapExactAmountInRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapExactAmountInRoute(ctx, req.(*MsgSwapExactAmountInRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SwapExactAmountOutRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapExactAmountOutRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapExactAmountOutRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SwapExactAmountOutRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapExactAmountOutRoute(ctx, req.(*MsgSwapExactAmountOutRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -211,6 +587,222 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdatePoolParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePoolParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePoolParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdatePoolParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePoolParams(ctx, req.(*MsgUpdatePoolParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPoolStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPoolStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPoolStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetPoolStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPoolStatus(ctx, req.(*MsgSetPoolStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PlaceLimitOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceLimitOrder(ctx, req.(*MsgPlaceLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelLimitOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelLimitOrder(ctx, req.(*MsgCancelLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreatePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreatePosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePosition(ctx, req.(*MsgCreatePosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_IncreasePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIncreasePosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IncreasePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_IncreasePosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IncreasePosition(ctx, req.(*MsgIncreasePosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DecreasePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDecreasePosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DecreasePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DecreasePosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DecreasePosition(ctx, req.(*MsgDecreasePosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CollectFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCollectFees)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CollectFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CollectFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CollectFees(ctx, req.(*MsgCollectFees))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateGauge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateGauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateGauge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateGauge(ctx, req.(*MsgCreateGauge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LockLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockLiquidity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LockLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_LockLiquidity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockLiquidity(ctx, req.(*MsgLockLiquidity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnlockLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnlockLiquidity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnlockLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnlockLiquidity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnlockLiquidity(ctx, req.(*MsgUnlockLiquidity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimLockRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimLockRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimLockRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimLockRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimLockRewards(ctx, req.(*MsgClaimLockRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -230,10 +822,74 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SwapCoin",
 			Handler:    _Msg_SwapCoin_Handler,
 		},
+		{
+			MethodName: "AddLiquiditySingle",
+			Handler:    _Msg_AddLiquiditySingle_Handler,
+		},
+		{
+			MethodName: "RemoveLiquiditySingle",
+			Handler:    _Msg_RemoveLiquiditySingle_Handler,
+		},
+		{
+			MethodName: "SwapExactAmountInRoute",
+			Handler:    _Msg_SwapExactAmountInRoute_Handler,
+		},
+		{
+			MethodName: "SwapExactAmountOutRoute",
+			Handler:    _Msg_SwapExactAmountOutRoute_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdatePoolParams",
+			Handler:    _Msg_UpdatePoolParams_Handler,
+		},
+		{
+			MethodName: "SetPoolStatus",
+			Handler:    _Msg_SetPoolStatus_Handler,
+		},
+		{
+			MethodName: "PlaceLimitOrder",
+			Handler:    _Msg_PlaceLimitOrder_Handler,
+		},
+		{
+			MethodName: "CancelLimitOrder",
+			Handler:    _Msg_CancelLimitOrder_Handler,
+		},
+		{
+			MethodName: "CreatePosition",
+			Handler:    _Msg_CreatePosition_Handler,
+		},
+		{
+			MethodName: "IncreasePosition",
+			Handler:    _Msg_IncreasePosition_Handler,
+		},
+		{
+			MethodName: "DecreasePosition",
+			Handler:    _Msg_DecreasePosition_Handler,
+		},
+		{
+			MethodName: "CollectFees",
+			Handler:    _Msg_CollectFees_Handler,
+		},
+		{
+			MethodName: "CreateGauge",
+			Handler:    _Msg_CreateGauge_Handler,
+		},
+		{
+			MethodName: "LockLiquidity",
+			Handler:    _Msg_LockLiquidity_Handler,
+		},
+		{
+			MethodName: "UnlockLiquidity",
+			Handler:    _Msg_UnlockLiquidity_Handler,
+		},
+		{
+			MethodName: "ClaimLockRewards",
+			Handler:    _Msg_ClaimLockRewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "canto/coinswap/v1/tx.proto",
@@ -64,11 +64,219 @@ func (x *_GenesisState_2_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*CSRRevenue
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CSRRevenue)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CSRRevenue)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(CSRRevenue)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(CSRRevenue)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*CSRRevenue
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CSRRevenue)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CSRRevenue)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(CSRRevenue)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(CSRRevenue)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*CSRShareOverride
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CSRShareOverride)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CSRShareOverride)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(CSRShareOverride)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(CSRShareOverride)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*CSRPendingFee
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CSRPendingFee)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CSRPendingFee)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(CSRPendingFee)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(CSRPendingFee)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_GenesisState                   protoreflect.MessageDescriptor
 	fd_GenesisState_params            protoreflect.FieldDescriptor
 	fd_GenesisState_csrs              protoreflect.FieldDescriptor
 	fd_GenesisState_turnstile_address protoreflect.FieldDescriptor
+	fd_GenesisState_revenue_history   protoreflect.FieldDescriptor
+	fd_GenesisState_current_revenues  protoreflect.FieldDescriptor
+	fd_GenesisState_share_overrides   protoreflect.FieldDescriptor
+	fd_GenesisState_pending_fees      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -77,6 +285,10 @@ func init() {
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_csrs = md_GenesisState.Fields().ByName("csrs")
 	fd_GenesisState_turnstile_address = md_GenesisState.Fields().ByName("turnstile_address")
+	fd_GenesisState_revenue_history = md_GenesisState.Fields().ByName("revenue_history")
+	fd_GenesisState_current_revenues = md_GenesisState.Fields().ByName("current_revenues")
+	fd_GenesisState_share_overrides = md_GenesisState.Fields().ByName("share_overrides")
+	fd_GenesisState_pending_fees = md_GenesisState.Fields().ByName("pending_fees")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -162,6 +374,30 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.RevenueHistory) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.RevenueHistory})
+		if !f(fd_GenesisState_revenue_history, value) {
+			return
+		}
+	}
+	if len(x.CurrentRevenues) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.CurrentRevenues})
+		if !f(fd_GenesisState_current_revenues, value) {
+			return
+		}
+	}
+	if len(x.ShareOverrides) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.ShareOverrides})
+		if !f(fd_GenesisState_share_overrides, value) {
+			return
+		}
+	}
+	if len(x.PendingFees) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.PendingFees})
+		if !f(fd_GenesisState_pending_fees, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -183,6 +419,14 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.Csrs) != 0
 	case "canto.csr.v1.GenesisState.turnstile_address":
 		return x.TurnstileAddress != ""
+	case "canto.csr.v1.GenesisState.revenue_history":
+		return len(x.RevenueHistory) != 0
+	case "canto.csr.v1.GenesisState.current_revenues":
+		return len(x.CurrentRevenues) != 0
+	case "canto.csr.v1.GenesisState.share_overrides":
+		return len(x.ShareOverrides) != 0
+	case "canto.csr.v1.GenesisState.pending_fees":
+		return len(x.PendingFees) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.csr.v1.GenesisState"))
@@ -205,6 +449,14 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Csrs = nil
 	case "canto.csr.v1.GenesisState.turnstile_address":
 		x.TurnstileAddress = ""
+	case "canto.csr.v1.GenesisState.revenue_history":
+		x.RevenueHistory = nil
+	case "canto.csr.v1.GenesisState.current_revenues":
+		x.CurrentRevenues = nil
+	case "canto.csr.v1.GenesisState.share_overrides":
+		x.ShareOverrides = nil
+	case "canto.csr.v1.GenesisState.pending_fees":
+		x.PendingFees = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.csr.v1.GenesisState"))
@@ -233,6 +485,30 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "canto.csr.v1.GenesisState.turnstile_address":
 		value := x.TurnstileAddress
 		return protoreflect.ValueOfString(value)
+	case "canto.csr.v1.GenesisState.revenue_history":
+		if len(x.RevenueHistory) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.RevenueHistory}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.csr.v1.GenesisState.current_revenues":
+		if len(x.CurrentRevenues) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.CurrentRevenues}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.csr.v1.GenesisState.share_overrides":
+		if len(x.ShareOverrides) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.ShareOverrides}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.csr.v1.GenesisState.pending_fees":
+		if len(x.PendingFees) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.PendingFees}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.csr.v1.GenesisState"))
@@ -261,6 +537,22 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.Csrs = *clv.list
 	case "canto.csr.v1.GenesisState.turnstile_address":
 		x.TurnstileAddress = value.Interface().(string)
+	case "canto.csr.v1.GenesisState.revenue_history":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.RevenueHistory = *clv.list
+	case "canto.csr.v1.GenesisState.current_revenues":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.CurrentRevenues = *clv.list
+	case "canto.csr.v1.GenesisState.share_overrides":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.ShareOverrides = *clv.list
+	case "canto.csr.v1.GenesisState.pending_fees":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.PendingFees = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.csr.v1.GenesisState"))
@@ -292,6 +584,30 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_2_list{list: &x.Csrs}
 		return protoreflect.ValueOfList(value)
+	case "canto.csr.v1.GenesisState.revenue_history":
+		if x.RevenueHistory == nil {
+			x.RevenueHistory = []*CSRRevenue{}
+		}
+		value := &_GenesisState_4_list{list: &x.RevenueHistory}
+		return protoreflect.ValueOfList(value)
+	case "canto.csr.v1.GenesisState.current_revenues":
+		if x.CurrentRevenues == nil {
+			x.CurrentRevenues = []*CSRRevenue{}
+		}
+		value := &_GenesisState_5_list{list: &x.CurrentRevenues}
+		return protoreflect.ValueOfList(value)
+	case "canto.csr.v1.GenesisState.share_overrides":
+		if x.ShareOverrides == nil {
+			x.ShareOverrides = []*CSRShareOverride{}
+		}
+		value := &_GenesisState_6_list{list: &x.ShareOverrides}
+		return protoreflect.ValueOfList(value)
+	case "canto.csr.v1.GenesisState.pending_fees":
+		if x.PendingFees == nil {
+			x.PendingFees = []*CSRPendingFee{}
+		}
+		value := &_GenesisState_7_list{list: &x.PendingFees}
+		return protoreflect.ValueOfList(value)
 	case "canto.csr.v1.GenesisState.turnstile_address":
 		panic(fmt.Errorf("field turnstile_address of message canto.csr.v1.GenesisState is not mutable"))
 	default:
@@ -315,6 +631,18 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
 	case "canto.csr.v1.GenesisState.turnstile_address":
 		return protoreflect.ValueOfString("")
+	case "canto.csr.v1.GenesisState.revenue_history":
+		list := []*CSRRevenue{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "canto.csr.v1.GenesisState.current_revenues":
+		list := []*CSRRevenue{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "canto.csr.v1.GenesisState.share_overrides":
+		list := []*CSRShareOverride{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "canto.csr.v1.GenesisState.pending_fees":
+		list := []*CSRPendingFee{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.csr.v1.GenesisState"))
@@ -398,6 +726,30 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.RevenueHistory) > 0 {
+			for _, e := range x.RevenueHistory {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CurrentRevenues) > 0 {
+			for _, e := range x.CurrentRevenues {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ShareOverrides) > 0 {
+			for _, e := range x.ShareOverrides {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PendingFees) > 0 {
+			for _, e := range x.PendingFees {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -427,6 +779,70 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.PendingFees) > 0 {
+			for iNdEx := len(x.PendingFees) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PendingFees[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.ShareOverrides) > 0 {
+			for iNdEx := len(x.ShareOverrides) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ShareOverrides[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.CurrentRevenues) > 0 {
+			for iNdEx := len(x.CurrentRevenues) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CurrentRevenues[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.RevenueHistory) > 0 {
+			for iNdEx := len(x.RevenueHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RevenueHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if len(x.TurnstileAddress) > 0 {
 			i -= len(x.TurnstileAddress)
 			copy(dAtA[i:], x.TurnstileAddress)
@@ -615,6 +1031,142 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				}
 				x.TurnstileAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevenueHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RevenueHistory = append(x.RevenueHistory, &CSRRevenue{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RevenueHistory[len(x.RevenueHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrentRevenues", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CurrentRevenues = append(x.CurrentRevenues, &CSRRevenue{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CurrentRevenues[len(x.CurrentRevenues)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ShareOverrides", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ShareOverrides = append(x.ShareOverrides, &CSRShareOverride{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ShareOverrides[len(x.ShareOverrides)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingFees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingFees = append(x.PendingFees, &CSRPendingFee{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingFees[len(x.PendingFees)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -673,6 +1225,14 @@ type GenesisState struct {
 	Params           *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
 	Csrs             []*CSR  `protobuf:"bytes,2,rep,name=csrs,proto3" json:"csrs,omitempty"`
 	TurnstileAddress string  `protobuf:"bytes,3,opt,name=turnstile_address,json=turnstileAddress,proto3" json:"turnstile_address,omitempty"`
+	// revenue_history is the revenue of every CSR NFT in past day epochs
+	RevenueHistory []*CSRRevenue `protobuf:"bytes,4,rep,name=revenue_history,json=revenueHistory,proto3" json:"revenue_history,omitempty"`
+	// current_revenues is the revenue of every CSR NFT in the ongoing day epoch
+	CurrentRevenues []*CSRRevenue `protobuf:"bytes,5,rep,name=current_revenues,json=currentRevenues,proto3" json:"current_revenues,omitempty"`
+	// share_overrides is the csr shares set by governance for CSR NFTs
+	ShareOverrides []*CSRShareOverride `protobuf:"bytes,6,rep,name=share_overrides,json=shareOverrides,proto3" json:"share_overrides,omitempty"`
+	// pending_fees is the csr fees not yet distributed to the Turnstile
+	PendingFees []*CSRPendingFee `protobuf:"bytes,7,rep,name=pending_fees,json=pendingFees,proto3" json:"pending_fees,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -716,6 +1276,34 @@ func (x *GenesisState) GetTurnstileAddress() string {
 	return ""
 }
 
+func (x *GenesisState) GetRevenueHistory() []*CSRRevenue {
+	if x != nil {
+		return x.RevenueHistory
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCurrentRevenues() []*CSRRevenue {
+	if x != nil {
+		return x.CurrentRevenues
+	}
+	return nil
+}
+
+func (x *GenesisState) GetShareOverrides() []*CSRShareOverride {
+	if x != nil {
+		return x.ShareOverrides
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPendingFees() []*CSRPendingFee {
+	if x != nil {
+		return x.PendingFees
+	}
+	return nil
+}
+
 var File_canto_csr_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_canto_csr_v1_genesis_proto_rawDesc = []byte{
@@ -726,7 +1314,7 @@ var file_canto_csr_v1_genesis_proto_rawDesc = []byte{
 	0x1a, 0x19, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x63, 0x73, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x70,
 	0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x63, 0x61, 0x6e,
 	0x74, 0x6f, 0x2f, 0x63, 0x73, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x73, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x9c, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
+	0x6f, 0x74, 0x6f, 0x22, 0xc5, 0x03, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
 	0x74, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x63, 0x73, 0x72,
 	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
@@ -736,17 +1324,35 @@ var file_canto_csr_v1_genesis_proto_rawDesc = []byte{
 	0x04, 0x63, 0x73, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x74, 0x69,
 	0x6c, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x10, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x74, 0x69, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x42, 0x97, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f,
-	0x2e, 0x63, 0x73, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2f,
-	0x63, 0x73, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x73, 0x72, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43,
-	0x43, 0x58, 0xaa, 0x02, 0x0c, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x43, 0x73, 0x72, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x0c, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x5c, 0x43, 0x73, 0x72, 0x5c, 0x56, 0x31,
-	0xe2, 0x02, 0x18, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x5c, 0x43, 0x73, 0x72, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x43, 0x61,
-	0x6e, 0x74, 0x6f, 0x3a, 0x3a, 0x43, 0x73, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x73, 0x12, 0x47, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x61,
+	0x6e, 0x74, 0x6f, 0x2e, 0x63, 0x73, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x53, 0x52, 0x52, 0x65,
+	0x76, 0x65, 0x6e, 0x75, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x49, 0x0a, 0x10, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x63, 0x73,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x53, 0x52, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x76, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x0f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f,
+	0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x63, 0x73, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x53, 0x52, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x4f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x61,
+	0x6e, 0x74, 0x6f, 0x2e, 0x63, 0x73, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x53, 0x52, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x73, 0x42, 0x97, 0x01, 0x0a, 0x10,
+	0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x63, 0x73, 0x72, 0x2e, 0x76, 0x31,
+	0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x63, 0x73, 0x72, 0x2f, 0x76, 0x31, 0x3b,
+	0x63, 0x73, 0x72, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x58, 0xaa, 0x02, 0x0c, 0x43, 0x61,
+	0x6e, 0x74, 0x6f, 0x2e, 0x43, 0x73, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x43, 0x61, 0x6e,
+	0x74, 0x6f, 0x5c, 0x43, 0x73, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x43, 0x61, 0x6e, 0x74,
+	0x6f, 0x5c, 0x43, 0x73, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x3a, 0x3a, 0x43, 0x73,
+	0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -763,18 +1369,25 @@ func file_canto_csr_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_canto_csr_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_canto_csr_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: canto.csr.v1.GenesisState
-	(*Params)(nil),       // 1: canto.csr.v1.Params
-	(*CSR)(nil),          // 2: canto.csr.v1.CSR
+	(*GenesisState)(nil),     // 0: canto.csr.v1.GenesisState
+	(*Params)(nil),           // 1: canto.csr.v1.Params
+	(*CSR)(nil),              // 2: canto.csr.v1.CSR
+	(*CSRRevenue)(nil),       // 3: canto.csr.v1.CSRRevenue
+	(*CSRShareOverride)(nil), // 4: canto.csr.v1.CSRShareOverride
+	(*CSRPendingFee)(nil),    // 5: canto.csr.v1.CSRPendingFee
 }
 var file_canto_csr_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: canto.csr.v1.GenesisState.params:type_name -> canto.csr.v1.Params
 	2, // 1: canto.csr.v1.GenesisState.csrs:type_name -> canto.csr.v1.CSR
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: canto.csr.v1.GenesisState.revenue_history:type_name -> canto.csr.v1.CSRRevenue
+	3, // 3: canto.csr.v1.GenesisState.current_revenues:type_name -> canto.csr.v1.CSRRevenue
+	4, // 4: canto.csr.v1.GenesisState.share_overrides:type_name -> canto.csr.v1.CSRShareOverride
+	5, // 5: canto.csr.v1.GenesisState.pending_fees:type_name -> canto.csr.v1.CSRPendingFee
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_canto_csr_v1_genesis_proto_init() }
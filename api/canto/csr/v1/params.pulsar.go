@@ -15,10 +15,67 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_4_list)(nil)
+
+type _Params_4_list struct {
+	list *[]*CSRShareTier
+}
+
+func (x *_Params_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CSRShareTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CSRShareTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_4_list) AppendMutable() protoreflect.Value {
+	v := new(CSRShareTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_4_list) NewElement() protoreflect.Value {
+	v := new(CSRShareTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params            protoreflect.MessageDescriptor
-	fd_Params_enable_csr protoreflect.FieldDescriptor
-	fd_Params_csr_shares protoreflect.FieldDescriptor
+	md_Params                            protoreflect.MessageDescriptor
+	fd_Params_enable_csr                 protoreflect.FieldDescriptor
+	fd_Params_csr_shares                 protoreflect.FieldDescriptor
+	fd_Params_multi_contract_attribution protoreflect.FieldDescriptor
+	fd_Params_csr_share_tiers            protoreflect.FieldDescriptor
+	fd_Params_csr_revenue_cap            protoreflect.FieldDescriptor
+	fd_Params_robust_fee_distribution    protoreflect.FieldDescriptor
+	fd_Params_revenue_history_epochs     protoreflect.FieldDescriptor
+	fd_Params_pending_fee_retry_budget   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -26,6 +83,12 @@ func init() {
 	md_Params = File_canto_csr_v1_params_proto.Messages().ByName("Params")
 	fd_Params_enable_csr = md_Params.Fields().ByName("enable_csr")
 	fd_Params_csr_shares = md_Params.Fields().ByName("csr_shares")
+	fd_Params_multi_contract_attribution = md_Params.Fields().ByName("multi_contract_attribution")
+	fd_Params_csr_share_tiers = md_Params.Fields().ByName("csr_share_tiers")
+	fd_Params_csr_revenue_cap = md_Params.Fields().ByName("csr_revenue_cap")
+	fd_Params_robust_fee_distribution = md_Params.Fields().ByName("robust_fee_distribution")
+	fd_Params_revenue_history_epochs = md_Params.Fields().ByName("revenue_history_epochs")
+	fd_Params_pending_fee_retry_budget = md_Params.Fields().ByName("pending_fee_retry_budget")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -105,6 +168,42 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MultiContractAttribution != false {
+		value := protoreflect.ValueOfBool(x.MultiContractAttribution)
+		if !f(fd_Params_multi_contract_attribution, value) {
+			return
+		}
+	}
+	if len(x.CsrShareTiers) != 0 {
+		value := protoreflect.ValueOfList(&_Params_4_list{list: &x.CsrShareTiers})
+		if !f(fd_Params_csr_share_tiers, value) {
+			return
+		}
+	}
+	if x.CsrRevenueCap != "" {
+		value := protoreflect.ValueOfString(x.CsrRevenueCap)
+		if !f(fd_Params_csr_revenue_cap, value) {
+			return
+		}
+	}
+	if x.RobustFeeDistribution != false {
+		value := protoreflect.ValueOfBool(x.RobustFeeDistribution)
+		if !f(fd_Params_robust_fee_distribution, value) {
+			return
+		}
+	}
+	if x.RevenueHistoryEpochs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RevenueHistoryEpochs)
+		if !f(fd_Params_revenue_history_epochs, value) {
+			return
+		}
+	}
+	if x.PendingFeeRetryBudget != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.PendingFeeRetryBudget)
+		if !f(fd_Params_pending_fee_retry_budget, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -124,6 +223,18 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.EnableCsr != false
 	case "canto.csr.v1.Params.csr_shares":
 		return x.CsrShares != ""
+	case "canto.csr.v1.Params.multi_contract_attribution":
+		return x.MultiContractAttribution != false
+	case "canto.csr.v1.Params.csr_share_tiers":
+		return len(x.CsrShareTiers) != 0
+	case "canto.csr.v1.Params.csr_revenue_cap":
+		return x.CsrRevenueCap != ""
+	case "canto.csr.v1.Params.robust_fee_distribution":
+		return x.RobustFeeDistribution != false
+	case "canto.csr.v1.Params.revenue_history_epochs":
+		return x.RevenueHistoryEpochs != uint64(0)
+	case "canto.csr.v1.Params.pending_fee_retry_budget":
+		return x.PendingFeeRetryBudget != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.csr.v1.Params"))
@@ -144,6 +255,18 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.EnableCsr = false
 	case "canto.csr.v1.Params.csr_shares":
 		x.CsrShares = ""
+	case "canto.csr.v1.Params.multi_contract_attribution":
+		x.MultiContractAttribution = false
+	case "canto.csr.v1.Params.csr_share_tiers":
+		x.CsrShareTiers = nil
+	case "canto.csr.v1.Params.csr_revenue_cap":
+		x.CsrRevenueCap = ""
+	case "canto.csr.v1.Params.robust_fee_distribution":
+		x.RobustFeeDistribution = false
+	case "canto.csr.v1.Params.revenue_history_epochs":
+		x.RevenueHistoryEpochs = uint64(0)
+	case "canto.csr.v1.Params.pending_fee_retry_budget":
+		x.PendingFeeRetryBudget = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.csr.v1.Params"))
@@ -166,6 +289,27 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "canto.csr.v1.Params.csr_shares":
 		value := x.CsrShares
 		return protoreflect.ValueOfString(value)
+	case "canto.csr.v1.Params.multi_contract_attribution":
+		value := x.MultiContractAttribution
+		return protoreflect.ValueOfBool(value)
+	case "canto.csr.v1.Params.csr_share_tiers":
+		if len(x.CsrShareTiers) == 0 {
+			return protoreflect.ValueOfList(&_Params_4_list{})
+		}
+		listValue := &_Params_4_list{list: &x.CsrShareTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.csr.v1.Params.csr_revenue_cap":
+		value := x.CsrRevenueCap
+		return protoreflect.ValueOfString(value)
+	case "canto.csr.v1.Params.robust_fee_distribution":
+		value := x.RobustFeeDistribution
+		return protoreflect.ValueOfBool(value)
+	case "canto.csr.v1.Params.revenue_history_epochs":
+		value := x.RevenueHistoryEpochs
+		return protoreflect.ValueOfUint64(value)
+	case "canto.csr.v1.Params.pending_fee_retry_budget":
+		value := x.PendingFeeRetryBudget
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.csr.v1.Params"))
@@ -190,6 +334,20 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.EnableCsr = value.Bool()
 	case "canto.csr.v1.Params.csr_shares":
 		x.CsrShares = value.Interface().(string)
+	case "canto.csr.v1.Params.multi_contract_attribution":
+		x.MultiContractAttribution = value.Bool()
+	case "canto.csr.v1.Params.csr_share_tiers":
+		lv := value.List()
+		clv := lv.(*_Params_4_list)
+		x.CsrShareTiers = *clv.list
+	case "canto.csr.v1.Params.csr_revenue_cap":
+		x.CsrRevenueCap = value.Interface().(string)
+	case "canto.csr.v1.Params.robust_fee_distribution":
+		x.RobustFeeDistribution = value.Bool()
+	case "canto.csr.v1.Params.revenue_history_epochs":
+		x.RevenueHistoryEpochs = value.Uint()
+	case "canto.csr.v1.Params.pending_fee_retry_budget":
+		x.PendingFeeRetryBudget = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.csr.v1.Params"))
@@ -210,10 +368,26 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "canto.csr.v1.Params.csr_share_tiers":
+		if x.CsrShareTiers == nil {
+			x.CsrShareTiers = []*CSRShareTier{}
+		}
+		value := &_Params_4_list{list: &x.CsrShareTiers}
+		return protoreflect.ValueOfList(value)
 	case "canto.csr.v1.Params.enable_csr":
 		panic(fmt.Errorf("field enable_csr of message canto.csr.v1.Params is not mutable"))
 	case "canto.csr.v1.Params.csr_shares":
 		panic(fmt.Errorf("field csr_shares of message canto.csr.v1.Params is not mutable"))
+	case "canto.csr.v1.Params.multi_contract_attribution":
+		panic(fmt.Errorf("field multi_contract_attribution of message canto.csr.v1.Params is not mutable"))
+	case "canto.csr.v1.Params.csr_revenue_cap":
+		panic(fmt.Errorf("field csr_revenue_cap of message canto.csr.v1.Params is not mutable"))
+	case "canto.csr.v1.Params.robust_fee_distribution":
+		panic(fmt.Errorf("field robust_fee_distribution of message canto.csr.v1.Params is not mutable"))
+	case "canto.csr.v1.Params.revenue_history_epochs":
+		panic(fmt.Errorf("field revenue_history_epochs of message canto.csr.v1.Params is not mutable"))
+	case "canto.csr.v1.Params.pending_fee_retry_budget":
+		panic(fmt.Errorf("field pending_fee_retry_budget of message canto.csr.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.csr.v1.Params"))
@@ -231,6 +405,19 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfBool(false)
 	case "canto.csr.v1.Params.csr_shares":
 		return protoreflect.ValueOfString("")
+	case "canto.csr.v1.Params.multi_contract_attribution":
+		return protoreflect.ValueOfBool(false)
+	case "canto.csr.v1.Params.csr_share_tiers":
+		list := []*CSRShareTier{}
+		return protoreflect.ValueOfList(&_Params_4_list{list: &list})
+	case "canto.csr.v1.Params.csr_revenue_cap":
+		return protoreflect.ValueOfString("")
+	case "canto.csr.v1.Params.robust_fee_distribution":
+		return protoreflect.ValueOfBool(false)
+	case "canto.csr.v1.Params.revenue_history_epochs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "canto.csr.v1.Params.pending_fee_retry_budget":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.csr.v1.Params"))
@@ -307,6 +494,28 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.MultiContractAttribution {
+			n += 2
+		}
+		if len(x.CsrShareTiers) > 0 {
+			for _, e := range x.CsrShareTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.CsrRevenueCap)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RobustFeeDistribution {
+			n += 2
+		}
+		if x.RevenueHistoryEpochs != 0 {
+			n += 1 + runtime.Sov(uint64(x.RevenueHistoryEpochs))
+		}
+		if x.PendingFeeRetryBudget != 0 {
+			n += 1 + runtime.Sov(uint64(x.PendingFeeRetryBudget))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -336,6 +545,59 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.PendingFeeRetryBudget != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PendingFeeRetryBudget))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.RevenueHistoryEpochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RevenueHistoryEpochs))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.RobustFeeDistribution {
+			i--
+			if x.RobustFeeDistribution {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.CsrRevenueCap) > 0 {
+			i -= len(x.CsrRevenueCap)
+			copy(dAtA[i:], x.CsrRevenueCap)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CsrRevenueCap)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.CsrShareTiers) > 0 {
+			for iNdEx := len(x.CsrShareTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CsrShareTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.MultiContractAttribution {
+			i--
+			if x.MultiContractAttribution {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
 		if len(x.CsrShares) > 0 {
 			i -= len(x.CsrShares)
 			copy(dAtA[i:], x.CsrShares)
@@ -454,6 +716,150 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.CsrShares = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultiContractAttribution", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.MultiContractAttribution = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CsrShareTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CsrShareTiers = append(x.CsrShareTiers, &CSRShareTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CsrShareTiers[len(x.CsrShareTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CsrRevenueCap", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CsrRevenueCap = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RobustFeeDistribution", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RobustFeeDistribution = bool(v != 0)
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevenueHistoryEpochs", wireType)
+				}
+				x.RevenueHistoryEpochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RevenueHistoryEpochs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingFeeRetryBudget", wireType)
+				}
+				x.PendingFeeRetryBudget = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PendingFeeRetryBudget |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -489,60 +895,659 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: canto/csr/v1/params.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_CSRShareTier                   protoreflect.MessageDescriptor
+	fd_CSRShareTier_revenue_threshold protoreflect.FieldDescriptor
+	fd_CSRShareTier_csr_shares        protoreflect.FieldDescriptor
 )
 
-// Params holds parameters for the csr module
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_canto_csr_v1_params_proto_init()
+	md_CSRShareTier = File_canto_csr_v1_params_proto.Messages().ByName("CSRShareTier")
+	fd_CSRShareTier_revenue_threshold = md_CSRShareTier.Fields().ByName("revenue_threshold")
+	fd_CSRShareTier_csr_shares = md_CSRShareTier.Fields().ByName("csr_shares")
+}
 
-	// boolean to enable the csr module
-	EnableCsr bool `protobuf:"varint,1,opt,name=enable_csr,json=enableCsr,proto3" json:"enable_csr,omitempty"`
-	// decimal to determine the transaction fee split between network operators
-	// (validators) and CSR
-	CsrShares string `protobuf:"bytes,2,opt,name=csr_shares,json=csrShares,proto3" json:"csr_shares,omitempty"`
+var _ protoreflect.Message = (*fastReflection_CSRShareTier)(nil)
+
+type fastReflection_CSRShareTier CSRShareTier
+
+func (x *CSRShareTier) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CSRShareTier)(x)
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_canto_csr_v1_params_proto_msgTypes[0]
+func (x *CSRShareTier) slowProtoReflect() protoreflect.Message {
+	mi := &file_canto_csr_v1_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_CSRShareTier_messageType fastReflection_CSRShareTier_messageType
+var _ protoreflect.MessageType = fastReflection_CSRShareTier_messageType{}
 
-func (*Params) ProtoMessage() {}
+type fastReflection_CSRShareTier_messageType struct{}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_canto_csr_v1_params_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_CSRShareTier_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CSRShareTier)(nil)
+}
+func (x fastReflection_CSRShareTier_messageType) New() protoreflect.Message {
+	return new(fastReflection_CSRShareTier)
+}
+func (x fastReflection_CSRShareTier_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CSRShareTier
 }
 
-func (x *Params) GetEnableCsr() bool {
-	if x != nil {
-		return x.EnableCsr
-	}
-	return false
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CSRShareTier) Descriptor() protoreflect.MessageDescriptor {
+	return md_CSRShareTier
 }
 
-func (x *Params) GetCsrShares() string {
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CSRShareTier) Type() protoreflect.MessageType {
+	return _fastReflection_CSRShareTier_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CSRShareTier) New() protoreflect.Message {
+	return new(fastReflection_CSRShareTier)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CSRShareTier) Interface() protoreflect.ProtoMessage {
+	return (*CSRShareTier)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CSRShareTier) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RevenueThreshold != "" {
+		value := protoreflect.ValueOfString(x.RevenueThreshold)
+		if !f(fd_CSRShareTier_revenue_threshold, value) {
+			return
+		}
+	}
+	if x.CsrShares != "" {
+		value := protoreflect.ValueOfString(x.CsrShares)
+		if !f(fd_CSRShareTier_csr_shares, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CSRShareTier) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "canto.csr.v1.CSRShareTier.revenue_threshold":
+		return x.RevenueThreshold != ""
+	case "canto.csr.v1.CSRShareTier.csr_shares":
+		return x.CsrShares != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.csr.v1.CSRShareTier"))
+		}
+		panic(fmt.Errorf("message canto.csr.v1.CSRShareTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CSRShareTier) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "canto.csr.v1.CSRShareTier.revenue_threshold":
+		x.RevenueThreshold = ""
+	case "canto.csr.v1.CSRShareTier.csr_shares":
+		x.CsrShares = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.csr.v1.CSRShareTier"))
+		}
+		panic(fmt.Errorf("message canto.csr.v1.CSRShareTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CSRShareTier) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "canto.csr.v1.CSRShareTier.revenue_threshold":
+		value := x.RevenueThreshold
+		return protoreflect.ValueOfString(value)
+	case "canto.csr.v1.CSRShareTier.csr_shares":
+		value := x.CsrShares
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.csr.v1.CSRShareTier"))
+		}
+		panic(fmt.Errorf("message canto.csr.v1.CSRShareTier does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CSRShareTier) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "canto.csr.v1.CSRShareTier.revenue_threshold":
+		x.RevenueThreshold = value.Interface().(string)
+	case "canto.csr.v1.CSRShareTier.csr_shares":
+		x.CsrShares = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.csr.v1.CSRShareTier"))
+		}
+		panic(fmt.Errorf("message canto.csr.v1.CSRShareTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CSRShareTier) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "canto.csr.v1.CSRShareTier.revenue_threshold":
+		panic(fmt.Errorf("field revenue_threshold of message canto.csr.v1.CSRShareTier is not mutable"))
+	case "canto.csr.v1.CSRShareTier.csr_shares":
+		panic(fmt.Errorf("field csr_shares of message canto.csr.v1.CSRShareTier is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.csr.v1.CSRShareTier"))
+		}
+		panic(fmt.Errorf("message canto.csr.v1.CSRShareTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CSRShareTier) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "canto.csr.v1.CSRShareTier.revenue_threshold":
+		return protoreflect.ValueOfString("")
+	case "canto.csr.v1.CSRShareTier.csr_shares":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.csr.v1.CSRShareTier"))
+		}
+		panic(fmt.Errorf("message canto.csr.v1.CSRShareTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CSRShareTier) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in canto.csr.v1.CSRShareTier", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CSRShareTier) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CSRShareTier) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CSRShareTier) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CSRShareTier) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CSRShareTier)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.RevenueThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CsrShares)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CSRShareTier)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.CsrShares) > 0 {
+			i -= len(x.CsrShares)
+			copy(dAtA[i:], x.CsrShares)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CsrShares)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.RevenueThreshold) > 0 {
+			i -= len(x.RevenueThreshold)
+			copy(dAtA[i:], x.RevenueThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RevenueThreshold)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CSRShareTier)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CSRShareTier: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CSRShareTier: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevenueThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RevenueThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CsrShares", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CsrShares = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: canto/csr/v1/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Params holds parameters for the csr module
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// boolean to enable the csr module
+	EnableCsr bool `protobuf:"varint,1,opt,name=enable_csr,json=enableCsr,proto3" json:"enable_csr,omitempty"`
+	// decimal to determine the transaction fee split between network operators
+	// (validators) and CSR
+	CsrShares string `protobuf:"bytes,2,opt,name=csr_shares,json=csrShares,proto3" json:"csr_shares,omitempty"`
+	// boolean to split the csr fee of a transaction across every registered
+	// contract touched by it, instead of crediting the called contract only. The
+	// touched contracts are the called contract and the contracts emitting events,
+	// as the call trace is not available to the EVM hooks, weighted by the gas of
+	// their events and the rest of the gas of the transaction for the called
+	// contract
+	MultiContractAttribution bool `protobuf:"varint,3,opt,name=multi_contract_attribution,json=multiContractAttribution,proto3" json:"multi_contract_attribution,omitempty"`
+	// tiers replacing csr_shares for the NFTs whose revenue in the ongoing day
+	// epoch reached their threshold, by increasing threshold and non-increasing
+	// csr shares of at most csr_shares
+	CsrShareTiers []*CSRShareTier `protobuf:"bytes,4,rep,name=csr_share_tiers,json=csrShareTiers,proto3" json:"csr_share_tiers,omitempty"`
+	// maximum revenue of an NFT in a day epoch, beyond which its csr fee is
+	// burned, or zero for no cap
+	CsrRevenueCap string `protobuf:"bytes,5,opt,name=csr_revenue_cap,json=csrRevenueCap,proto3" json:"csr_revenue_cap,omitempty"`
+	// boolean to keep the csr fees the Turnstile failed to take in as pending
+	// fees of their NFT, retried at the end of every block, instead of reverting
+	// the transaction. Failed or reverted transactions are out of scope, as the
+	// EVM hooks only run for successful transactions
+	RobustFeeDistribution bool `protobuf:"varint,6,opt,name=robust_fee_distribution,json=robustFeeDistribution,proto3" json:"robust_fee_distribution,omitempty"`
+	// number of past day epochs whose revenues are kept in the revenue history,
+	// or zero to keep the whole history
+	RevenueHistoryEpochs uint64 `protobuf:"varint,7,opt,name=revenue_history_epochs,json=revenueHistoryEpochs,proto3" json:"revenue_history_epochs,omitempty"`
+	// maximum number of pending fees retried at the end of a block, resuming
+	// after the last NFT retried in the previous block, or zero to stop retrying
+	PendingFeeRetryBudget uint32 `protobuf:"varint,8,opt,name=pending_fee_retry_budget,json=pendingFeeRetryBudget,proto3" json:"pending_fee_retry_budget,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_canto_csr_v1_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_canto_csr_v1_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetEnableCsr() bool {
+	if x != nil {
+		return x.EnableCsr
+	}
+	return false
+}
+
+func (x *Params) GetCsrShares() string {
+	if x != nil {
+		return x.CsrShares
+	}
+	return ""
+}
+
+func (x *Params) GetMultiContractAttribution() bool {
+	if x != nil {
+		return x.MultiContractAttribution
+	}
+	return false
+}
+
+func (x *Params) GetCsrShareTiers() []*CSRShareTier {
+	if x != nil {
+		return x.CsrShareTiers
+	}
+	return nil
+}
+
+func (x *Params) GetCsrRevenueCap() string {
+	if x != nil {
+		return x.CsrRevenueCap
+	}
+	return ""
+}
+
+func (x *Params) GetRobustFeeDistribution() bool {
+	if x != nil {
+		return x.RobustFeeDistribution
+	}
+	return false
+}
+
+func (x *Params) GetRevenueHistoryEpochs() uint64 {
+	if x != nil {
+		return x.RevenueHistoryEpochs
+	}
+	return 0
+}
+
+func (x *Params) GetPendingFeeRetryBudget() uint32 {
+	if x != nil {
+		return x.PendingFeeRetryBudget
+	}
+	return 0
+}
+
+// CSRShareTier is the share of the transaction fees distributed to the NFTs
+// whose revenue in the ongoing day epoch reached a threshold
+type CSRShareTier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// revenue of an NFT in the ongoing day epoch from which the tier applies
+	RevenueThreshold string `protobuf:"bytes,1,opt,name=revenue_threshold,json=revenueThreshold,proto3" json:"revenue_threshold,omitempty"`
+	// decimal to determine the transaction fee split between network operators
+	// (validators) and CSR for the NFTs of the tier
+	CsrShares string `protobuf:"bytes,2,opt,name=csr_shares,json=csrShares,proto3" json:"csr_shares,omitempty"`
+}
+
+func (x *CSRShareTier) Reset() {
+	*x = CSRShareTier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_canto_csr_v1_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CSRShareTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CSRShareTier) ProtoMessage() {}
+
+// Deprecated: Use CSRShareTier.ProtoReflect.Descriptor instead.
+func (*CSRShareTier) Descriptor() ([]byte, []int) {
+	return file_canto_csr_v1_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CSRShareTier) GetRevenueThreshold() string {
+	if x != nil {
+		return x.RevenueThreshold
+	}
+	return ""
+}
+
+func (x *CSRShareTier) GetCsrShares() string {
 	if x != nil {
 		return x.CsrShares
 	}
@@ -558,7 +1563,7 @@ var file_canto_csr_v1_params_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e,
-	0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x97, 0x01,
+	0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa5, 0x04,
 	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x61, 0x62,
 	0x6c, 0x65, 0x5f, 0x63, 0x73, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x6e,
 	0x61, 0x62, 0x6c, 0x65, 0x43, 0x73, 0x72, 0x12, 0x55, 0x0a, 0x0a, 0x63, 0x73, 0x72, 0x5f, 0x73,
@@ -566,19 +1571,58 @@ var file_canto_csr_v1_params_proto_rawDesc = []byte{
 	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
 	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
 	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7,
-	0xb0, 0x2a, 0x01, 0x52, 0x09, 0x63, 0x73, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x3a, 0x17,
-	0x8a, 0xe7, 0xb0, 0x2a, 0x12, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x78, 0x2f, 0x63, 0x73, 0x72,
-	0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x96, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e,
-	0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x63, 0x73, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x61,
-	0x6e, 0x74, 0x6f, 0x2f, 0x63, 0x73, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x73, 0x72, 0x76, 0x31,
-	0xa2, 0x02, 0x03, 0x43, 0x43, 0x58, 0xaa, 0x02, 0x0c, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x43,
-	0x73, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x5c, 0x43, 0x73,
-	0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x5c, 0x43, 0x73, 0x72,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x0e, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x3a, 0x3a, 0x43, 0x73, 0x72, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0xb0, 0x2a, 0x01, 0x52, 0x09, 0x63, 0x73, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x3c,
+	0x0a, 0x1a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x18, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0f,
+	0x63, 0x73, 0x72, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x63, 0x73,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x53, 0x52, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x69, 0x65,
+	0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x63, 0x73,
+	0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x58, 0x0a, 0x0f, 0x63,
+	0x73, 0x72, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x63, 0x73, 0x72, 0x52, 0x65, 0x76, 0x65, 0x6e,
+	0x75, 0x65, 0x43, 0x61, 0x70, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x6f, 0x62, 0x75, 0x73, 0x74, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x72, 0x6f, 0x62, 0x75, 0x73, 0x74, 0x46, 0x65,
+	0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a,
+	0x16, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x72,
+	0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x66,
+	0x65, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x65,
+	0x65, 0x52, 0x65, 0x74, 0x72, 0x79, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x3a, 0x17, 0x8a, 0xe7,
+	0xb0, 0x2a, 0x12, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x78, 0x2f, 0x63, 0x73, 0x72, 0x2f, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xe3, 0x01, 0x0a, 0x0c, 0x43, 0x53, 0x52, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x54, 0x69, 0x65, 0x72, 0x12, 0x5d, 0x0a, 0x11, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x10, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x55, 0x0a, 0x0a, 0x63, 0x73, 0x72, 0x5f, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x09, 0x63, 0x73, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x3a, 0x1d, 0x8a, 0xe7,
+	0xb0, 0x2a, 0x18, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x78, 0x2f, 0x63, 0x73, 0x72, 0x2f, 0x43,
+	0x53, 0x52, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x69, 0x65, 0x72, 0x42, 0x96, 0x01, 0x0a, 0x10,
+	0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x63, 0x73, 0x72, 0x2e, 0x76, 0x31,
+	0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x63, 0x73, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x63,
+	0x73, 0x72, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x58, 0xaa, 0x02, 0x0c, 0x43, 0x61, 0x6e,
+	0x74, 0x6f, 0x2e, 0x43, 0x73, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x43, 0x61, 0x6e, 0x74,
+	0x6f, 0x5c, 0x43, 0x73, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x43, 0x61, 0x6e, 0x74, 0x6f,
+	0x5c, 0x43, 0x73, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x3a, 0x3a, 0x43, 0x73, 0x72,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -593,16 +1637,18 @@ func file_canto_csr_v1_params_proto_rawDescGZIP() []byte {
 	return file_canto_csr_v1_params_proto_rawDescData
 }
 
-var file_canto_csr_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_canto_csr_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_canto_csr_v1_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: canto.csr.v1.Params
+	(*Params)(nil),       // 0: canto.csr.v1.Params
+	(*CSRShareTier)(nil), // 1: canto.csr.v1.CSRShareTier
 }
 var file_canto_csr_v1_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: canto.csr.v1.Params.csr_share_tiers:type_name -> canto.csr.v1.CSRShareTier
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_canto_csr_v1_params_proto_init() }
@@ -623,6 +1669,18 @@ func file_canto_csr_v1_params_proto_init() {
 				return nil
 			}
 		}
+		file_canto_csr_v1_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CSRShareTier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -630,7 +1688,7 @@ func file_canto_csr_v1_params_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_canto_csr_v1_params_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,11 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName        = "/canto.csr.v1.Query/Params"
-	Query_CSRs_FullMethodName          = "/canto.csr.v1.Query/CSRs"
-	Query_CSRByNFT_FullMethodName      = "/canto.csr.v1.Query/CSRByNFT"
-	Query_CSRByContract_FullMethodName = "/canto.csr.v1.Query/CSRByContract"
-	Query_Turnstile_FullMethodName     = "/canto.csr.v1.Query/Turnstile"
+	Query_Params_FullMethodName            = "/canto.csr.v1.Query/Params"
+	Query_CSRs_FullMethodName              = "/canto.csr.v1.Query/CSRs"
+	Query_CSRByNFT_FullMethodName          = "/canto.csr.v1.Query/CSRByNFT"
+	Query_CSRByContract_FullMethodName     = "/canto.csr.v1.Query/CSRByContract"
+	Query_Turnstile_FullMethodName         = "/canto.csr.v1.Query/Turnstile"
+	Query_CSRRevenueHistory_FullMethodName = "/canto.csr.v1.Query/CSRRevenueHistory"
+	Query_TopCSRs_FullMethodName           = "/canto.csr.v1.Query/TopCSRs"
+	Query_PendingFees_FullMethodName       = "/canto.csr.v1.Query/PendingFees"
 )
 
 // QueryClient is the client API for Query service.
@@ -40,6 +43,12 @@ type QueryClient interface {
 	CSRByContract(ctx context.Context, in *QueryCSRByContractRequest, opts ...grpc.CallOption) (*QueryCSRByContractResponse, error)
 	// query the turnstile address
 	Turnstile(ctx context.Context, in *QueryTurnstileRequest, opts ...grpc.CallOption) (*QueryTurnstileResponse, error)
+	// query the revenue of a CSR NFT in past day epochs
+	CSRRevenueHistory(ctx context.Context, in *QueryCSRRevenueHistoryRequest, opts ...grpc.CallOption) (*QueryCSRRevenueHistoryResponse, error)
+	// query the CSR NFTs that earned the most revenue in a day epoch
+	TopCSRs(ctx context.Context, in *QueryTopCSRsRequest, opts ...grpc.CallOption) (*QueryTopCSRsResponse, error)
+	// query the csr fees not yet distributed to the Turnstile
+	PendingFees(ctx context.Context, in *QueryPendingFeesRequest, opts ...grpc.CallOption) (*QueryPendingFeesResponse, error)
 }
 
 type queryClient struct {
@@ -95,6 +104,33 @@ func (c *queryClient) Turnstile(ctx context.Context, in *QueryTurnstileRequest,
 	return out, nil
 }
 
+func (c *queryClient) CSRRevenueHistory(ctx context.Context, in *QueryCSRRevenueHistoryRequest, opts ...grpc.CallOption) (*QueryCSRRevenueHistoryResponse, error) {
+	out := new(QueryCSRRevenueHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_CSRRevenueHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TopCSRs(ctx context.Context, in *QueryTopCSRsRequest, opts ...grpc.CallOption) (*QueryTopCSRsResponse, error) {
+	out := new(QueryTopCSRsResponse)
+	err := c.cc.Invoke(ctx, Query_TopCSRs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingFees(ctx context.Context, in *QueryPendingFeesRequest, opts ...grpc.CallOption) (*QueryPendingFeesResponse, error) {
+	out := new(QueryPendingFeesResponse)
+	err := c.cc.Invoke(ctx, Query_PendingFees_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -109,6 +145,12 @@ type QueryServer interface {
 	CSRByContract(context.Context, *QueryCSRByContractRequest) (*QueryCSRByContractResponse, error)
 	// query the turnstile address
 	Turnstile(context.Context, *QueryTurnstileRequest) (*QueryTurnstileResponse, error)
+	// query the revenue of a CSR NFT in past day epochs
+	CSRRevenueHistory(context.Context, *QueryCSRRevenueHistoryRequest) (*QueryCSRRevenueHistoryResponse, error)
+	// query the CSR NFTs that earned the most revenue in a day epoch
+	TopCSRs(context.Context, *QueryTopCSRsRequest) (*QueryTopCSRsResponse, error)
+	// query the csr fees not yet distributed to the Turnstile
+	PendingFees(context.Context, *QueryPendingFeesRequest) (*QueryPendingFeesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -131,6 +173,15 @@ func (UnimplementedQueryServer) CSRByContract(context.Context, *QueryCSRByContra
 func (UnimplementedQueryServer) Turnstile(context.Context, *QueryTurnstileRequest) (*QueryTurnstileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Turnstile not implemented")
 }
+func (UnimplementedQueryServer) CSRRevenueHistory(context.Context, *QueryCSRRevenueHistoryRequest) (*QueryCSRRevenueHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CSRRevenueHistory not implemented")
+}
+func (UnimplementedQueryServer) TopCSRs(context.Context, *QueryTopCSRsRequest) (*QueryTopCSRsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopCSRs not implemented")
+}
+func (UnimplementedQueryServer) PendingFees(context.Context, *QueryPendingFeesRequest) (*QueryPendingFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingFees not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -234,6 +285,60 @@ func _Query_Turnstile_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CSRRevenueHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCSRRevenueHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CSRRevenueHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CSRRevenueHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CSRRevenueHistory(ctx, req.(*QueryCSRRevenueHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TopCSRs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopCSRsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TopCSRs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TopCSRs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TopCSRs(ctx, req.(*QueryTopCSRsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingFees(ctx, req.(*QueryPendingFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -261,6 +366,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Turnstile",
 			Handler:    _Query_Turnstile_Handler,
 		},
+		{
+			MethodName: "CSRRevenueHistory",
+			Handler:    _Query_CSRRevenueHistory_Handler,
+		},
+		{
+			MethodName: "TopCSRs",
+			Handler:    _Query_TopCSRs_Handler,
+		},
+		{
+			MethodName: "PendingFees",
+			Handler:    _Query_PendingFees_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "canto/csr/v1/query.proto",
@@ -19,7 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/canto.csr.v1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName        = "/canto.csr.v1.Msg/UpdateParams"
+	Msg_WithdrawCSRRevenue_FullMethodName  = "/canto.csr.v1.Msg/WithdrawCSRRevenue"
+	Msg_RegisterContract_FullMethodName    = "/canto.csr.v1.Msg/RegisterContract"
+	Msg_RemoveContract_FullMethodName      = "/canto.csr.v1.Msg/RemoveContract"
+	Msg_ReassignContract_FullMethodName    = "/canto.csr.v1.Msg/ReassignContract"
+	Msg_MergeNFTs_FullMethodName           = "/canto.csr.v1.Msg/MergeNFTs"
+	Msg_SetCSRShareOverride_FullMethodName = "/canto.csr.v1.Msg/SetCSRShareOverride"
 )
 
 // MsgClient is the client API for Msg service.
@@ -28,6 +34,25 @@ const (
 type MsgClient interface {
 	// UpdateParams updates the parameters of the x/csr module.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// WithdrawCSRRevenue withdraws the revenue accrued by a CSR NFT in the
+	// Turnstile to a bech32 address, on behalf of the owner of the NFT. As the
+	// Turnstile only lets the owner of the NFT withdraw, the Turnstile withdraw
+	// method is called from the EVM address of the signer once it is checked to
+	// own the NFT.
+	WithdrawCSRRevenue(ctx context.Context, in *MsgWithdrawCSRRevenue, opts ...grpc.CallOption) (*MsgWithdrawCSRRevenueResponse, error)
+	// RegisterContract registers a deployed contract to a new CSR NFT by minting
+	// the NFT in the Turnstile on behalf of the contract.
+	RegisterContract(ctx context.Context, in *MsgRegisterContract, opts ...grpc.CallOption) (*MsgRegisterContractResponse, error)
+	// RemoveContract detaches a contract from its CSR NFT.
+	RemoveContract(ctx context.Context, in *MsgRemoveContract, opts ...grpc.CallOption) (*MsgRemoveContractResponse, error)
+	// ReassignContract moves a contract from its CSR NFT to another CSR NFT.
+	ReassignContract(ctx context.Context, in *MsgReassignContract, opts ...grpc.CallOption) (*MsgReassignContractResponse, error)
+	// MergeNFTs moves every contract of a CSR NFT to another CSR NFT of the
+	// same owner.
+	MergeNFTs(ctx context.Context, in *MsgMergeNFTs, opts ...grpc.CallOption) (*MsgMergeNFTsResponse, error)
+	// SetCSRShareOverride sets or clears the csr shares of a CSR NFT, replacing
+	// the csr shares and tiers of the params.
+	SetCSRShareOverride(ctx context.Context, in *MsgSetCSRShareOverride, opts ...grpc.CallOption) (*MsgSetCSRShareOverrideResponse, error)
 }
 
 type msgClient struct {
@@ -47,12 +72,85 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) WithdrawCSRRevenue(ctx context.Context, in *MsgWithdrawCSRRevenue, opts ...grpc.CallOption) (*MsgWithdrawCSRRevenueResponse, error) {
+	out := new(MsgWithdrawCSRRevenueResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawCSRRevenue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterContract(ctx context.Context, in *MsgRegisterContract, opts ...grpc.CallOption) (*MsgRegisterContractResponse, error) {
+	out := new(MsgRegisterContractResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterContract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveContract(ctx context.Context, in *MsgRemoveContract, opts ...grpc.CallOption) (*MsgRemoveContractResponse, error) {
+	out := new(MsgRemoveContractResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveContract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReassignContract(ctx context.Context, in *MsgReassignContract, opts ...grpc.CallOption) (*MsgReassignContractResponse, error) {
+	out := new(MsgReassignContractResponse)
+	err := c.cc.Invoke(ctx, Msg_ReassignContract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MergeNFTs(ctx context.Context, in *MsgMergeNFTs, opts ...grpc.CallOption) (*MsgMergeNFTsResponse, error) {
+	out := new(MsgMergeNFTsResponse)
+	err := c.cc.Invoke(ctx, Msg_MergeNFTs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetCSRShareOverride(ctx context.Context, in *MsgSetCSRShareOverride, opts ...grpc.CallOption) (*MsgSetCSRShareOverrideResponse, error) {
+	out := new(MsgSetCSRShareOverrideResponse)
+	err := c.cc.Invoke(ctx, Msg_SetCSRShareOverride_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
 type MsgServer interface {
 	// UpdateParams updates the parameters of the x/csr module.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// WithdrawCSRRevenue withdraws the revenue accrued by a CSR NFT in the
+	// Turnstile to a bech32 address, on behalf of the owner of the NFT. As the
+	// Turnstile only lets the owner of the NFT withdraw, the Turnstile withdraw
+	// method is called from the EVM address of the signer once it is checked to
+	// own the NFT.
+	WithdrawCSRRevenue(context.Context, *MsgWithdrawCSRRevenue) (*MsgWithdrawCSRRevenueResponse, error)
+	// RegisterContract registers a deployed contract to a new CSR NFT by minting
+	// the NFT in the Turnstile on behalf of the contract.
+	RegisterContract(context.Context, *MsgRegisterContract) (*MsgRegisterContractResponse, error)
+	// RemoveContract detaches a contract from its CSR NFT.
+	RemoveContract(context.Context, *MsgRemoveContract) (*MsgRemoveContractResponse, error)
+	// ReassignContract moves a contract from its CSR NFT to another CSR NFT.
+	ReassignContract(context.Context, *MsgReassignContract) (*MsgReassignContractResponse, error)
+	// MergeNFTs moves every contract of a CSR NFT to another CSR NFT of the
+	// same owner.
+	MergeNFTs(context.Context, *MsgMergeNFTs) (*MsgMergeNFTsResponse, error)
+	// SetCSRShareOverride sets or clears the csr shares of a CSR NFT, replacing
+	// the csr shares and tiers of the params.
+	SetCSRShareOverride(context.Context, *MsgSetCSRShareOverride) (*MsgSetCSRShareOverrideResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -63,6 +161,24 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) WithdrawCSRRevenue(context.Context, *MsgWithdrawCSRRevenue) (*MsgWithdrawCSRRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCSRRevenue not implemented")
+}
+func (UnimplementedMsgServer) RegisterContract(context.Context, *MsgRegisterContract) (*MsgRegisterContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterContract not implemented")
+}
+func (UnimplementedMsgServer) RemoveContract(context.Context, *MsgRemoveContract) (*MsgRemoveContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveContract not implemented")
+}
+func (UnimplementedMsgServer) ReassignContract(context.Context, *MsgReassignContract) (*MsgReassignContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignContract not implemented")
+}
+func (UnimplementedMsgServer) MergeNFTs(context.Context, *MsgMergeNFTs) (*MsgMergeNFTsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeNFTs not implemented")
+}
+func (UnimplementedMsgServer) SetCSRShareOverride(context.Context, *MsgSetCSRShareOverride) (*MsgSetCSRShareOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCSRShareOverride not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +210,114 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawCSRRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawCSRRevenue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawCSRRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawCSRRevenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawCSRRevenue(ctx, req.(*MsgWithdrawCSRRevenue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterContract(ctx, req.(*MsgRegisterContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveContract(ctx, req.(*MsgRemoveContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReassignContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReassignContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReassignContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReassignContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReassignContract(ctx, req.(*MsgReassignContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MergeNFTs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMergeNFTs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MergeNFTs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_MergeNFTs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MergeNFTs(ctx, req.(*MsgMergeNFTs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCSRShareOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCSRShareOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCSRShareOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetCSRShareOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCSRShareOverride(ctx, req.(*MsgSetCSRShareOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +329,30 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "WithdrawCSRRevenue",
+			Handler:    _Msg_WithdrawCSRRevenue_Handler,
+		},
+		{
+			MethodName: "RegisterContract",
+			Handler:    _Msg_RegisterContract_Handler,
+		},
+		{
+			MethodName: "RemoveContract",
+			Handler:    _Msg_RemoveContract_Handler,
+		},
+		{
+			MethodName: "ReassignContract",
+			Handler:    _Msg_ReassignContract_Handler,
+		},
+		{
+			MethodName: "MergeNFTs",
+			Handler:    _Msg_MergeNFTs_Handler,
+		},
+		{
+			MethodName: "SetCSRShareOverride",
+			Handler:    _Msg_SetCSRShareOverride_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "canto/csr/v1/tx.proto",
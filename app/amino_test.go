@@ -82,11 +82,33 @@ func TestAminoJSON_Equivalence(t *testing.T) {
 		GenType(&coinswaptypes.MsgAddLiquidity{}, &coinswapapi.MsgAddLiquidity{}, GenOpts.WithDisallowNil()),
 		GenType(&coinswaptypes.MsgRemoveLiquidity{}, &coinswapapi.MsgRemoveLiquidity{}, GenOpts.WithDisallowNil()),
 		GenType(&coinswaptypes.MsgSwapOrder{}, &coinswapapi.MsgSwapOrder{}, GenOpts.WithDisallowNil()),
+		GenType(&coinswaptypes.MsgSwapExactAmountInRoute{}, &coinswapapi.MsgSwapExactAmountInRoute{}, GenOpts.WithDisallowNil()),
+		GenType(&coinswaptypes.MsgSwapExactAmountOutRoute{}, &coinswapapi.MsgSwapExactAmountOutRoute{}, GenOpts.WithDisallowNil()),
+		GenType(&coinswaptypes.MsgAddLiquiditySingle{}, &coinswapapi.MsgAddLiquiditySingle{}, GenOpts.WithDisallowNil()),
+		GenType(&coinswaptypes.MsgRemoveLiquiditySingle{}, &coinswapapi.MsgRemoveLiquiditySingle{}, GenOpts.WithDisallowNil()),
+		GenType(&coinswaptypes.MsgPlaceLimitOrder{}, &coinswapapi.MsgPlaceLimitOrder{}, GenOpts.WithDisallowNil()),
+		GenType(&coinswaptypes.MsgCancelLimitOrder{}, &coinswapapi.MsgCancelLimitOrder{}, GenOpts.WithDisallowNil()),
+		GenType(&coinswaptypes.MsgCreatePosition{}, &coinswapapi.MsgCreatePosition{}, GenOpts.WithDisallowNil()),
+		GenType(&coinswaptypes.MsgIncreasePosition{}, &coinswapapi.MsgIncreasePosition{}, GenOpts.WithDisallowNil()),
+		GenType(&coinswaptypes.MsgDecreasePosition{}, &coinswapapi.MsgDecreasePosition{}, GenOpts.WithDisallowNil()),
+		GenType(&coinswaptypes.MsgCollectFees{}, &coinswapapi.MsgCollectFees{}, GenOpts.WithDisallowNil()),
+		GenType(&coinswaptypes.MsgCreateGauge{}, &coinswapapi.MsgCreateGauge{}, GenOpts.WithDisallowNil()),
+		GenType(&coinswaptypes.MsgLockLiquidity{}, &coinswapapi.MsgLockLiquidity{}, GenOpts.WithDisallowNil()),
+		GenType(&coinswaptypes.MsgUnlockLiquidity{}, &coinswapapi.MsgUnlockLiquidity{}, GenOpts.WithDisallowNil()),
+		GenType(&coinswaptypes.MsgClaimLockRewards{}, &coinswapapi.MsgClaimLockRewards{}, GenOpts.WithDisallowNil()),
 		GenType(&coinswaptypes.MsgUpdateParams{}, &coinswapapi.MsgUpdateParams{}, GenOpts.WithDisallowNil()),
+		GenType(&coinswaptypes.MsgUpdatePoolParams{}, &coinswapapi.MsgUpdatePoolParams{}, GenOpts.WithDisallowNil()),
+		GenType(&coinswaptypes.MsgSetPoolStatus{}, &coinswapapi.MsgSetPoolStatus{}, GenOpts.WithDisallowNil()),
 		GenType(&coinswaptypes.Params{}, &coinswapapi.Params{}, GenOpts.WithDisallowNil()),
 
 		// csr
 		GenType(&csrtypes.MsgUpdateParams{}, &csrapi.MsgUpdateParams{}, GenOpts.WithDisallowNil()),
+		GenType(&csrtypes.MsgWithdrawCSRRevenue{}, &csrapi.MsgWithdrawCSRRevenue{}, GenOpts.WithDisallowNil()),
+		GenType(&csrtypes.MsgRegisterContract{}, &csrapi.MsgRegisterContract{}, GenOpts.WithDisallowNil()),
+		GenType(&csrtypes.MsgRemoveContract{}, &csrapi.MsgRemoveContract{}, GenOpts.WithDisallowNil()),
+		GenType(&csrtypes.MsgReassignContract{}, &csrapi.MsgReassignContract{}, GenOpts.WithDisallowNil()),
+		GenType(&csrtypes.MsgMergeNFTs{}, &csrapi.MsgMergeNFTs{}, GenOpts.WithDisallowNil()),
+		GenType(&csrtypes.MsgSetCSRShareOverride{}, &csrapi.MsgSetCSRShareOverride{}, GenOpts.WithDisallowNil()),
 		GenType(&csrtypes.Params{}, &csrapi.Params{}, GenOpts.WithDisallowNil()),
 
 		// inflation
@@ -663,6 +663,8 @@ func NewCanto(
 		epochskeeper.NewMultiEpochHooks(
 			// insert epoch hooks receivers here
 			app.InflationKeeper.Hooks(),
+			app.CoinswapKeeper.Hooks(),
+			app.CSRKeeper.EpochHooks(),
 		),
 	)
 
@@ -173,10 +173,10 @@ func TestWorkingHash(t *testing.T) {
 		govtypes.StoreKey:         "033e4a113195025eb54ecdeabffec5fd20605eae08da125d237768f1f3387616",
 		ibctransfertypes.StoreKey: "3ffd548eb86288efc51964649e36dc710f591c3d60d6f9c1b42f2a4d17870904",
 		inflationtypes.StoreKey:   "b85bc597af9eb62c42e06b0f158bde591975585bab384e9666f89f80001b3d01",
-		paramstypes.StoreKey:      "689664bc7d4f9aa8abeeb6ba13a556467e149d56e7bead89e8b574bdf8e0fe7f",
+		paramstypes.StoreKey:      "27039a3ba865785a656fc8308584b7a7c5ffc8066771dfb7b585cf50d3eafc00",
 		slashingtypes.StoreKey:    "9da3ff2ded57e30dfea0371278d9043bea9f579421beb45b58ec7240e1b4f27a",
 		stakingtypes.StoreKey:     "17b36186121d21b713a667c6cd534562bbc3095974ec866cb906cad7c2fa31e1",
-		upgradetypes.StoreKey:     "9677219870ca98ba9868589ccdcd97411d9b82abc6a7aa1910016457b4730a93",
+		upgradetypes.StoreKey:     "6c032d20e76f6b14c1801ab64bfeef20eac95b6637578692c7014f6f85cfd086",
 	}
 
 	matchAny := func(key string) bool {
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -14,6 +16,11 @@ import (
 	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
 )
 
+const (
+	FlagOwner    = "owner"
+	FlagLptDenom = "lpt-denom"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -28,6 +35,21 @@ func GetQueryCmd() *cobra.Command {
 		NewQueryParamsCmd(),
 		GetCmdQueryLiquidityPools(),
 		GetCmdQueryLiquidityPool(),
+		GetCmdQueryProtocolFees(),
+		GetCmdQueryTwap(),
+		GetCmdQueryPoolStats(),
+		GetCmdQueryAllPoolStats(),
+		GetCmdQueryEstimateSwapExactIn(),
+		GetCmdQueryEstimateSwapExactOut(),
+		GetCmdQueryLimitOrder(),
+		GetCmdQueryLimitOrders(),
+		GetCmdQueryPosition(),
+		GetCmdQueryPositions(),
+		GetCmdQueryGauge(),
+		GetCmdQueryGauges(),
+		GetCmdQueryLock(),
+		GetCmdQueryLocks(),
+		GetCmdQueryPendingRewards(),
 	)
 
 	return cmd
@@ -112,6 +134,96 @@ $ %s query %s liquidity-pools
 	return cmd
 }
 
+func GetCmdQueryPoolStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-stats [liquidity pool denom]",
+		Short: "query the statistics of a liquidity pool per epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the swap count, volume, fees and liquidity of a liquidity pool for each ended epoch.
+
+Example:
+$ %s query %s pool-stats lpt-1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			lptDenom := args[0]
+			if err := sdk.ValidateDenom(lptDenom); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.PoolStats(cmd.Context(), &types.QueryPoolStatsRequest{
+				LptDenom:   lptDenom,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pool-stats")
+
+	return cmd
+}
+
+func GetCmdQueryAllPoolStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-pool-stats",
+		Short: "query the statistics of all liquidity pools per epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the swap count, volume, fees and liquidity of all liquidity pools for each ended epoch.
+
+Example:
+$ %s query %s all-pool-stats
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.AllPoolStats(cmd.Context(), &types.QueryAllPoolStatsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all-pool-stats")
+
+	return cmd
+}
+
 func GetCmdQueryLiquidityPool() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "liquidity-pool [liquidity pool denom]",
@@ -153,3 +265,618 @@ $ %s query %s liquidity-pool
 
 	return cmd
 }
+
+func GetCmdQueryProtocolFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "protocol-fees [liquidity pool denom]",
+		Short: "query the protocol fees accumulated by a liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the protocol share of the swap fees accumulated by a liquidity pool.
+
+Example:
+$ %s query %s protocol-fees lpt-1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			lptDenom := args[0]
+			if err := sdk.ValidateDenom(lptDenom); err != nil {
+				return err
+			}
+
+			res, err := queryClient.ProtocolFees(cmd.Context(), &types.QueryProtocolFeesRequest{
+				LptDenom: lptDenom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryTwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [liquidity pool denom] [start time] [end time]",
+		Short: "query the time weighted average price of a liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the time weighted average price of the counterparty coin in the standard coin of a liquidity pool.
+Times are given in RFC3339 format.
+
+Example:
+$ %s query %s twap lpt-1 2024-07-29T06:00:00Z 2024-07-29T07:00:00Z
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			lptDenom := args[0]
+			if err := sdk.ValidateDenom(lptDenom); err != nil {
+				return err
+			}
+
+			startTime, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return err
+			}
+
+			endTime, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Twap(cmd.Context(), &types.QueryTwapRequest{
+				LptDenom:  lptDenom,
+				StartTime: startTime,
+				EndTime:   endTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryEstimateSwapExactIn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-swap-exact-in [input coin] [output denom]",
+		Short: "estimate the output of selling an exact input coin",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Estimate the output, fee and price impact of selling an exact input coin, without executing the swap.
+
+Example:
+$ %s query %s estimate-swap-exact-in 1000000ibc/... acanto
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			tokenIn, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid input coin: %w", err)
+			}
+
+			tokenOutDenom := args[1]
+			if err := sdk.ValidateDenom(tokenOutDenom); err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateSwapExactIn(cmd.Context(), &types.QueryEstimateSwapExactInRequest{
+				TokenIn:       tokenIn,
+				TokenOutDenom: tokenOutDenom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryEstimateSwapExactOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-swap-exact-out [output coin] [input denom]",
+		Short: "estimate the input of buying an exact output coin",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Estimate the input, fee and price impact of buying an exact output coin, without executing the swap.
+
+Example:
+$ %s query %s estimate-swap-exact-out 1000000ibc/... acanto
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			tokenOut, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid output coin: %w", err)
+			}
+
+			tokenInDenom := args[1]
+			if err := sdk.ValidateDenom(tokenInDenom); err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateSwapExactOut(cmd.Context(), &types.QueryEstimateSwapExactOutRequest{
+				TokenOut:     tokenOut,
+				TokenInDenom: tokenInDenom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryLimitOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit-order [order id]",
+		Short: "query an open limit order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query an open limit order by id.
+
+Example:
+$ %s query %s limit-order 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			orderId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid order id: %w", err)
+			}
+
+			res, err := queryClient.LimitOrder(cmd.Context(), &types.QueryLimitOrderRequest{
+				OrderId: orderId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryLimitOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit-orders",
+		Short: "query the open limit orders",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the open limit orders, optionally filtered by owner and liquidity pool.
+
+Example:
+$ %s query %s limit-orders --owner=<address> --lpt-denom=lpt-1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			owner, err := cmd.Flags().GetString(FlagOwner)
+			if err != nil {
+				return err
+			}
+
+			lptDenom, err := cmd.Flags().GetString(FlagLptDenom)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.LimitOrders(cmd.Context(), &types.QueryLimitOrdersRequest{
+				Owner:      owner,
+				LptDenom:   lptDenom,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagOwner, "", "Owner address of the limit orders")
+	cmd.Flags().String(FlagLptDenom, "", "Liquidity pool token denom of the pool of the limit orders")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "limit-orders")
+
+	return cmd
+}
+
+func GetCmdQueryPosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "position [position id]",
+		Short: "query a concentrated liquidity position",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a concentrated liquidity position and its uncollected fees by id.
+
+Example:
+$ %s query %s position 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			positionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid position id: %w", err)
+			}
+
+			res, err := queryClient.Position(cmd.Context(), &types.QueryPositionRequest{
+				PositionId: positionId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryPositions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "positions",
+		Short: "query the concentrated liquidity positions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the concentrated liquidity positions, optionally filtered by owner and liquidity pool.
+
+Example:
+$ %s query %s positions --owner=<address> --lpt-denom=lpt-1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			owner, err := cmd.Flags().GetString(FlagOwner)
+			if err != nil {
+				return err
+			}
+
+			lptDenom, err := cmd.Flags().GetString(FlagLptDenom)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.Positions(cmd.Context(), &types.QueryPositionsRequest{
+				Owner:      owner,
+				LptDenom:   lptDenom,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagOwner, "", "Owner address of the positions")
+	cmd.Flags().String(FlagLptDenom, "", "Liquidity pool token denom of the pool of the positions")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "positions")
+
+	return cmd
+}
+
+func GetCmdQueryGauge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gauge [gauge id]",
+		Short: "query a liquidity mining gauge",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a liquidity mining gauge by id.
+
+Example:
+$ %s query %s gauge 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			gaugeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid gauge id: %w", err)
+			}
+
+			res, err := queryClient.Gauge(cmd.Context(), &types.QueryGaugeRequest{
+				GaugeId: gaugeId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryGauges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gauges",
+		Short: "query the liquidity mining gauges",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the liquidity mining gauges, optionally filtered by liquidity pool.
+
+Example:
+$ %s query %s gauges --lpt-denom=lpt-1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			lptDenom, err := cmd.Flags().GetString(FlagLptDenom)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.Gauges(cmd.Context(), &types.QueryGaugesRequest{
+				LptDenom:   lptDenom,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagLptDenom, "", "Liquidity pool token denom of the pool of the gauges")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "gauges")
+
+	return cmd
+}
+
+func GetCmdQueryLock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock [lock id]",
+		Short: "query a liquidity lock",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a liquidity lock and its pending rewards by id.
+
+Example:
+$ %s query %s lock 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			lockId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid lock id: %w", err)
+			}
+
+			res, err := queryClient.Lock(cmd.Context(), &types.QueryLockRequest{
+				LockId: lockId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryLocks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "locks",
+		Short: "query the liquidity locks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the liquidity locks, optionally filtered by owner and liquidity pool.
+
+Example:
+$ %s query %s locks --owner=<address> --lpt-denom=lpt-1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			owner, err := cmd.Flags().GetString(FlagOwner)
+			if err != nil {
+				return err
+			}
+
+			lptDenom, err := cmd.Flags().GetString(FlagLptDenom)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.Locks(cmd.Context(), &types.QueryLocksRequest{
+				Owner:      owner,
+				LptDenom:   lptDenom,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagOwner, "", "Owner address of the locks")
+	cmd.Flags().String(FlagLptDenom, "", "Liquidity pool token denom of the locks")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "locks")
+
+	return cmd
+}
+
+func GetCmdQueryPendingRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-rewards [owner]",
+		Short: "query the pending rewards of the liquidity locks of an owner",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rewards earned by all the liquidity locks of an owner and not claimed yet.
+
+Example:
+$ %s query %s pending-rewards <address>
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingRewards(cmd.Context(), &types.QueryPendingRewardsRequest{
+				Owner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
 )
@@ -29,12 +30,33 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetAddLiquidityCmd(),
 		GetRemoveLiquidityCmd(),
+		GetAddLiquiditySingleCmd(),
+		GetRemoveLiquiditySingleCmd(),
 		GetSwapCmd(),
+		GetSwapExactAmountInRouteCmd(),
+		GetSwapExactAmountOutRouteCmd(),
+		GetPlaceLimitOrderCmd(),
+		GetCancelLimitOrderCmd(),
+		GetCreatePositionCmd(),
+		GetIncreasePositionCmd(),
+		GetDecreasePositionCmd(),
+		GetCollectFeesCmd(),
+		GetCreateGaugeCmd(),
+		GetLockLiquidityCmd(),
+		GetUnlockLiquidityCmd(),
+		GetClaimLockRewardsCmd(),
 	)
 
 	return cmd
 }
 
+const (
+	FlagPoolType      = "pool-type"
+	FlagAmplification = "amplification"
+	FlagInitialPrice  = "initial-price"
+	FlagPairDenom     = "pair-denom"
+)
+
 func GetAddLiquidityCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "add-liquidity [max-coin] [standard-coin-amount] [minimum-liquidity] [duration]",
@@ -70,10 +92,33 @@ func GetAddLiquidityCmd() *cobra.Command {
 
 			msg := types.NewMsgAddLiquidity(depositCoin, standardCoinAmt, minLiquidity, deadline.Unix(), clientCtx.GetFromAddress().String())
 
+			poolTypeStr, err := cmd.Flags().GetString(FlagPoolType)
+			if err != nil {
+				return err
+			}
+			poolType, ok := types.PoolType_value[poolTypeStr]
+			if !ok {
+				return fmt.Errorf("invalid pool type: %s", poolTypeStr)
+			}
+			msg.PoolType = types.PoolType(poolType)
+
+			msg.Amplification, err = cmd.Flags().GetUint64(FlagAmplification)
+			if err != nil {
+				return err
+			}
+
+			msg.PairDenom, err = cmd.Flags().GetString(FlagPairDenom)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagPoolType, types.POOL_TYPE_CONSTANT_PRODUCT.String(), "Type of the pool if it is created by this deposit (POOL_TYPE_CONSTANT_PRODUCT or POOL_TYPE_STABLESWAP, concentrated liquidity pools are created by create-position)")
+	cmd.Flags().Uint64(FlagAmplification, 0, "Amplification coefficient of a stableswap pool created by this deposit")
+	cmd.Flags().String(FlagPairDenom, "", "Denom of the standard coin amount, to provide liquidity to a pool of two arbitrary denoms (defaults to the standard denom)")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -123,6 +168,84 @@ func GetRemoveLiquidityCmd() *cobra.Command {
 	return cmd
 }
 
+func GetAddLiquiditySingleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-liquidity-single [lpt-denom] [token-in] [minimum-liquidity] [duration]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Add liquidity to a pool with a single coin, swapping part of it for the other coin of the pool",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenIn, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid coins: %w", err)
+			}
+
+			minLiquidity, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid minimum liquidity: %s", args[2])
+			}
+
+			duration, err := time.ParseDuration(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid duration: %s", err)
+			}
+
+			deadline := time.Now().Add(duration)
+
+			msg := types.NewMsgAddLiquiditySingle(clientCtx.GetFromAddress().String(), args[0], tokenIn, minLiquidity, deadline.Unix())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetRemoveLiquiditySingleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-liquidity-single [liquidity coin to withdraw] [min output coin] [duration]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Remove liquidity from a pool as a single coin, swapping the other withdrawn coin for it",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			liquidityCoin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid liquidity coin: %w", err)
+			}
+
+			tokenOutMin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid output coin: %w", err)
+			}
+
+			duration, err := time.ParseDuration(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid duration: %s", err)
+			}
+
+			deadline := time.Now().Add(duration)
+
+			msg := types.NewMsgRemoveLiquiditySingle(clientCtx.GetFromAddress().String(), liquidityCoin, tokenOutMin, deadline.Unix())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func GetSwapCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "swap [input coin] [output coin] [isBuyOrder] [duration]",
@@ -171,3 +294,473 @@ func GetSwapCmd() *cobra.Command {
 
 	return cmd
 }
+
+func GetSwapExactAmountInRouteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-exact-in-route [input coin] [min output coin] [duration]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Sell an exact input coin for the output coin, routing through the standard coin pools",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			inputCoin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid input coin: %w", err)
+			}
+
+			minOutputCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid min output coin: %w", err)
+			}
+
+			duration, err := time.ParseDuration(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid duration: %s", err)
+			}
+
+			deadline := time.Now().Add(duration)
+
+			msg := types.NewMsgSwapExactAmountInRoute(
+				clientCtx.GetFromAddress().String(),
+				inputCoin,
+				minOutputCoin,
+				clientCtx.GetFromAddress().String(),
+				deadline.Unix(),
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetSwapExactAmountOutRouteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-exact-out-route [max input coin] [output coin] [duration]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Buy an exact output coin with the input coin, routing through the standard coin pools",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxInputCoin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid max input coin: %w", err)
+			}
+
+			outputCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid output coin: %w", err)
+			}
+
+			duration, err := time.ParseDuration(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid duration: %s", err)
+			}
+
+			deadline := time.Now().Add(duration)
+
+			msg := types.NewMsgSwapExactAmountOutRoute(
+				clientCtx.GetFromAddress().String(),
+				maxInputCoin,
+				outputCoin,
+				clientCtx.GetFromAddress().String(),
+				deadline.Unix(),
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetPlaceLimitOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-limit-order [input coin] [min output coin] [duration]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Escrow a coin until the pool sells at least the min output coin for it",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenIn, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid input coin: %w", err)
+			}
+
+			minTokenOut, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid output coin: %w", err)
+			}
+
+			duration, err := time.ParseDuration(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid duration: %s", err)
+			}
+
+			deadline := time.Now().Add(duration)
+
+			msg := types.NewMsgPlaceLimitOrder(clientCtx.GetFromAddress().String(), tokenIn, minTokenOut, deadline.Unix())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCancelLimitOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-limit-order [order id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel an open limit order and refund its escrowed coin",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid order id: %w", err)
+			}
+
+			msg := types.NewMsgCancelLimitOrder(clientCtx.GetFromAddress().String(), orderId)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCreatePositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-position [max-coin] [max-standard-coin-amount] [lower-tick] [upper-tick] [minimum-liquidity] [duration]",
+		Args:  cobra.ExactArgs(6),
+		Short: "Provide liquidity between two ticks of a concentrated liquidity pool",
+		Long: `Provide liquidity between two ticks of a concentrated liquidity pool, creating the pool at the initial price if it does not exist.
+Negative ticks must follow the -- separator.`,
+		Example: fmt.Sprintf("$ %s tx %s create-position 1000000eth 1000000 --initial-price=1 -- -1000 1000 1 1m", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxToken, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid coins: %w", err)
+			}
+
+			maxStandardAmt, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid standard coin amount: %s", args[1])
+			}
+
+			lowerTick, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid lower tick: %w", err)
+			}
+
+			upperTick, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid upper tick: %w", err)
+			}
+
+			minLiquidity, ok := sdkmath.NewIntFromString(args[4])
+			if !ok {
+				return fmt.Errorf("invalid minimum liquidity: %s", args[4])
+			}
+
+			duration, err := time.ParseDuration(args[5])
+			if err != nil {
+				return fmt.Errorf("invalid duration: %s", err)
+			}
+
+			deadline := time.Now().Add(duration)
+
+			var initialPrice *sdkmath.LegacyDec
+			initialPriceStr, err := cmd.Flags().GetString(FlagInitialPrice)
+			if err != nil {
+				return err
+			}
+			if initialPriceStr != "" {
+				price, err := sdkmath.LegacyNewDecFromStr(initialPriceStr)
+				if err != nil {
+					return fmt.Errorf("invalid initial price: %w", err)
+				}
+				initialPrice = &price
+			}
+
+			msg := types.NewMsgCreatePosition(clientCtx.GetFromAddress().String(), maxToken, maxStandardAmt, lowerTick, upperTick, minLiquidity, initialPrice, deadline.Unix())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagInitialPrice, "", "Price of the counterparty coin in standard coin the pool is created at if it does not exist")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetIncreasePositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "increase-position [position id] [max-token-amount] [max-standard-coin-amount] [minimum-liquidity] [duration]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Add liquidity to a concentrated liquidity position",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			positionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid position id: %w", err)
+			}
+
+			maxTokenAmt, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid token amount: %s", args[1])
+			}
+
+			maxStandardAmt, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid standard coin amount: %s", args[2])
+			}
+
+			minLiquidity, ok := sdkmath.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid minimum liquidity: %s", args[3])
+			}
+
+			duration, err := time.ParseDuration(args[4])
+			if err != nil {
+				return fmt.Errorf("invalid duration: %s", err)
+			}
+
+			deadline := time.Now().Add(duration)
+
+			msg := types.NewMsgIncreasePosition(clientCtx.GetFromAddress().String(), positionId, maxTokenAmt, maxStandardAmt, minLiquidity, deadline.Unix())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetDecreasePositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decrease-position [position id] [liquidity] [min-token-amount] [min-standard-coin-amount] [duration]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Remove liquidity from a concentrated liquidity position",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			positionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid position id: %w", err)
+			}
+
+			liquidity, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid liquidity: %s", args[1])
+			}
+
+			minTokenAmt, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid token amount: %s", args[2])
+			}
+
+			minStandardAmt, ok := sdkmath.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid standard coin amount: %s", args[3])
+			}
+
+			duration, err := time.ParseDuration(args[4])
+			if err != nil {
+				return fmt.Errorf("invalid duration: %s", err)
+			}
+
+			deadline := time.Now().Add(duration)
+
+			msg := types.NewMsgDecreasePosition(clientCtx.GetFromAddress().String(), positionId, liquidity, minTokenAmt, minStandardAmt, deadline.Unix())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCollectFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collect-fees [position id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Collect the swap fees earned by a concentrated liquidity position",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			positionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid position id: %w", err)
+			}
+
+			msg := types.NewMsgCollectFees(clientCtx.GetFromAddress().String(), positionId)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCreateGaugeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-gauge [lpt denom] [reward coins] [num epochs]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Fund rewards for the liquidity pool coins locked over a number of incentives epochs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid reward coins: %w", err)
+			}
+
+			numEpochs, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid num epochs: %w", err)
+			}
+
+			msg := types.NewMsgCreateGauge(clientCtx.GetFromAddress().String(), args[0], coins, numEpochs)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetLockLiquidityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock-liquidity [lpt coin] [duration]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Lock liquidity pool coins for a duration to earn the rewards of the gauges of their pool",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid lpt coin: %w", err)
+			}
+
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid duration: %s", err)
+			}
+
+			msg := types.NewMsgLockLiquidity(clientCtx.GetFromAddress().String(), coin, duration)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetUnlockLiquidityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock-liquidity [lock id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Withdraw the liquidity pool coins of an ended lock along with its rewards",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			lockId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid lock id: %w", err)
+			}
+
+			msg := types.NewMsgUnlockLiquidity(clientCtx.GetFromAddress().String(), lockId)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetClaimLockRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-lock-rewards [lock id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Claim the rewards earned by a liquidity lock",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			lockId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid lock id: %w", err)
+			}
+
+			msg := types.NewMsgClaimLockRewards(clientCtx.GetFromAddress().String(), lockId)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
+)
+
+// EndBlocker of coinswap module
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.FillLimitOrders(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
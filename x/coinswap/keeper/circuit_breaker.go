@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
+)
+
+// ValidateSwapPriceDeviation returns an error if swapping coinSold for coinBought
+// moves the spot price of the pool beyond the bounds of its circuit breaker,
+// exactIn being set if the amount of coinSold is the exact one of the trade
+func (k Keeper) ValidateSwapPriceDeviation(ctx sdk.Context, lptDenom string, coinSold, coinBought sdk.Coin, exactIn bool) error {
+	pool, has := k.GetPoolByLptDenom(ctx, lptDenom)
+	if !has {
+		return errorsmod.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", lptDenom)
+	}
+
+	_, err := k.checkPriceDeviation(ctx, pool, coinSold, coinBought, exactIn)
+	return err
+}
+
+// checkPriceDeviation returns the reference prices of the pool updated for the
+// ongoing block and epoch, or an error if swapping coinSold for coinBought moves
+// the spot price beyond the bounds of the pool. No reference prices are returned
+// if the circuit breaker of the pool is disabled
+func (k Keeper) checkPriceDeviation(ctx sdk.Context, pool types.Pool, coinSold, coinBought sdk.Coin, exactIn bool) (*types.PriceReference, error) {
+	epochIdentifier := k.GetParams(ctx).PriceDeviationEpochIdentifier
+	checkEpoch := pool.MaxEpochPriceDeviation != nil && epochIdentifier != ""
+	if pool.MaxBlockPriceDeviation == nil && !checkEpoch {
+		return nil, nil
+	}
+
+	spotPrice, price, err := k.getSwapSpotPrices(ctx, pool, coinSold, coinBought, exactIn)
+	if err != nil {
+		return nil, err
+	}
+
+	reference, found := k.GetPriceReference(ctx, pool.Id)
+	if !found {
+		reference = types.PriceReference{PoolId: pool.Id, EpochPrice: spotPrice}
+	}
+	if !found || reference.Height != ctx.BlockHeight() {
+		reference.Height = ctx.BlockHeight()
+		reference.BlockPrice = spotPrice
+	}
+
+	if pool.MaxBlockPriceDeviation != nil {
+		if err := k.validatePriceDeviation(ctx, pool, price, reference.BlockPrice, *pool.MaxBlockPriceDeviation); err != nil {
+			return nil, err
+		}
+	}
+	if checkEpoch {
+		if err := k.validatePriceDeviation(ctx, pool, price, reference.EpochPrice, *pool.MaxEpochPriceDeviation); err != nil {
+			return nil, err
+		}
+	}
+	return &reference, nil
+}
+
+// getSwapSpotPrices returns the spot prices of the counterparty coin in the standard
+// coin of the pool before and after swapping coinSold for coinBought
+func (k Keeper) getSwapSpotPrices(ctx sdk.Context, pool types.Pool, coinSold, coinBought sdk.Coin, exactIn bool) (sdkmath.LegacyDec, sdkmath.LegacyDec, error) {
+	if pool.PoolType == types.POOL_TYPE_CONCENTRATED {
+		spotPrice, err := k.getConcentratedSpotPrice(ctx, pool)
+		if err != nil {
+			return sdkmath.LegacyDec{}, sdkmath.LegacyDec{}, err
+		}
+		result, err := k.simulateConcentratedTrade(ctx, pool, coinSold, coinBought, exactIn)
+		if err != nil {
+			return sdkmath.LegacyDec{}, sdkmath.LegacyDec{}, err
+		}
+		return spotPrice, result.state.SqrtPrice.Mul(result.state.SqrtPrice), nil
+	}
+
+	balances, err := k.GetPoolBalances(ctx, pool.EscrowAddress)
+	if err != nil {
+		return sdkmath.LegacyDec{}, sdkmath.LegacyDec{}, err
+	}
+	standardReserve := balances.AmountOf(pool.StandardDenom)
+	tokenReserve := balances.AmountOf(pool.CounterpartyDenom)
+
+	spotPrice := GetPoolSpotPrice(pool, tokenReserve, standardReserve)
+	if coinSold.Denom == pool.CounterpartyDenom {
+		tokenReserve, standardReserve = tokenReserve.Add(coinSold.Amount), standardReserve.Sub(coinBought.Amount)
+	} else {
+		tokenReserve, standardReserve = tokenReserve.Sub(coinBought.Amount), standardReserve.Add(coinSold.Amount)
+	}
+	return spotPrice, GetPoolSpotPrice(pool, tokenReserve, standardReserve), nil
+}
+
+// validatePriceDeviation returns an error if the relative move of price from
+// referencePrice is greater than maxDeviation. No event is emitted, as the
+// events of a rejected transaction are discarded along with its state
+func (k Keeper) validatePriceDeviation(ctx sdk.Context, pool types.Pool, price, referencePrice, maxDeviation sdkmath.LegacyDec) error {
+	if !referencePrice.IsPositive() {
+		return nil
+	}
+
+	deviation := price.Sub(referencePrice).Abs().Quo(referencePrice)
+	if deviation.LTE(maxDeviation) {
+		return nil
+	}
+
+	return errorsmod.Wrapf(types.ErrPriceDeviationExceeded, "price of pool %s would move from %s to %s, max deviation: %s", pool.LptDenom, referencePrice, price, maxDeviation)
+}
+
+// ResetPriceReferences deletes the reference prices of all the pools, so that
+// the next swap of each pool starts new block and epoch references
+func (k Keeper) ResetPriceReferences(ctx sdk.Context) {
+	store := k.storeService.OpenKVStore(ctx)
+	for _, reference := range k.GetAllPriceReferences(ctx) {
+		if err := store.Delete(types.GetPriceReferenceKey(reference.PoolId)); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// GetPriceReference returns the reference prices of the circuit breaker of the pool
+func (k Keeper) GetPriceReference(ctx sdk.Context, poolId string) (types.PriceReference, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get(types.GetPriceReferenceKey(poolId))
+	if bz == nil {
+		return types.PriceReference{}, false
+	}
+
+	var reference types.PriceReference
+	k.cdc.MustUnmarshal(bz, &reference)
+	return reference, true
+}
+
+// SetPriceReference stores the reference prices of the circuit breaker of the pool
+func (k Keeper) SetPriceReference(ctx sdk.Context, reference types.PriceReference) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&reference)
+	store.Set(types.GetPriceReferenceKey(reference.PoolId), bz)
+}
+
+// GetAllPriceReferences returns the reference prices of the circuit breakers of all the pools
+func (k Keeper) GetAllPriceReferences(ctx sdk.Context) (references []types.PriceReference) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyPriceReference+"/"))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var reference types.PriceReference
+		k.cdc.MustUnmarshal(iterator.Value(), &reference)
+		references = append(references, reference)
+	}
+	return
+}
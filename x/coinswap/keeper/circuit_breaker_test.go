@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
+	epochstypes "github.com/Canto-Network/Canto/v8/x/epochs/types"
+)
+
+func (suite *TestSuite) TestPriceDeviationCircuitBreaker() {
+	sender, _ := createReservePool(suite, denomBTC)
+	poolId := types.GetPoolId(denomStandard, denomBTC)
+	params := suite.app.CoinswapKeeper.GetParams(suite.ctx)
+	params.PriceDeviationEpochIdentifier = epochstypes.DayEpochID
+	suite.app.CoinswapKeeper.SetParams(suite.ctx, params)
+
+	// each swap moves the price of the pool by about 0.2%
+	input := types.Input{Address: sender.String(), Coin: sdk.NewInt64Coin(denomBTC, 10_000_000)}
+	output := types.Output{Address: sender.String(), Coin: sdk.NewInt64Coin(denomStandard, 0)}
+	swap := func(ctx sdk.Context) error {
+		_, err := suite.app.CoinswapKeeper.TradeExactInputForOutput(ctx, input, output)
+		return err
+	}
+
+	// the circuit breaker is disabled by default
+	suite.Require().NoError(swap(suite.ctx))
+	_, found := suite.app.CoinswapKeeper.GetPriceReference(suite.ctx, poolId)
+	suite.Require().False(found)
+
+	maxBlockPriceDeviation := sdkmath.LegacyNewDecWithPrec(3, 3)
+	maxEpochPriceDeviation := sdkmath.LegacyNewDecWithPrec(5, 3)
+	_, err := suite.msgServer.UpdatePoolParams(suite.ctx, &types.MsgUpdatePoolParams{
+		Authority:              authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		LptDenom:               "lpt-1",
+		MaxBlockPriceDeviation: &maxBlockPriceDeviation,
+		MaxEpochPriceDeviation: &maxEpochPriceDeviation,
+	})
+	suite.Require().NoError(err)
+
+	// the second swap of the block exceeds the block bound
+	ctx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+	suite.Require().NoError(swap(ctx))
+	reference, found := suite.app.CoinswapKeeper.GetPriceReference(ctx, poolId)
+	suite.Require().True(found)
+	suite.Require().Equal(ctx.BlockHeight(), reference.Height)
+	suite.Require().Equal(reference.BlockPrice, reference.EpochPrice)
+
+	suite.Require().ErrorIs(swap(ctx), types.ErrPriceDeviationExceeded)
+	suite.Require().ErrorIs(suite.app.CoinswapKeeper.ValidateSwapPriceDeviation(ctx, "lpt-1", input.Coin, sdk.NewInt64Coin(denomStandard, 9_950_000), true), types.ErrPriceDeviationExceeded)
+
+	// the next block starts a new block reference, but the swaps add up to the epoch bound
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	suite.Require().NoError(swap(ctx))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	suite.Require().ErrorIs(swap(ctx), types.ErrPriceDeviationExceeded)
+
+	// the end of the epoch resets the references
+	suite.app.CoinswapKeeper.Hooks().AfterEpochEnd(ctx, epochstypes.WeekEpochID, 1)
+	suite.Require().ErrorIs(swap(ctx), types.ErrPriceDeviationExceeded)
+	suite.app.CoinswapKeeper.Hooks().AfterEpochEnd(ctx, epochstypes.DayEpochID, 1)
+	suite.Require().Empty(suite.app.CoinswapKeeper.GetAllPriceReferences(ctx))
+	suite.Require().NoError(swap(ctx))
+
+	// the epoch bound is not enforced without epoch identifier
+	params.PriceDeviationEpochIdentifier = ""
+	suite.app.CoinswapKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	suite.Require().NoError(swap(ctx))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	suite.Require().NoError(swap(ctx))
+}
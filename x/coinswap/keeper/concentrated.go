@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
+)
+
+// sqrtTickBase is the ratio between the square root prices of two consecutive
+// ticks, sqrt(1.0001)
+var sqrtTickBase = sdkmath.LegacyMustNewDecFromStr("1.000049998750062496")
+
+// TickToSqrtPrice returns the square root of the price of the tick, sqrt(1.0001)^tick
+func TickToSqrtPrice(tick int64) sdkmath.LegacyDec {
+	if tick < 0 {
+		return sdkmath.LegacyOneDec().Quo(sqrtTickBase.Power(uint64(-tick)))
+	}
+	return sqrtTickBase.Power(uint64(tick))
+}
+
+// SqrtPriceToTick returns the greatest tick whose square root price is lower than
+// or equal to sqrtPrice, bounded by the min and max ticks
+func SqrtPriceToTick(sqrtPrice sdkmath.LegacyDec) int64 {
+	low, high := types.MinTick, types.MaxTick
+	for low < high {
+		mid := low + (high-low+1)/2
+		if TickToSqrtPrice(mid).LTE(sqrtPrice) {
+			low = mid
+		} else {
+			high = mid - 1
+		}
+	}
+	return low
+}
+
+// GetTokenAmountForLiquidity returns the amount of counterparty coin held by the
+// liquidity between the square root prices sqrtA and sqrtB, L * (sqrtB - sqrtA) / (sqrtA * sqrtB)
+func GetTokenAmountForLiquidity(liquidity sdkmath.Int, sqrtA, sqrtB sdkmath.LegacyDec, roundUp bool) sdkmath.Int {
+	if sqrtA.GT(sqrtB) {
+		sqrtA, sqrtB = sqrtB, sqrtA
+	}
+	l := sdkmath.LegacyNewDecFromInt(liquidity)
+	if roundUp {
+		return l.MulRoundUp(sqrtB.Sub(sqrtA)).QuoRoundUp(sqrtB).QuoRoundUp(sqrtA).Ceil().TruncateInt()
+	}
+	return l.MulTruncate(sqrtB.Sub(sqrtA)).QuoTruncate(sqrtB).QuoTruncate(sqrtA).TruncateInt()
+}
+
+// GetStandardAmountForLiquidity returns the amount of standard coin held by the
+// liquidity between the square root prices sqrtA and sqrtB, L * (sqrtB - sqrtA)
+func GetStandardAmountForLiquidity(liquidity sdkmath.Int, sqrtA, sqrtB sdkmath.LegacyDec, roundUp bool) sdkmath.Int {
+	if sqrtA.GT(sqrtB) {
+		sqrtA, sqrtB = sqrtB, sqrtA
+	}
+	l := sdkmath.LegacyNewDecFromInt(liquidity)
+	if roundUp {
+		return l.MulRoundUp(sqrtB.Sub(sqrtA)).Ceil().TruncateInt()
+	}
+	return l.MulTruncate(sqrtB.Sub(sqrtA)).TruncateInt()
+}
+
+// GetAmountsForLiquidity returns the amounts of counterparty and standard coins held
+// by the liquidity between the square root prices sqrtA and sqrtB at the current
+// square root price sqrtPrice. The amounts are rounded up when deposited and
+// rounded down when withdrawn, in favor of the pool
+func GetAmountsForLiquidity(sqrtPrice, sqrtA, sqrtB sdkmath.LegacyDec, liquidity sdkmath.Int, roundUp bool) (tokenAmt, standardAmt sdkmath.Int) {
+	tokenAmt, standardAmt = sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	switch {
+	case sqrtPrice.LTE(sqrtA):
+		tokenAmt = GetTokenAmountForLiquidity(liquidity, sqrtA, sqrtB, roundUp)
+	case sqrtPrice.LT(sqrtB):
+		tokenAmt = GetTokenAmountForLiquidity(liquidity, sqrtPrice, sqrtB, roundUp)
+		standardAmt = GetStandardAmountForLiquidity(liquidity, sqrtA, sqrtPrice, roundUp)
+	default:
+		standardAmt = GetStandardAmountForLiquidity(liquidity, sqrtA, sqrtB, roundUp)
+	}
+	return tokenAmt, standardAmt
+}
+
+// GetLiquidityForAmounts returns the maximum liquidity between the square root prices
+// sqrtA and sqrtB that the given amounts of counterparty and standard coins can
+// provide at the current square root price sqrtPrice
+func GetLiquidityForAmounts(sqrtPrice, sqrtA, sqrtB sdkmath.LegacyDec, tokenAmt, standardAmt sdkmath.Int) sdkmath.Int {
+	switch {
+	case sqrtPrice.LTE(sqrtA):
+		return getLiquidityForTokenAmount(tokenAmt, sqrtA, sqrtB)
+	case sqrtPrice.LT(sqrtB):
+		return sdkmath.MinInt(
+			getLiquidityForTokenAmount(tokenAmt, sqrtPrice, sqrtB),
+			getLiquidityForStandardAmount(standardAmt, sqrtA, sqrtPrice),
+		)
+	default:
+		return getLiquidityForStandardAmount(standardAmt, sqrtA, sqrtB)
+	}
+}
+
+// getLiquidityForTokenAmount returns the liquidity provided by the amount of
+// counterparty coin between sqrtA and sqrtB, x * sqrtA * sqrtB / (sqrtB - sqrtA)
+func getLiquidityForTokenAmount(tokenAmt sdkmath.Int, sqrtA, sqrtB sdkmath.LegacyDec) sdkmath.Int {
+	return sdkmath.LegacyNewDecFromInt(tokenAmt).MulTruncate(sqrtA).MulTruncate(sqrtB).QuoTruncate(sqrtB.Sub(sqrtA)).TruncateInt()
+}
+
+// getLiquidityForStandardAmount returns the liquidity provided by the amount of
+// standard coin between sqrtA and sqrtB, y / (sqrtB - sqrtA)
+func getLiquidityForStandardAmount(standardAmt sdkmath.Int, sqrtA, sqrtB sdkmath.LegacyDec) sdkmath.Int {
+	return sdkmath.LegacyNewDecFromInt(standardAmt).QuoTruncate(sqrtB.Sub(sqrtA)).TruncateInt()
+}
+
+// getNextSqrtPriceFromInput returns the square root price reached by selling the
+// amount to the liquidity, rounded so that the price moves less in favor of the pool
+func getNextSqrtPriceFromInput(sqrtPrice sdkmath.LegacyDec, liquidity, amount sdkmath.Int, sellToken bool) sdkmath.LegacyDec {
+	l := sdkmath.LegacyNewDecFromInt(liquidity)
+	if sellToken {
+		// L / (L / sqrtP + x)
+		sqrtNext := l.QuoRoundUp(l.QuoTruncate(sqrtPrice).Add(sdkmath.LegacyNewDecFromInt(amount)))
+		return sdkmath.LegacyMinDec(sqrtNext, sqrtPrice)
+	}
+	// sqrtP + y / L
+	return sqrtPrice.Add(sdkmath.LegacyNewDecFromInt(amount).QuoTruncate(l))
+}
+
+// getNextSqrtPriceFromOutput returns the square root price reached by buying the
+// amount from the liquidity, rounded so that the price moves more in favor of the pool
+func getNextSqrtPriceFromOutput(sqrtPrice sdkmath.LegacyDec, liquidity, amount sdkmath.Int, sellToken bool) sdkmath.LegacyDec {
+	l := sdkmath.LegacyNewDecFromInt(liquidity)
+	if sellToken {
+		// sqrtP - y / L
+		return sqrtPrice.Sub(sdkmath.LegacyNewDecFromInt(amount).QuoRoundUp(l))
+	}
+	// L / (L / sqrtP - x)
+	return l.QuoRoundUp(l.QuoTruncate(sqrtPrice).Sub(sdkmath.LegacyNewDecFromInt(amount)))
+}
+
+// computeConcentratedSwapStep returns the square root price reached by swapping the
+// remaining amount within the liquidity from sqrtPrice towards sqrtTarget, the
+// amount sold excluding the fee, the amount bought and the fee charged. The
+// counterparty coin is sold when sellToken is set, and the remaining amount is
+// sold when exactIn is set, bought otherwise
+func computeConcentratedSwapStep(
+	sqrtPrice, sqrtTarget sdkmath.LegacyDec,
+	liquidity, remaining sdkmath.Int,
+	fee sdkmath.LegacyDec,
+	sellToken, exactIn bool,
+) (sqrtNext sdkmath.LegacyDec, amountIn, amountOut, feeAmt sdkmath.Int) {
+	getAmountIn := func(sqrtNext sdkmath.LegacyDec) sdkmath.Int {
+		if sellToken {
+			return GetTokenAmountForLiquidity(liquidity, sqrtNext, sqrtPrice, true)
+		}
+		return GetStandardAmountForLiquidity(liquidity, sqrtPrice, sqrtNext, true)
+	}
+	getAmountOut := func(sqrtNext sdkmath.LegacyDec) sdkmath.Int {
+		if sellToken {
+			return GetStandardAmountForLiquidity(liquidity, sqrtNext, sqrtPrice, false)
+		}
+		return GetTokenAmountForLiquidity(liquidity, sqrtPrice, sqrtNext, false)
+	}
+
+	feeFactor := sdkmath.LegacyOneDec().Sub(fee)
+	if exactIn {
+		remainingLessFee := sdkmath.LegacyNewDecFromInt(remaining).MulTruncate(feeFactor).TruncateInt()
+		if remainingLessFee.GTE(getAmountIn(sqrtTarget)) {
+			sqrtNext = sqrtTarget
+		} else {
+			sqrtNext = getNextSqrtPriceFromInput(sqrtPrice, liquidity, remainingLessFee, sellToken)
+		}
+	} else {
+		if remaining.GTE(getAmountOut(sqrtTarget)) {
+			sqrtNext = sqrtTarget
+		} else {
+			sqrtNext = getNextSqrtPriceFromOutput(sqrtPrice, liquidity, remaining, sellToken)
+		}
+	}
+
+	amountIn = getAmountIn(sqrtNext)
+	amountOut = getAmountOut(sqrtNext)
+	if !exactIn && amountOut.GT(remaining) {
+		amountOut = remaining
+	}
+
+	if exactIn && !sqrtNext.Equal(sqrtTarget) {
+		// the remaining amount is entirely sold, the fee takes what the price move does not
+		if amountIn.GT(remaining) {
+			amountIn = remaining
+		}
+		return sqrtNext, amountIn, amountOut, remaining.Sub(amountIn)
+	}
+
+	feeAmt = sdkmath.LegacyNewDecFromInt(amountIn).MulRoundUp(fee).QuoRoundUp(feeFactor).Ceil().TruncateInt()
+	if exactIn && amountIn.Add(feeAmt).GT(remaining) {
+		feeAmt = remaining.Sub(amountIn)
+	}
+	return sqrtNext, amountIn, amountOut, feeAmt
+}
@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Canto-Network/Canto/v8/x/coinswap/keeper"
+	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
+)
+
+func (suite *TestSuite) TestConcentratedLiquidityMath() {
+	suite.Require().Equal(sdkmath.LegacyOneDec(), keeper.TickToSqrtPrice(0))
+	for _, tick := range []int64{types.MinTick, -100_000, -1, 0, 1, 23_027, types.MaxTick} {
+		suite.Require().Equal(tick, keeper.SqrtPriceToTick(keeper.TickToSqrtPrice(tick)), tick)
+	}
+	suite.Require().True(keeper.TickToSqrtPrice(-1).LT(keeper.TickToSqrtPrice(0)))
+
+	sqrtPrice := sdkmath.LegacyOneDec()
+	sqrtA, sqrtB := keeper.TickToSqrtPrice(-1000), keeper.TickToSqrtPrice(1000)
+	amount := sdkmath.NewInt(1_000_000_000)
+	liquidity := keeper.GetLiquidityForAmounts(sqrtPrice, sqrtA, sqrtB, amount, amount)
+	suite.Require().True(liquidity.IsPositive())
+
+	// the amounts deposited for the liquidity do not exceed the amounts given
+	tokenAmt, standardAmt := keeper.GetAmountsForLiquidity(sqrtPrice, sqrtA, sqrtB, liquidity, true)
+	suite.Require().True(tokenAmt.LTE(amount))
+	suite.Require().True(standardAmt.LTE(amount))
+
+	// the amounts withdrawn for the liquidity do not exceed the amounts deposited
+	withdrawnToken, withdrawnStandard := keeper.GetAmountsForLiquidity(sqrtPrice, sqrtA, sqrtB, liquidity, false)
+	suite.Require().True(withdrawnToken.LTE(tokenAmt))
+	suite.Require().True(withdrawnStandard.LTE(standardAmt))
+
+	// a range above the price holds the counterparty coin only, a range below the standard coin only
+	tokenAmt, standardAmt = keeper.GetAmountsForLiquidity(sqrtPrice, sqrtB, keeper.TickToSqrtPrice(2000), liquidity, true)
+	suite.Require().True(tokenAmt.IsPositive())
+	suite.Require().True(standardAmt.IsZero())
+	tokenAmt, standardAmt = keeper.GetAmountsForLiquidity(sqrtPrice, keeper.TickToSqrtPrice(-2000), sqrtA, liquidity, true)
+	suite.Require().True(tokenAmt.IsZero())
+	suite.Require().True(standardAmt.IsPositive())
+}
+
+func (suite *TestSuite) TestConcentratedLiquidityPositions() {
+	sender, _ := createReservePool(suite, denomBTC)
+	ethCoins := sdk.NewCoins(buildCoin(denomETH, 20_000_000_000))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, ethCoins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, ethCoins))
+
+	params := suite.app.CoinswapKeeper.GetParams(suite.ctx)
+	params.Fee = sdkmath.LegacyNewDecWithPrec(3, 3)
+	suite.app.CoinswapKeeper.SetParams(suite.ctx, params)
+
+	deadline := suite.ctx.BlockHeader().Time.Add(time.Hour).Unix()
+	maxAmt := sdkmath.NewInt(1_000_000_000)
+	initialPrice := sdkmath.LegacyOneDec()
+
+	testCases := []struct {
+		name string
+		msg  *types.MsgCreatePosition
+		err  error
+	}{
+		{"fail - invalid tick range", types.NewMsgCreatePosition(sender.String(), sdk.NewCoin(denomETH, maxAmt), maxAmt, 1000, -1000, sdkmath.OneInt(), &initialPrice, deadline), types.ErrInvalidTick},
+		{"fail - not a concentrated pool", types.NewMsgCreatePosition(sender.String(), sdk.NewCoin(denomBTC, maxAmt), maxAmt, -1000, 1000, sdkmath.OneInt(), nil, deadline), types.ErrInvalidPoolType},
+		{"fail - missing initial price", types.NewMsgCreatePosition(sender.String(), sdk.NewCoin(denomETH, maxAmt), maxAmt, -1000, 1000, sdkmath.OneInt(), nil, deadline), sdkerrors.ErrInvalidRequest},
+		{"fail - deadline passed", types.NewMsgCreatePosition(sender.String(), sdk.NewCoin(denomETH, maxAmt), maxAmt, -1000, 1000, sdkmath.OneInt(), &initialPrice, 1), types.ErrInvalidDeadline},
+	}
+	for _, tc := range testCases {
+		_, err := suite.msgServer.CreatePosition(suite.ctx, tc.msg)
+		suite.Require().ErrorIs(err, tc.err, tc.name)
+	}
+
+	// the pool is created at the initial price by the first position
+	res, err := suite.msgServer.CreatePosition(suite.ctx, types.NewMsgCreatePosition(sender.String(), sdk.NewCoin(denomETH, maxAmt), maxAmt, -1000, 1000, sdkmath.OneInt(), &initialPrice, deadline))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.PositionId)
+	suite.Require().True(res.Amounts.AmountOf(denomETH).LTE(maxAmt))
+	suite.Require().True(res.Amounts.AmountOf(denomStandard).LTE(maxAmt))
+
+	pool, has := suite.app.CoinswapKeeper.GetPool(suite.ctx, types.GetPoolId(denomStandard, denomETH))
+	suite.Require().True(has)
+	suite.Require().Equal(types.POOL_TYPE_CONCENTRATED, pool.PoolType)
+	escrow := types.GetReservePoolAddr(pool.LptDenom)
+	suite.Require().Equal(res.Amounts, suite.app.BankKeeper.GetAllBalances(suite.ctx, escrow))
+
+	// a range above the price is provided with the counterparty coin only
+	res, err = suite.msgServer.CreatePosition(suite.ctx, types.NewMsgCreatePosition(sender.String(), sdk.NewCoin(denomETH, maxAmt), maxAmt, 2000, 3000, sdkmath.OneInt(), nil, deadline))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res.PositionId)
+	suite.Require().True(res.Amounts.AmountOf(denomStandard).IsZero())
+
+	// the pool does not accept the constant product liquidity messages
+	_, err = suite.msgServer.AddLiquidity(suite.ctx, types.NewMsgAddLiquidity(sdk.NewCoin(denomETH, maxAmt), maxAmt, sdkmath.OneInt(), deadline, sender.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidPoolType)
+
+	// buying the counterparty coin moves the price up and accrues fees in standard coin
+	_, err = suite.msgServer.SwapCoin(suite.ctx, types.NewMsgSwapOrder(
+		types.Input{Coin: buildCoin(denomStandard, 1_000_000), Address: sender.String()},
+		types.Output{Coin: buildCoin(denomETH, 990_000), Address: sender.String()},
+		deadline,
+		false,
+	))
+	suite.Require().NoError(err)
+	state, found := suite.app.CoinswapKeeper.GetConcentratedPoolState(suite.ctx, pool.Id)
+	suite.Require().True(found)
+	suite.Require().True(state.SqrtPrice.GT(sdkmath.LegacyOneDec()))
+	suite.Require().True(state.FeeGrowthGlobalStandard.IsPositive())
+
+	// an exact output swap is priced in its own direction, leaving the pool at a
+	// higher price than an exact input swap of the same quoted input, the input
+	// being rounded up in favor of the pool
+	for _, amount := range []int64{1, 1_093, 797_161} {
+		tokenOut := buildCoin(denomStandard, amount)
+		quote, err := suite.app.CoinswapKeeper.EstimateSwapExactOut(suite.ctx, &types.QueryEstimateSwapExactOutRequest{TokenOut: tokenOut, TokenInDenom: denomETH})
+		suite.Require().NoError(err)
+
+		exactOutCtx, _ := suite.ctx.CacheContext()
+		_, err = suite.msgServer.SwapCoin(exactOutCtx, types.NewMsgSwapOrder(
+			types.Input{Coin: sdk.NewCoin(denomETH, maxAmt), Address: sender.String()},
+			types.Output{Coin: tokenOut, Address: sender.String()},
+			deadline,
+			true,
+		))
+		suite.Require().NoError(err)
+		exactInCtx, _ := suite.ctx.CacheContext()
+		_, err = suite.msgServer.SwapCoin(exactInCtx, types.NewMsgSwapOrder(
+			types.Input{Coin: quote.TokenIn, Address: sender.String()},
+			types.Output{Coin: tokenOut, Address: sender.String()},
+			deadline,
+			false,
+		))
+		suite.Require().NoError(err)
+
+		exactOutState, _ := suite.app.CoinswapKeeper.GetConcentratedPoolState(exactOutCtx, pool.Id)
+		exactInState, _ := suite.app.CoinswapKeeper.GetConcentratedPoolState(exactInCtx, pool.Id)
+		suite.Require().True(exactOutState.SqrtPrice.GT(exactInState.SqrtPrice), amount)
+	}
+
+	positionRes, err := suite.app.CoinswapKeeper.Position(suite.ctx, &types.QueryPositionRequest{PositionId: 1})
+	suite.Require().NoError(err)
+	suite.Require().True(positionRes.UncollectedFees.AmountOf(denomStandard).IsPositive())
+	positionsRes, err := suite.app.CoinswapKeeper.Positions(suite.ctx, &types.QueryPositionsRequest{Owner: sender.String(), LptDenom: pool.LptDenom})
+	suite.Require().NoError(err)
+	suite.Require().Len(positionsRes.Positions, 2)
+
+	// only the owner can collect the fees
+	_, err = suite.msgServer.CollectFees(suite.ctx, types.NewMsgCollectFees(addrSender1.String(), 1))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, denomStandard)
+	collectRes, err := suite.msgServer.CollectFees(suite.ctx, types.NewMsgCollectFees(sender.String(), 1))
+	suite.Require().NoError(err)
+	suite.Require().Equal(positionRes.UncollectedFees, collectRes.Fees)
+	suite.Require().Equal(balance.Add(collectRes.Fees[0]), suite.app.BankKeeper.GetBalance(suite.ctx, sender, denomStandard))
+
+	// removing all the liquidity of the position deletes it
+	_, err = suite.msgServer.DecreasePosition(suite.ctx, types.NewMsgDecreasePosition(sender.String(), 1, positionRes.Position.Liquidity.AddRaw(1), sdkmath.ZeroInt(), sdkmath.ZeroInt(), deadline))
+	suite.Require().ErrorIs(err, types.ErrInsufficientFunds)
+	decreaseRes, err := suite.msgServer.DecreasePosition(suite.ctx, types.NewMsgDecreasePosition(sender.String(), 1, positionRes.Position.Liquidity, sdkmath.ZeroInt(), sdkmath.ZeroInt(), deadline))
+	suite.Require().NoError(err)
+	suite.Require().True(decreaseRes.Amounts.AmountOf(denomStandard).IsPositive())
+	_, err = suite.app.CoinswapKeeper.Position(suite.ctx, &types.QueryPositionRequest{PositionId: 1})
+	suite.Require().ErrorIs(err, types.ErrPositionNotFound)
+
+	// the out of range position still holds the counterparty coin only
+	state, _ = suite.app.CoinswapKeeper.GetConcentratedPoolState(suite.ctx, pool.Id)
+	suite.Require().True(state.Liquidity.IsZero())
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, escrow, denomETH).IsPositive())
+}
+
+func (suite *TestSuite) TestConcentratedTicksByPool() {
+	sender, _ := createReservePool(suite, denomBTC)
+	ethCoins := sdk.NewCoins(buildCoin(denomETH, 20_000_000_000))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, ethCoins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, ethCoins))
+
+	deadline := suite.ctx.BlockHeader().Time.Add(time.Hour).Unix()
+	maxAmt := sdkmath.NewInt(1_000_000_000)
+	initialPrice := sdkmath.LegacyOneDec()
+	_, err := suite.msgServer.CreatePosition(suite.ctx, types.NewMsgCreatePosition(sender.String(), sdk.NewCoin(denomETH, maxAmt), maxAmt, -1000, 1000, sdkmath.OneInt(), &initialPrice, deadline))
+	suite.Require().NoError(err)
+
+	// the liquidity of the pool cannot buy more than the position holds
+	req := &types.QueryEstimateSwapExactOutRequest{TokenOut: sdk.NewCoin(denomETH, maxAmt.MulRaw(2)), TokenInDenom: denomStandard}
+	_, err = suite.app.CoinswapKeeper.EstimateSwapExactOut(suite.ctx, req)
+	suite.Require().ErrorIs(err, types.ErrInsufficientFunds)
+
+	// the ticks of a pool whose id extends the id of the pool are not crossed by its swaps
+	suite.app.CoinswapKeeper.SetTick(suite.ctx, types.Tick{
+		PoolId:                   types.GetPoolId(denomETH, denomStandard+"/x"),
+		Index:                    2000,
+		LiquidityGross:           maxAmt.MulRaw(1000),
+		LiquidityNet:             maxAmt.MulRaw(1000),
+		FeeGrowthOutsideStandard: sdkmath.LegacyZeroDec(),
+		FeeGrowthOutsideToken:    sdkmath.LegacyZeroDec(),
+	})
+	_, err = suite.app.CoinswapKeeper.EstimateSwapExactOut(suite.ctx, req)
+	suite.Require().ErrorIs(err, types.ErrInsufficientFunds)
+}
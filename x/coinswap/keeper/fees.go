@@ -2,7 +2,10 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
@@ -10,17 +13,31 @@ import (
 
 // DeductPoolCreationFee performs fee handling for creating liquidity pool
 func (k Keeper) DeductPoolCreationFee(ctx sdk.Context, creator sdk.AccAddress) error {
+	return k.deductCreationFee(ctx, creator, k.GetParams(ctx).PoolCreationFee)
+}
+
+// DeductGaugeCreationFee performs fee handling for creating a gauge
+func (k Keeper) DeductGaugeCreationFee(ctx sdk.Context, creator sdk.AccAddress) error {
+	gaugeCreationFee := k.GetParams(ctx).GaugeCreationFee
+	if gaugeCreationFee.Amount.IsNil() {
+		return nil
+	}
+	return k.deductCreationFee(ctx, creator, gaugeCreationFee)
+}
+
+// deductCreationFee sends the TaxRate share of the creation fee to the fee
+// collector and burns the rest
+func (k Keeper) deductCreationFee(ctx sdk.Context, creator sdk.AccAddress, creationFee sdk.Coin) error {
 	params := k.GetParams(ctx)
-	poolCreationFee := params.PoolCreationFee
 
 	// compute community tax and burned coin
-	communityTaxCoin := sdk.NewCoin(poolCreationFee.Denom,
-		sdkmath.LegacyNewDecFromInt(poolCreationFee.Amount).Mul(params.TaxRate).TruncateInt())
-	burnedCoins := sdk.NewCoins(poolCreationFee.Sub(communityTaxCoin))
+	communityTaxCoin := sdk.NewCoin(creationFee.Denom,
+		sdkmath.LegacyNewDecFromInt(creationFee.Amount).Mul(params.TaxRate).TruncateInt())
+	burnedCoins := sdk.NewCoins(creationFee.Sub(communityTaxCoin))
 
 	// send all fees to module account
 	if err := k.bk.SendCoinsFromAccountToModule(
-		ctx, creator, types.ModuleName, sdk.NewCoins(poolCreationFee),
+		ctx, creator, types.ModuleName, sdk.NewCoins(creationFee),
 	); err != nil {
 		return err
 	}
@@ -33,3 +50,69 @@ func (k Keeper) DeductPoolCreationFee(ctx sdk.Context, creator sdk.AccAddress) e
 	// burn burnedCoin
 	return k.bk.BurnCoins(ctx, types.ModuleName, burnedCoins)
 }
+
+// DeductProtocolFee moves the protocol share of the swap fee charged on
+// coinSold from the reserve pool to the fee collector
+func (k Keeper) DeductProtocolFee(ctx sdk.Context, lptDenom string, coinSold sdk.Coin) error {
+	params := k.GetParams(ctx)
+	fee := k.GetPoolFee(ctx, lptDenom)
+	protocolFee := sdk.NewCoin(coinSold.Denom,
+		sdkmath.LegacyNewDecFromInt(coinSold.Amount).Mul(fee).Mul(params.SwapProtocolFeeShare).TruncateInt())
+	if !protocolFee.IsPositive() {
+		return nil
+	}
+
+	pool, has := k.GetPoolByLptDenom(ctx, lptDenom)
+	if !has {
+		return errorsmod.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", lptDenom)
+	}
+
+	poolAddr := types.GetReservePoolAddr(lptDenom)
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, poolAddr, k.feeCollectorName, sdk.NewCoins(protocolFee)); err != nil {
+		return err
+	}
+
+	k.SetProtocolFees(ctx, pool.Id, k.GetProtocolFees(ctx, pool.Id).Add(protocolFee))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProtocolFee,
+			sdk.NewAttribute(types.AttributeValuePoolId, pool.Id),
+			sdk.NewAttribute(types.AttributeValueAmount, protocolFee.String()),
+		),
+	)
+	return nil
+}
+
+// GetProtocolFees returns the protocol fees accumulated by the given pool
+func (k Keeper) GetProtocolFees(ctx sdk.Context, poolId string) sdk.Coins {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get(types.GetProtocolFeeKey(poolId))
+	if bz == nil {
+		return sdk.Coins{}
+	}
+
+	protocolFee := &types.ProtocolFee{}
+	k.cdc.MustUnmarshal(bz, protocolFee)
+	return protocolFee.Fees
+}
+
+// SetProtocolFees sets the protocol fees accumulated by the given pool
+func (k Keeper) SetProtocolFees(ctx sdk.Context, poolId string, fees sdk.Coins) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&types.ProtocolFee{PoolId: poolId, Fees: fees})
+	store.Set(types.GetProtocolFeeKey(poolId), bz)
+}
+
+// GetAllProtocolFees returns the protocol fees accumulated by all the pools
+func (k Keeper) GetAllProtocolFees(ctx sdk.Context) (protocolFees []types.ProtocolFee) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyProtocolFee))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var protocolFee types.ProtocolFee
+		k.cdc.MustUnmarshal(iterator.Value(), &protocolFee)
+		protocolFees = append(protocolFees, protocolFee)
+	}
+	return
+}
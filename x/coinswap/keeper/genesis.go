@@ -10,6 +10,10 @@ import (
 
 // InitGenesis initializes the coinswap module's state from a given genesis state.
 func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	//init to prevent nil dec for SwapProtocolFeeShare in genesis exported before it was introduced
+	if genState.Params.SwapProtocolFeeShare.IsNil() {
+		genState.Params.SwapProtocolFeeShare = types.DefaultSwapProtocolFeeShare
+	}
 	if err := types.ValidateGenesis(genState); err != nil {
 		panic(fmt.Errorf("panic for ValidateGenesis,%v", err))
 	}
@@ -24,6 +28,62 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, pool := range genState.Pool {
 		k.setPool(ctx, &pool)
 	}
+	for _, protocolFee := range genState.ProtocolFees {
+		k.SetProtocolFees(ctx, protocolFee.PoolId, protocolFee.Fees)
+	}
+	twapSequences := make(map[string]uint64, len(genState.Pool))
+	for _, record := range genState.TwapRecords {
+		k.setTwapRecord(ctx, record, twapSequences[record.PoolId])
+		twapSequences[record.PoolId]++
+	}
+	for poolId, sequence := range twapSequences {
+		k.setTwapSequence(ctx, poolId, sequence)
+	}
+	for _, stats := range genState.PoolStats {
+		k.SetPoolStats(ctx, stats)
+	}
+	for _, stats := range genState.CurrentPoolStats {
+		k.setCurrentPoolStats(ctx, stats)
+	}
+	for _, reference := range genState.PriceReferences {
+		k.SetPriceReference(ctx, reference)
+	}
+	//keep the default sequence for genesis exported before limit orders were introduced
+	if genState.LimitOrderSequence > 0 {
+		k.setLimitOrderSequence(ctx, genState.LimitOrderSequence)
+	}
+	for _, order := range genState.LimitOrders {
+		k.SetLimitOrder(ctx, order)
+	}
+	for _, state := range genState.ConcentratedPoolStates {
+		k.SetConcentratedPoolState(ctx, state)
+	}
+	for _, tick := range genState.Ticks {
+		k.SetTick(ctx, tick)
+	}
+	//keep the default sequence for genesis exported before positions were introduced
+	if genState.PositionSequence > 0 {
+		k.setPositionSequence(ctx, genState.PositionSequence)
+	}
+	for _, position := range genState.Positions {
+		k.SetPosition(ctx, position)
+	}
+	//keep the default sequences for genesis exported before liquidity mining was introduced
+	if genState.GaugeSequence > 0 {
+		k.setGaugeSequence(ctx, genState.GaugeSequence)
+	}
+	for _, gauge := range genState.Gauges {
+		k.SetGauge(ctx, gauge)
+	}
+	if genState.LockSequence > 0 {
+		k.setLockSequence(ctx, genState.LockSequence)
+	}
+	for _, lock := range genState.Locks {
+		k.SetLock(ctx, lock)
+	}
+	for _, lockRewards := range genState.LockRewards {
+		k.SetLockRewards(ctx, lockRewards)
+	}
 }
 
 // ExportGenesis returns the coinswap module's genesis state.
@@ -35,9 +95,25 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
 	}
 	standardDenom, _ := k.GetStandardDenom(ctx)
 	return types.GenesisState{
-		Params:        params,
-		StandardDenom: standardDenom,
-		Pool:          k.GetAllPools(ctx),
-		Sequence:      k.getSequence(ctx),
+		Params:                 params,
+		StandardDenom:          standardDenom,
+		Pool:                   k.GetAllPools(ctx),
+		Sequence:               k.getSequence(ctx),
+		ProtocolFees:           k.GetAllProtocolFees(ctx),
+		TwapRecords:            k.GetAllTwapRecords(ctx),
+		PoolStats:              k.GetAllPoolStats(ctx),
+		CurrentPoolStats:       k.GetAllCurrentPoolStats(ctx),
+		PriceReferences:        k.GetAllPriceReferences(ctx),
+		LimitOrders:            k.GetAllLimitOrders(ctx),
+		LimitOrderSequence:     k.getLimitOrderSequence(ctx),
+		ConcentratedPoolStates: k.GetAllConcentratedPoolStates(ctx),
+		Ticks:                  k.GetAllTicks(ctx),
+		Positions:              k.GetAllPositions(ctx),
+		PositionSequence:       k.getPositionSequence(ctx),
+		Gauges:                 k.GetAllGauges(ctx),
+		GaugeSequence:          k.getGaugeSequence(ctx),
+		Locks:                  k.GetAllLocks(ctx),
+		LockSequence:           k.getLockSequence(ctx),
+		LockRewards:            k.GetAllLockRewards(ctx),
 	}
 }
@@ -2,9 +2,13 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
 )
 
@@ -14,17 +18,127 @@ func TestGenesisSuite(t *testing.T) {
 
 func (suite *TestSuite) TestInitGenesisAndExportGenesis() {
 	k, ctx := suite.app.CoinswapKeeper, suite.ctx
+	maxBlockPriceDeviation := sdkmath.LegacyNewDecWithPrec(1, 1)
 	expGenesis := types.GenesisState{
 		Params:        types.DefaultParams(),
 		StandardDenom: denomStandard,
 		Pool: []types.Pool{{
-			Id:                types.GetPoolId(denomETH),
+			Id:                types.GetPoolId(denomStandard, denomBTC),
 			StandardDenom:     denomStandard,
-			CounterpartyDenom: denomETH,
-			EscrowAddress:     types.GetReservePoolAddr("lpt-1").String(),
-			LptDenom:          "lpt-1",
+			CounterpartyDenom: denomBTC,
+			EscrowAddress:     types.GetReservePoolAddr("lpt-2").String(),
+			LptDenom:          "lpt-2",
+			PoolType:          types.POOL_TYPE_CONCENTRATED,
+		}, {
+			Id:                     types.GetPoolId(denomStandard, denomETH),
+			StandardDenom:          denomStandard,
+			CounterpartyDenom:      denomETH,
+			EscrowAddress:          types.GetReservePoolAddr("lpt-1").String(),
+			LptDenom:               "lpt-1",
+			MaxBlockPriceDeviation: &maxBlockPriceDeviation,
+		}},
+		Sequence: 3,
+		ProtocolFees: []types.ProtocolFee{{
+			PoolId: types.GetPoolId(denomStandard, denomETH),
+			Fees:   sdk.NewCoins(sdk.NewInt64Coin(denomETH, 1000)),
+		}},
+		TwapRecords: []types.TwapRecord{{
+			PoolId:          types.GetPoolId(denomStandard, denomETH),
+			Height:          1,
+			Time:            time.Unix(1722234651, 0).UTC(),
+			PriceCumulative: sdkmath.LegacyNewDec(100),
+		}},
+		PoolStats: []types.PoolStats{{
+			PoolId:         types.GetPoolId(denomStandard, denomETH),
+			EpochNumber:    1,
+			SwapCount:      2,
+			StandardVolume: sdkmath.NewInt(2000),
+			TokenVolume:    sdkmath.NewInt(1000),
+			Fees:           sdk.NewCoins(sdk.NewInt64Coin(denomETH, 3)),
+			Liquidity:      sdkmath.NewInt(10000),
+		}},
+		CurrentPoolStats: []types.PoolStats{{
+			PoolId:         types.GetPoolId(denomStandard, denomETH),
+			SwapCount:      1,
+			StandardVolume: sdkmath.NewInt(500),
+			TokenVolume:    sdkmath.NewInt(250),
+			Fees:           sdk.NewCoins(sdk.NewInt64Coin(denomStandard, 1)),
+			Liquidity:      sdkmath.ZeroInt(),
+		}},
+		PriceReferences: []types.PriceReference{{
+			PoolId:     types.GetPoolId(denomStandard, denomETH),
+			Height:     1,
+			BlockPrice: sdkmath.LegacyNewDec(2),
+			EpochPrice: sdkmath.LegacyNewDecWithPrec(19, 1),
+		}},
+		LimitOrders: []types.LimitOrder{{
+			Id:          3,
+			Owner:       addrSender1.String(),
+			LptDenom:    "lpt-1",
+			TokenIn:     sdk.NewInt64Coin(denomETH, 1000),
+			MinTokenOut: sdk.NewInt64Coin(denomStandard, 2000),
+			Deadline:    1722234651,
+		}},
+		LimitOrderSequence: 4,
+		ConcentratedPoolStates: []types.ConcentratedPoolState{{
+			PoolId:                  types.GetPoolId(denomStandard, denomBTC),
+			SqrtPrice:               sdkmath.LegacyOneDec(),
+			Liquidity:               sdkmath.NewInt(1000),
+			FeeGrowthGlobalStandard: sdkmath.LegacyNewDecWithPrec(1, 2),
+			FeeGrowthGlobalToken:    sdkmath.LegacyZeroDec(),
+		}},
+		Ticks: []types.Tick{{
+			PoolId:                   types.GetPoolId(denomStandard, denomBTC),
+			Index:                    -10,
+			LiquidityGross:           sdkmath.NewInt(1000),
+			LiquidityNet:             sdkmath.NewInt(1000),
+			FeeGrowthOutsideStandard: sdkmath.LegacyNewDecWithPrec(1, 2),
+			FeeGrowthOutsideToken:    sdkmath.LegacyZeroDec(),
+		}, {
+			PoolId:                   types.GetPoolId(denomStandard, denomBTC),
+			Index:                    10,
+			LiquidityGross:           sdkmath.NewInt(1000),
+			LiquidityNet:             sdkmath.NewInt(-1000),
+			FeeGrowthOutsideStandard: sdkmath.LegacyZeroDec(),
+			FeeGrowthOutsideToken:    sdkmath.LegacyZeroDec(),
+		}},
+		Positions: []types.Position{{
+			Id:                          1,
+			Owner:                       addrSender1.String(),
+			LptDenom:                    "lpt-2",
+			LowerTick:                   -10,
+			UpperTick:                   10,
+			Liquidity:                   sdkmath.NewInt(1000),
+			FeeGrowthInsideStandardLast: sdkmath.LegacyZeroDec(),
+			FeeGrowthInsideTokenLast:    sdkmath.LegacyZeroDec(),
+			TokensOwed:                  sdk.NewCoins(sdk.NewInt64Coin(denomStandard, 10)),
+		}},
+		PositionSequence: 2,
+		Gauges: []types.Gauge{{
+			Id:               1,
+			Owner:            addrSender1.String(),
+			LptDenom:         "lpt-1",
+			Coins:            sdk.NewCoins(sdk.NewInt64Coin(denomStandard, 1000)),
+			NumEpochs:        2,
+			FilledEpochs:     1,
+			DistributedCoins: sdk.NewCoins(sdk.NewInt64Coin(denomStandard, 500)),
+		}},
+		GaugeSequence: 2,
+		Locks: []types.Lock{{
+			Id:                 1,
+			Owner:              addrSender1.String(),
+			Coin:               sdk.NewInt64Coin("lpt-1", 1000),
+			Duration:           time.Hour,
+			EndTime:            time.Unix(1722234651, 0).UTC(),
+			RewardPerShareLast: sdk.NewDecCoins(sdk.NewDecCoinFromDec(denomStandard, sdkmath.LegacyNewDecWithPrec(1, 1))),
+			RewardsOwed:        sdk.NewCoins(sdk.NewInt64Coin(denomStandard, 400)),
+		}},
+		LockSequence: 2,
+		LockRewards: []types.LockRewards{{
+			LptDenom:       "lpt-1",
+			TotalLocked:    sdkmath.NewInt(1000),
+			RewardPerShare: sdk.NewDecCoins(sdk.NewDecCoinFromDec(denomStandard, sdkmath.LegacyNewDecWithPrec(5, 1))),
 		}},
-		Sequence: 2,
 	}
 	k.InitGenesis(suite.ctx, expGenesis)
 	genState := k.ExportGenesis(ctx)
@@ -46,7 +46,6 @@ func (k Keeper) LiquidityPool(c context.Context, req *types.QueryLiquidityPoolRe
 	token := sdk.NewCoin(pool.CounterpartyDenom, balances.AmountOf(pool.CounterpartyDenom))
 	liquidity := k.bk.GetSupply(ctx, pool.LptDenom)
 
-	params := k.GetParams(ctx)
 	res := types.QueryLiquidityPoolResponse{
 		Pool: types.PoolInfo{
 			Id:            pool.Id,
@@ -54,9 +53,15 @@ func (k Keeper) LiquidityPool(c context.Context, req *types.QueryLiquidityPoolRe
 			Standard:      standard,
 			Token:         token,
 			Lpt:           liquidity,
-			Fee:           params.Fee.String(),
+			Fee:           k.GetPoolFee(ctx, pool.LptDenom).String(),
+			PoolType:      pool.PoolType,
+			Amplification: pool.Amplification,
+			Status:        pool.Status,
 		},
 	}
+	if state, found := k.GetConcentratedPoolState(ctx, pool.Id); found {
+		res.Pool.ConcentratedState = &state
+	}
 	return &res, nil
 }
 
@@ -65,7 +70,6 @@ func (k Keeper) LiquidityPools(c context.Context, req *types.QueryLiquidityPools
 		return nil, status.Errorf(codes.InvalidArgument, "empty request")
 	}
 	ctx := sdk.UnwrapSDKContext(c)
-	params := k.GetParams(ctx)
 
 	var pools []types.PoolInfo
 
@@ -80,14 +84,21 @@ func (k Keeper) LiquidityPools(c context.Context, req *types.QueryLiquidityPools
 			return err
 		}
 
-		pools = append(pools, types.PoolInfo{
+		poolInfo := types.PoolInfo{
 			Id:            pool.Id,
 			EscrowAddress: pool.EscrowAddress,
 			Standard:      sdk.NewCoin(pool.StandardDenom, balances.AmountOf(pool.StandardDenom)),
 			Token:         sdk.NewCoin(pool.CounterpartyDenom, balances.AmountOf(pool.CounterpartyDenom)),
 			Lpt:           k.bk.GetSupply(ctx, pool.LptDenom),
-			Fee:           params.Fee.String(),
-		})
+			Fee:           k.GetPoolFee(ctx, pool.LptDenom).String(),
+			PoolType:      pool.PoolType,
+			Amplification: pool.Amplification,
+			Status:        pool.Status,
+		}
+		if state, found := k.GetConcentratedPoolState(ctx, pool.Id); found {
+			poolInfo.ConcentratedState = &state
+		}
+		pools = append(pools, poolInfo)
 		return nil
 	})
 	if err != nil {
@@ -98,3 +109,401 @@ func (k Keeper) LiquidityPools(c context.Context, req *types.QueryLiquidityPools
 		Pools:      pools,
 	}, nil
 }
+
+// ProtocolFees returns the protocol fees accumulated by the liquidity pool of the denom
+func (k Keeper) ProtocolFees(c context.Context, req *types.QueryProtocolFeesRequest) (*types.QueryProtocolFeesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	pool, exists := k.GetPoolByLptDenom(ctx, req.LptDenom)
+	if !exists {
+		return nil, errorsmod.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", req.LptDenom)
+	}
+
+	return &types.QueryProtocolFeesResponse{Fees: k.GetProtocolFees(ctx, pool.Id)}, nil
+}
+
+// Twap returns the time weighted average price of the liquidity pool of the denom
+func (k Keeper) Twap(c context.Context, req *types.QueryTwapRequest) (*types.QueryTwapResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	twap, err := k.GetTwap(ctx, req.LptDenom, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryTwapResponse{Twap: twap}, nil
+}
+
+// EstimateSwapExactIn returns the estimated output of selling an exact amount of token
+func (k Keeper) EstimateSwapExactIn(c context.Context, req *types.QueryEstimateSwapExactInRequest) (*types.QueryEstimateSwapExactInResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if !(req.TokenIn.IsValid() && req.TokenIn.IsPositive()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token in: %s", req.TokenIn.String())
+	}
+
+	if req.TokenIn.Denom == req.TokenOutDenom {
+		return nil, status.Errorf(codes.InvalidArgument, "token in and token out denom are equal: %s", req.TokenOutDenom)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return k.QuoteSwapExactIn(ctx, req.TokenIn, req.TokenOutDenom)
+}
+
+// EstimateSwapExactOut returns the estimated input of buying an exact amount of token
+func (k Keeper) EstimateSwapExactOut(c context.Context, req *types.QueryEstimateSwapExactOutRequest) (*types.QueryEstimateSwapExactOutResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if !(req.TokenOut.IsValid() && req.TokenOut.IsPositive()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token out: %s", req.TokenOut.String())
+	}
+
+	if req.TokenOut.Denom == req.TokenInDenom {
+		return nil, status.Errorf(codes.InvalidArgument, "token in and token out denom are equal: %s", req.TokenInDenom)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return k.QuoteSwapExactOut(ctx, req.TokenOut, req.TokenInDenom)
+}
+
+// PoolStats returns the statistics of the ended epochs of the liquidity pool of the denom
+func (k Keeper) PoolStats(c context.Context, req *types.QueryPoolStatsRequest) (*types.QueryPoolStatsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	pool, exists := k.GetPoolByLptDenom(ctx, req.LptDenom)
+	if !exists {
+		return nil, errorsmod.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", req.LptDenom)
+	}
+
+	var stats []types.PoolStats
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	statsStore := prefix.NewStore(store, types.GetPoolStatsPrefix(pool.Id))
+	pageRes, err := query.Paginate(statsStore, req.Pagination, func(_ []byte, value []byte) error {
+		var s types.PoolStats
+		k.cdc.MustUnmarshal(value, &s)
+		stats = append(stats, s)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPoolStatsResponse{
+		Stats:      stats,
+		Pagination: pageRes,
+	}, nil
+}
+
+// AllPoolStats returns the statistics of the ended epochs of all the liquidity pools
+func (k Keeper) AllPoolStats(c context.Context, req *types.QueryAllPoolStatsRequest) (*types.QueryAllPoolStatsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var stats []types.PoolStats
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	statsStore := prefix.NewStore(store, []byte(types.KeyPoolStats+"/"))
+	pageRes, err := query.Paginate(statsStore, req.Pagination, func(_ []byte, value []byte) error {
+		var s types.PoolStats
+		k.cdc.MustUnmarshal(value, &s)
+		stats = append(stats, s)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAllPoolStatsResponse{
+		Stats:      stats,
+		Pagination: pageRes,
+	}, nil
+}
+
+// LimitOrder returns the open limit order of the order id
+func (k Keeper) LimitOrder(c context.Context, req *types.QueryLimitOrderRequest) (*types.QueryLimitOrderResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	order, found := k.GetLimitOrder(ctx, req.OrderId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrLimitOrderNotFound, "order id: %d", req.OrderId)
+	}
+	return &types.QueryLimitOrderResponse{Order: order}, nil
+}
+
+// LimitOrders returns the open limit orders, optionally filtered by owner and liquidity pool
+func (k Keeper) LimitOrders(c context.Context, req *types.QueryLimitOrdersRequest) (*types.QueryLimitOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", err)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	// the orders are iterated through the owner or pool index when filtered,
+	// the index values being the order ids
+	var orderStore prefix.Store
+	switch {
+	case req.Owner != "":
+		orderStore = prefix.NewStore(store, types.GetLimitOrderByOwnerPrefix(req.Owner))
+	case req.LptDenom != "":
+		orderStore = prefix.NewStore(store, types.GetLimitOrderByPoolPrefix(req.LptDenom))
+	default:
+		orderStore = prefix.NewStore(store, []byte(types.KeyLimitOrder+"/"))
+	}
+	isIndex := req.Owner != "" || req.LptDenom != ""
+
+	var orders []types.LimitOrder
+	pageRes, err := query.FilteredPaginate(orderStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var order types.LimitOrder
+		if isIndex {
+			order, _ = k.GetLimitOrder(ctx, sdk.BigEndianToUint64(value))
+		} else {
+			k.cdc.MustUnmarshal(value, &order)
+		}
+
+		if req.LptDenom != "" && order.LptDenom != req.LptDenom {
+			return false, nil
+		}
+		if accumulate {
+			orders = append(orders, order)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryLimitOrdersResponse{
+		Orders:     orders,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Position returns the concentrated liquidity position of the given id and its uncollected fees
+func (k Keeper) Position(c context.Context, req *types.QueryPositionRequest) (*types.QueryPositionResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	position, found := k.GetPosition(ctx, req.PositionId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrPositionNotFound, "position id: %d", req.PositionId)
+	}
+
+	fees, err := k.GetPositionFees(ctx, position)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPositionResponse{Position: position, UncollectedFees: fees}, nil
+}
+
+// Positions returns the concentrated liquidity positions, optionally filtered by owner and liquidity pool
+func (k Keeper) Positions(c context.Context, req *types.QueryPositionsRequest) (*types.QueryPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", err)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	// the positions are iterated through the owner or pool index when filtered,
+	// the index values being the position ids
+	var positionStore prefix.Store
+	switch {
+	case req.Owner != "":
+		positionStore = prefix.NewStore(store, types.GetPositionByOwnerPrefix(req.Owner))
+	case req.LptDenom != "":
+		positionStore = prefix.NewStore(store, types.GetPositionByPoolPrefix(req.LptDenom))
+	default:
+		positionStore = prefix.NewStore(store, []byte(types.KeyPosition+"/"))
+	}
+	isIndex := req.Owner != "" || req.LptDenom != ""
+
+	var positions []types.Position
+	pageRes, err := query.FilteredPaginate(positionStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var position types.Position
+		if isIndex {
+			position, _ = k.GetPosition(ctx, sdk.BigEndianToUint64(value))
+		} else {
+			k.cdc.MustUnmarshal(value, &position)
+		}
+
+		if req.LptDenom != "" && position.LptDenom != req.LptDenom {
+			return false, nil
+		}
+		if accumulate {
+			positions = append(positions, position)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPositionsResponse{
+		Positions:  positions,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Gauge returns the liquidity mining gauge of the given id
+func (k Keeper) Gauge(c context.Context, req *types.QueryGaugeRequest) (*types.QueryGaugeResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	gauge, found := k.GetGauge(ctx, req.GaugeId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrGaugeNotFound, "gauge id: %d", req.GaugeId)
+	}
+	return &types.QueryGaugeResponse{Gauge: gauge}, nil
+}
+
+// Gauges returns the liquidity mining gauges, optionally filtered by liquidity pool
+func (k Keeper) Gauges(c context.Context, req *types.QueryGaugesRequest) (*types.QueryGaugesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	// the gauges are iterated through the pool index when filtered, the index
+	// values being the gauge ids
+	gaugeStore := prefix.NewStore(store, []byte(types.KeyGauge+"/"))
+	isIndex := req.LptDenom != ""
+	if isIndex {
+		gaugeStore = prefix.NewStore(store, types.GetGaugeByPoolPrefix(req.LptDenom))
+	}
+
+	var gauges []types.Gauge
+	pageRes, err := query.Paginate(gaugeStore, req.Pagination, func(_ []byte, value []byte) error {
+		var gauge types.Gauge
+		if isIndex {
+			gauge, _ = k.GetGauge(ctx, sdk.BigEndianToUint64(value))
+		} else {
+			k.cdc.MustUnmarshal(value, &gauge)
+		}
+		gauges = append(gauges, gauge)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryGaugesResponse{
+		Gauges:     gauges,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Lock returns the liquidity lock of the given id along with its pending rewards
+func (k Keeper) Lock(c context.Context, req *types.QueryLockRequest) (*types.QueryLockResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	lock, found := k.GetLock(ctx, req.LockId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrLockNotFound, "lock id: %d", req.LockId)
+	}
+	return &types.QueryLockResponse{Lock: lock, PendingRewards: k.GetLockPendingRewards(ctx, lock)}, nil
+}
+
+// Locks returns the liquidity locks, optionally filtered by owner and liquidity pool
+func (k Keeper) Locks(c context.Context, req *types.QueryLocksRequest) (*types.QueryLocksResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", err)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	// the locks are iterated through the owner or pool index when filtered,
+	// the index values being the lock ids
+	var lockStore prefix.Store
+	switch {
+	case req.Owner != "":
+		lockStore = prefix.NewStore(store, types.GetLockByOwnerPrefix(req.Owner))
+	case req.LptDenom != "":
+		lockStore = prefix.NewStore(store, types.GetLockByPoolPrefix(req.LptDenom))
+	default:
+		lockStore = prefix.NewStore(store, []byte(types.KeyLock+"/"))
+	}
+	isIndex := req.Owner != "" || req.LptDenom != ""
+
+	var locks []types.Lock
+	pageRes, err := query.FilteredPaginate(lockStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var lock types.Lock
+		if isIndex {
+			lock, _ = k.GetLock(ctx, sdk.BigEndianToUint64(value))
+		} else {
+			k.cdc.MustUnmarshal(value, &lock)
+		}
+
+		if req.LptDenom != "" && lock.Coin.Denom != req.LptDenom {
+			return false, nil
+		}
+		if accumulate {
+			locks = append(locks, lock)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryLocksResponse{
+		Locks:      locks,
+		Pagination: pageRes,
+	}, nil
+}
+
+// PendingRewards returns the rewards earned by all the liquidity locks of the owner and not claimed yet
+func (k Keeper) PendingRewards(c context.Context, req *types.QueryPendingRewardsRequest) (*types.QueryPendingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rewards := sdk.NewCoins()
+	for _, lock := range k.getLocksByOwner(ctx, req.Owner) {
+		rewards = rewards.Add(k.GetLockPendingRewards(ctx, lock)...)
+	}
+	return &types.QueryPendingRewardsResponse{Rewards: rewards}, nil
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
@@ -14,7 +15,7 @@ func (s *TestSuite) TestGRPCParams() {
 
 func (s *TestSuite) TestGRPCPool() {
 	_, _ = createReservePool(s, denomBTC)
-	poolId := types.GetPoolId(denomBTC)
+	poolId := types.GetPoolId(denomStandard, denomBTC)
 	pool, _ := s.app.CoinswapKeeper.GetPool(s.ctx, poolId)
 
 	resp, err := s.queryClient.LiquidityPool(s.ctx, &types.QueryLiquidityPoolRequest{LptDenom: pool.LptDenom})
@@ -45,3 +46,64 @@ func (s *TestSuite) TestGRPCPools() {
 	s.Require().NoError(err)
 	s.Require().Len(resp.Pools, 2)
 }
+
+func (s *TestSuite) TestGRPCEstimateSwapExactIn() {
+	sender, _ := createReservePool(s, denomBTC)
+	_, _ = createReservePool(s, denomETH)
+
+	tokenIn := sdk.NewCoin(denomBTC, sdkmath.NewInt(1_000_000))
+	resp, err := s.queryClient.EstimateSwapExactIn(s.ctx, &types.QueryEstimateSwapExactInRequest{
+		TokenIn:       tokenIn,
+		TokenOutDenom: denomETH,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{denomBTC, denomStandard, denomETH}, resp.Route)
+	s.Require().False(resp.MaxSwapAmountExceeded)
+	s.Require().True(resp.PriceImpact.IsPositive())
+
+	// the estimate matches the executed swap
+	amt, err := s.app.CoinswapKeeper.TradeExactInputForOutputRoute(s.ctx,
+		types.Input{Address: sender.String(), Coin: tokenIn},
+		types.Output{Address: sender.String(), Coin: sdk.NewCoin(denomETH, sdkmath.ZeroInt())},
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(amt, resp.TokenOut.Amount)
+
+	resp, err = s.queryClient.EstimateSwapExactIn(s.ctx, &types.QueryEstimateSwapExactInRequest{
+		TokenIn:       sdk.NewCoin(denomBTC, sdkmath.NewInt(10_000_001)),
+		TokenOutDenom: denomStandard,
+	})
+	s.Require().NoError(err)
+	s.Require().True(resp.MaxSwapAmountExceeded)
+
+	_, err = s.queryClient.EstimateSwapExactIn(s.ctx, &types.QueryEstimateSwapExactInRequest{
+		TokenIn:       tokenIn,
+		TokenOutDenom: denomBTC,
+	})
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestGRPCEstimateSwapExactOut() {
+	sender, _ := createReservePool(s, denomBTC)
+
+	params := s.app.CoinswapKeeper.GetParams(s.ctx)
+	params.Fee = sdkmath.LegacyNewDecWithPrec(3, 3)
+	s.app.CoinswapKeeper.SetParams(s.ctx, params)
+
+	tokenOut := sdk.NewCoin(denomStandard, sdkmath.NewInt(1_000_000))
+	resp, err := s.queryClient.EstimateSwapExactOut(s.ctx, &types.QueryEstimateSwapExactOutRequest{
+		TokenOut:     tokenOut,
+		TokenInDenom: denomBTC,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{denomBTC, denomStandard}, resp.Route)
+	s.Require().Equal(sdkmath.LegacyNewDecFromInt(resp.TokenIn.Amount).Mul(params.Fee).TruncateInt(), resp.Fees.AmountOf(denomBTC))
+
+	// the estimate matches the executed swap
+	amt, err := s.app.CoinswapKeeper.TradeInputForExactOutput(s.ctx,
+		types.Input{Address: sender.String(), Coin: sdk.NewCoin(denomBTC, sdkmath.NewInt(10_000_000))},
+		types.Output{Address: sender.String(), Coin: tokenOut},
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(amt, resp.TokenIn.Amount)
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/Canto-Network/Canto/v8/x/epochs/types"
+)
+
+// BeforeEpochStart: noop, We don't need to do anything here
+func (k Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {
+}
+
+// AfterEpochEnd rolls up the statistics of the pools at the end of the pool
+// statistics epoch, resets the reference prices of the pool circuit breakers
+// at the end of the price deviation epoch, and distributes the liquidity mining
+// gauges at the end of the incentives epoch
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	params := k.GetParams(ctx)
+	if params.PoolStatsEpochIdentifier != "" && epochIdentifier == params.PoolStatsEpochIdentifier {
+		k.RollUpPoolStats(ctx, epochNumber)
+	}
+	if params.PriceDeviationEpochIdentifier != "" && epochIdentifier == params.PriceDeviationEpochIdentifier {
+		k.ResetPriceReferences(ctx)
+	}
+	if params.IncentivesEpochIdentifier != "" && epochIdentifier == params.IncentivesEpochIdentifier {
+		k.DistributeGauges(ctx)
+	}
+}
+
+// ___________________________________________________________________________________________________
+
+// Hooks wrapper struct for coinswap keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// Return the wrapper struct
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// epochs hooks
+func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	h.k.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+}
+
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+}
@@ -0,0 +1,473 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
+)
+
+// CreateGauge deducts the gauge creation fee from the sender, escrows the reward
+// coins of the gauge in the module account and stores the gauge until its epochs
+// are filled
+func (k Keeper) CreateGauge(ctx sdk.Context, msg *types.MsgCreateGauge) (uint64, error) {
+	if err := k.validateLockDenom(ctx, msg.LptDenom); err != nil {
+		return 0, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return 0, err
+	}
+	if err := k.DeductGaugeCreationFee(ctx, sender); err != nil {
+		return 0, err
+	}
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, msg.Coins); err != nil {
+		return 0, err
+	}
+
+	gauge := types.Gauge{
+		Id:        k.getGaugeSequence(ctx),
+		Owner:     msg.Sender,
+		LptDenom:  msg.LptDenom,
+		Coins:     msg.Coins,
+		NumEpochs: msg.NumEpochs,
+	}
+	k.SetGauge(ctx, gauge)
+	k.setGaugeSequence(ctx, gauge.Id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateGauge,
+			sdk.NewAttribute(types.AttributeValueGaugeId, fmt.Sprintf("%d", gauge.Id)),
+			sdk.NewAttribute(types.AttributeValueOwner, gauge.Owner),
+			sdk.NewAttribute(types.AttributeValueTokenPair, gauge.LptDenom),
+			sdk.NewAttribute(types.AttributeValueAmount, gauge.Coins.String()),
+			sdk.NewAttribute(types.AttributeValueNumEpochs, fmt.Sprintf("%d", gauge.NumEpochs)),
+		),
+	)
+	return gauge.Id, nil
+}
+
+// LockLiquidity escrows the liquidity pool coins of the msg in the module account
+// until the end of the lock duration. The lock earns the rewards distributed to
+// its denom from the next distribution on
+func (k Keeper) LockLiquidity(ctx sdk.Context, msg *types.MsgLockLiquidity) (uint64, error) {
+	if err := k.validateLockDenom(ctx, msg.Coin.Denom); err != nil {
+		return 0, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return 0, err
+	}
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(msg.Coin)); err != nil {
+		return 0, err
+	}
+
+	lockRewards := k.GetLockRewards(ctx, msg.Coin.Denom)
+	lock := types.Lock{
+		Id:                 k.getLockSequence(ctx),
+		Owner:              msg.Sender,
+		Coin:               msg.Coin,
+		Duration:           msg.Duration,
+		EndTime:            ctx.BlockTime().Add(msg.Duration),
+		RewardPerShareLast: lockRewards.RewardPerShare,
+	}
+	lockRewards.TotalLocked = lockRewards.TotalLocked.Add(lock.Coin.Amount)
+	k.SetLockRewards(ctx, lockRewards)
+	k.SetLock(ctx, lock)
+	k.setLockSequence(ctx, lock.Id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLockLiquidity,
+			sdk.NewAttribute(types.AttributeValueLockId, fmt.Sprintf("%d", lock.Id)),
+			sdk.NewAttribute(types.AttributeValueOwner, lock.Owner),
+			sdk.NewAttribute(types.AttributeValueAmount, lock.Coin.String()),
+			sdk.NewAttribute(types.AttributeValueEndTime, lock.EndTime.String()),
+		),
+	)
+	return lock.Id, nil
+}
+
+// UnlockLiquidity sends the coins of a lock of the sender past its end time back
+// to the sender along with its rewards, and deletes the lock
+func (k Keeper) UnlockLiquidity(ctx sdk.Context, msg *types.MsgUnlockLiquidity) (sdk.Coin, sdk.Coins, error) {
+	lock, err := k.getOwnedLock(ctx, msg.LockId, msg.Sender)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	if ctx.BlockTime().Before(lock.EndTime) {
+		return sdk.Coin{}, nil, errorsmod.Wrapf(types.ErrLockNotMatured, "lock %d ends at %s", lock.Id, lock.EndTime)
+	}
+
+	rewards, err := k.claimLockRewards(ctx, &lock)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(lock.Owner)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(lock.Coin)); err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	lockRewards := k.GetLockRewards(ctx, lock.Coin.Denom)
+	lockRewards.TotalLocked = lockRewards.TotalLocked.Sub(lock.Coin.Amount)
+	k.SetLockRewards(ctx, lockRewards)
+	k.deleteLock(ctx, lock)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnlockLiquidity,
+			sdk.NewAttribute(types.AttributeValueLockId, fmt.Sprintf("%d", lock.Id)),
+			sdk.NewAttribute(types.AttributeValueOwner, lock.Owner),
+			sdk.NewAttribute(types.AttributeValueAmount, lock.Coin.String()),
+			sdk.NewAttribute(types.AttributeValueRewards, rewards.String()),
+		),
+	)
+	return lock.Coin, rewards, nil
+}
+
+// ClaimLockRewards sends the rewards earned by a lock of the sender to the sender
+func (k Keeper) ClaimLockRewards(ctx sdk.Context, msg *types.MsgClaimLockRewards) (sdk.Coins, error) {
+	lock, err := k.getOwnedLock(ctx, msg.LockId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, err := k.claimLockRewards(ctx, &lock)
+	if err != nil {
+		return nil, err
+	}
+	k.SetLock(ctx, lock)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaimLockRewards,
+			sdk.NewAttribute(types.AttributeValueLockId, fmt.Sprintf("%d", lock.Id)),
+			sdk.NewAttribute(types.AttributeValueOwner, lock.Owner),
+			sdk.NewAttribute(types.AttributeValueRewards, rewards.String()),
+		),
+	)
+	return rewards, nil
+}
+
+// GetLockPendingRewards returns the rewards earned by the lock and not claimed yet,
+// including the rewards not accrued to the lock yet
+func (k Keeper) GetLockPendingRewards(ctx sdk.Context, lock types.Lock) sdk.Coins {
+	accrueLockRewards(k.GetLockRewards(ctx, lock.Coin.Denom), &lock)
+	return lock.RewardsOwed
+}
+
+// DistributeGauges distributes the share of the epoch of the rewards of each gauge
+// to the locks of its denom, in proportion to their locked amount. The remaining
+// rewards of a gauge are split evenly between its remaining epochs, so that the
+// share of an epoch during which nothing is locked is distributed over the next
+// epochs. Once its epochs are filled, the undistributed rewards of a gauge are
+// refunded to its owner and the gauge is deleted
+func (k Keeper) DistributeGauges(ctx sdk.Context) {
+	for _, gauge := range k.GetAllGauges(ctx) {
+		lockRewards := k.GetLockRewards(ctx, gauge.LptDenom)
+		if lockRewards.TotalLocked.IsPositive() {
+			amount := gauge.Coins.Sub(gauge.DistributedCoins...)
+			if epochsLeft := gauge.NumEpochs - gauge.FilledEpochs; epochsLeft > 1 {
+				amount = sdk.NewCoins(amount.QuoInt(sdkmath.NewIntFromUint64(epochsLeft))...)
+			}
+
+			// the rewards per share are truncated, the dust staying in the module account
+			rewardPerShare := sdk.NewDecCoinsFromCoins(amount...).QuoDecTruncate(sdkmath.LegacyNewDecFromInt(lockRewards.TotalLocked))
+			lockRewards.RewardPerShare = lockRewards.RewardPerShare.Add(rewardPerShare...)
+			k.SetLockRewards(ctx, lockRewards)
+			gauge.DistributedCoins = gauge.DistributedCoins.Add(amount...)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeDistributeGauge,
+					sdk.NewAttribute(types.AttributeValueGaugeId, fmt.Sprintf("%d", gauge.Id)),
+					sdk.NewAttribute(types.AttributeValueTokenPair, gauge.LptDenom),
+					sdk.NewAttribute(types.AttributeValueAmount, amount.String()),
+				),
+			)
+		}
+
+		gauge.FilledEpochs++
+		if gauge.FilledEpochs < gauge.NumEpochs {
+			k.SetGauge(ctx, gauge)
+			continue
+		}
+		if err := k.finishGauge(ctx, gauge); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// finishGauge refunds the rewards of the gauge that were not distributed to its
+// owner, and deletes the gauge
+func (k Keeper) finishGauge(ctx sdk.Context, gauge types.Gauge) error {
+	refund := gauge.Coins.Sub(gauge.DistributedCoins...)
+	if !refund.IsZero() {
+		owner, err := sdk.AccAddressFromBech32(gauge.Owner)
+		if err != nil {
+			return err
+		}
+		// the owner may be the governance account, which cannot receive coins
+		// from a module account through SendCoinsFromModuleToAccount
+		if err := k.bk.SendCoins(ctx, k.ak.GetModuleAddress(types.ModuleName), owner, refund); err != nil {
+			return err
+		}
+	}
+	k.deleteGauge(ctx, gauge)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFinishGauge,
+			sdk.NewAttribute(types.AttributeValueGaugeId, fmt.Sprintf("%d", gauge.Id)),
+			sdk.NewAttribute(types.AttributeValueOwner, gauge.Owner),
+			sdk.NewAttribute(types.AttributeValueTokenPair, gauge.LptDenom),
+			sdk.NewAttribute(types.AttributeValueRefund, refund.String()),
+		),
+	)
+	return nil
+}
+
+// claimLockRewards accrues the rewards earned by the lock and sends them to its owner
+func (k Keeper) claimLockRewards(ctx sdk.Context, lock *types.Lock) (sdk.Coins, error) {
+	accrueLockRewards(k.GetLockRewards(ctx, lock.Coin.Denom), lock)
+	rewards := lock.RewardsOwed
+	lock.RewardsOwed = nil
+	if rewards.IsZero() {
+		return rewards, nil
+	}
+
+	owner, err := sdk.AccAddressFromBech32(lock.Owner)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, rewards); err != nil {
+		return nil, err
+	}
+	return rewards, nil
+}
+
+// accrueLockRewards adds the rewards distributed to the denom of the lock since they
+// were last accrued to the rewards owed to the lock
+func accrueLockRewards(lockRewards types.LockRewards, lock *types.Lock) {
+	earned, _ := lockRewards.RewardPerShare.Sub(lock.RewardPerShareLast).
+		MulDecTruncate(sdkmath.LegacyNewDecFromInt(lock.Coin.Amount)).
+		TruncateDecimal()
+	lock.RewardsOwed = lock.RewardsOwed.Add(earned...)
+	lock.RewardPerShareLast = lockRewards.RewardPerShare
+}
+
+// validateLockDenom returns nil if the denom is the liquidity pool coin of a pool
+func (k Keeper) validateLockDenom(ctx sdk.Context, lptDenom string) error {
+	pool, has := k.GetPoolByLptDenom(ctx, lptDenom)
+	if !has {
+		return errorsmod.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", lptDenom)
+	}
+	if pool.PoolType == types.POOL_TYPE_CONCENTRATED {
+		return errorsmod.Wrapf(types.ErrInvalidPoolType, "concentrated liquidity pool %s has no liquidity pool coin", lptDenom)
+	}
+	return nil
+}
+
+// getOwnedLock returns the lock of the given id if it is owned by sender
+func (k Keeper) getOwnedLock(ctx sdk.Context, lockId uint64, sender string) (types.Lock, error) {
+	lock, found := k.GetLock(ctx, lockId)
+	if !found {
+		return types.Lock{}, errorsmod.Wrapf(types.ErrLockNotFound, "lock id: %d", lockId)
+	}
+	if lock.Owner != sender {
+		return types.Lock{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "lock %d is not owned by %s", lock.Id, sender)
+	}
+	return lock, nil
+}
+
+// GetGauge returns the gauge of the given id
+func (k Keeper) GetGauge(ctx sdk.Context, gaugeId uint64) (types.Gauge, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get(types.GetGaugeKey(gaugeId))
+	if bz == nil {
+		return types.Gauge{}, false
+	}
+
+	var gauge types.Gauge
+	k.cdc.MustUnmarshal(bz, &gauge)
+	return gauge, true
+}
+
+// SetGauge stores the gauge and indexes it by pool
+func (k Keeper) SetGauge(ctx sdk.Context, gauge types.Gauge) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&gauge)
+	store.Set(types.GetGaugeKey(gauge.Id), bz)
+	store.Set(types.GetGaugeByPoolKey(gauge.LptDenom, gauge.Id), sdk.Uint64ToBigEndian(gauge.Id))
+}
+
+// deleteGauge deletes the gauge and its index
+func (k Keeper) deleteGauge(ctx sdk.Context, gauge types.Gauge) {
+	store := k.storeService.OpenKVStore(ctx)
+	for _, key := range [][]byte{
+		types.GetGaugeKey(gauge.Id),
+		types.GetGaugeByPoolKey(gauge.LptDenom, gauge.Id),
+	} {
+		if err := store.Delete(key); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// GetAllGauges returns all the gauges
+func (k Keeper) GetAllGauges(ctx sdk.Context) (gauges []types.Gauge) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyGauge+"/"))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var gauge types.Gauge
+		k.cdc.MustUnmarshal(iterator.Value(), &gauge)
+		gauges = append(gauges, gauge)
+	}
+	return
+}
+
+// GetLock returns the lock of the given id
+func (k Keeper) GetLock(ctx sdk.Context, lockId uint64) (types.Lock, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get(types.GetLockKey(lockId))
+	if bz == nil {
+		return types.Lock{}, false
+	}
+
+	var lock types.Lock
+	k.cdc.MustUnmarshal(bz, &lock)
+	return lock, true
+}
+
+// SetLock stores the lock and indexes it by owner and pool
+func (k Keeper) SetLock(ctx sdk.Context, lock types.Lock) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&lock)
+	store.Set(types.GetLockKey(lock.Id), bz)
+
+	id := sdk.Uint64ToBigEndian(lock.Id)
+	store.Set(types.GetLockByOwnerKey(lock.Owner, lock.Id), id)
+	store.Set(types.GetLockByPoolKey(lock.Coin.Denom, lock.Id), id)
+}
+
+// deleteLock deletes the lock and its indexes
+func (k Keeper) deleteLock(ctx sdk.Context, lock types.Lock) {
+	store := k.storeService.OpenKVStore(ctx)
+	for _, key := range [][]byte{
+		types.GetLockKey(lock.Id),
+		types.GetLockByOwnerKey(lock.Owner, lock.Id),
+		types.GetLockByPoolKey(lock.Coin.Denom, lock.Id),
+	} {
+		if err := store.Delete(key); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// GetAllLocks returns all the locks
+func (k Keeper) GetAllLocks(ctx sdk.Context) (locks []types.Lock) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyLock+"/"))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var lock types.Lock
+		k.cdc.MustUnmarshal(iterator.Value(), &lock)
+		locks = append(locks, lock)
+	}
+	return
+}
+
+// getLocksByOwner returns the locks of the owner
+func (k Keeper) getLocksByOwner(ctx sdk.Context, owner string) (locks []types.Lock) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetLockByOwnerPrefix(owner))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if lock, found := k.GetLock(ctx, sdk.BigEndianToUint64(iterator.Value())); found {
+			locks = append(locks, lock)
+		}
+	}
+	return
+}
+
+// GetLockRewards returns the rewards distributed to the locks of the denom, with
+// nothing locked nor distributed if the denom was never locked
+func (k Keeper) GetLockRewards(ctx sdk.Context, lptDenom string) types.LockRewards {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get(types.GetLockRewardsKey(lptDenom))
+	if bz == nil {
+		return types.LockRewards{LptDenom: lptDenom, TotalLocked: sdkmath.ZeroInt()}
+	}
+
+	var lockRewards types.LockRewards
+	k.cdc.MustUnmarshal(bz, &lockRewards)
+	return lockRewards
+}
+
+// SetLockRewards stores the rewards distributed to the locks of a denom
+func (k Keeper) SetLockRewards(ctx sdk.Context, lockRewards types.LockRewards) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&lockRewards)
+	store.Set(types.GetLockRewardsKey(lockRewards.LptDenom), bz)
+}
+
+// GetAllLockRewards returns the rewards distributed to the locks of every denom
+func (k Keeper) GetAllLockRewards(ctx sdk.Context) (lockRewards []types.LockRewards) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyLockRewards+"/"))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var rewards types.LockRewards
+		k.cdc.MustUnmarshal(iterator.Value(), &rewards)
+		lockRewards = append(lockRewards, rewards)
+	}
+	return
+}
+
+// getGaugeSequence gets the id of the next gauge from the store.
+func (k Keeper) getGaugeSequence(ctx sdk.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get([]byte(types.KeyNextGaugeSequence))
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setGaugeSequence sets the id of the next gauge to the store.
+func (k Keeper) setGaugeSequence(ctx sdk.Context, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := sdk.Uint64ToBigEndian(sequence)
+	store.Set([]byte(types.KeyNextGaugeSequence), bz)
+}
+
+// getLockSequence gets the id of the next lock from the store.
+func (k Keeper) getLockSequence(ctx sdk.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get([]byte(types.KeyNextLockSequence))
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setLockSequence sets the id of the next lock to the store.
+func (k Keeper) setLockSequence(ctx sdk.Context, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := sdk.Uint64ToBigEndian(sequence)
+	store.Set([]byte(types.KeyNextLockSequence), bz)
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
+	epochstypes "github.com/Canto-Network/Canto/v8/x/epochs/types"
+)
+
+func (suite *TestSuite) TestLiquidityMining() {
+	sender, _ := createReservePool(suite, denomBTC)
+	lptDenom := types.GetLptDenom(1)
+	params := suite.app.CoinswapKeeper.GetParams(suite.ctx)
+	params.IncentivesEpochIdentifier = epochstypes.DayEpochID
+	params.GaugeCreationFee = buildCoin(denomStandard, 100)
+	suite.app.CoinswapKeeper.SetParams(suite.ctx, params)
+
+	lptCoins := sdk.NewCoins(sdk.NewInt64Coin(lptDenom, 1000))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoins(suite.ctx, sender, addrSender1, lptCoins))
+
+	testCases := []struct {
+		name string
+		msg  *types.MsgCreateGauge
+		err  error
+	}{
+		{"fail - unknown pool", types.NewMsgCreateGauge(sender.String(), types.GetLptDenom(9), sdk.NewCoins(buildCoin(denomStandard, 1000)), 2), types.ErrReservePoolNotExists},
+		{"fail - no epochs", types.NewMsgCreateGauge(sender.String(), lptDenom, sdk.NewCoins(buildCoin(denomStandard, 1000)), 0), sdkerrors.ErrInvalidRequest},
+		{"fail - no rewards", types.NewMsgCreateGauge(sender.String(), lptDenom, sdk.NewCoins(), 2), sdkerrors.ErrInvalidCoins},
+	}
+	for _, tc := range testCases {
+		_, err := suite.msgServer.CreateGauge(suite.ctx, tc.msg)
+		suite.Require().ErrorIs(err, tc.err, tc.name)
+	}
+
+	// the gauge creation fee must be paid on top of the rewards
+	funder := sdk.AccAddress(getRandomString(20))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoins(suite.ctx, sender, funder, sdk.NewCoins(buildCoin(denomStandard, 1000))))
+	_, err := suite.msgServer.CreateGauge(suite.ctx, types.NewMsgCreateGauge(funder.String(), lptDenom, sdk.NewCoins(buildCoin(denomStandard, 1000)), 2))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// the gauge creation fee is deducted along with the rewards
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, denomStandard)
+	gaugeRes, err := suite.msgServer.CreateGauge(suite.ctx, types.NewMsgCreateGauge(sender.String(), lptDenom, sdk.NewCoins(buildCoin(denomStandard, 1000)), 3))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), gaugeRes.GaugeId)
+	suite.Require().Equal(balance.SubAmount(sdkmath.NewInt(1100)), suite.app.BankKeeper.GetBalance(suite.ctx, sender, denomStandard))
+
+	// an epoch during which nothing is locked is counted, its share being
+	// distributed over the next epochs
+	suite.app.CoinswapKeeper.Hooks().AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 1)
+	gauge, found := suite.app.CoinswapKeeper.GetGauge(suite.ctx, gaugeRes.GaugeId)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), gauge.FilledEpochs)
+	suite.Require().True(gauge.DistributedCoins.IsZero())
+
+	_, err = suite.msgServer.LockLiquidity(suite.ctx, types.NewMsgLockLiquidity(sender.String(), sdk.NewInt64Coin(lptDenom, 3000), 0))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	lockRes, err := suite.msgServer.LockLiquidity(suite.ctx, types.NewMsgLockLiquidity(sender.String(), sdk.NewInt64Coin(lptDenom, 3000), time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), lockRes.LockId)
+	lockRes, err = suite.msgServer.LockLiquidity(suite.ctx, types.NewMsgLockLiquidity(addrSender1.String(), lptCoins[0], time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), lockRes.LockId)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, addrSender1, lptDenom).IsZero())
+
+	// the rewards of the epoch are shared in proportion to the locked amounts,
+	// and only at the end of the incentives epoch
+	suite.app.CoinswapKeeper.Hooks().AfterEpochEnd(suite.ctx, epochstypes.WeekEpochID, 1)
+	pendingRes, err := suite.app.CoinswapKeeper.PendingRewards(suite.ctx, &types.QueryPendingRewardsRequest{Owner: sender.String()})
+	suite.Require().NoError(err)
+	suite.Require().True(pendingRes.Rewards.IsZero())
+
+	suite.app.CoinswapKeeper.Hooks().AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 2)
+	pendingRes, err = suite.app.CoinswapKeeper.PendingRewards(suite.ctx, &types.QueryPendingRewardsRequest{Owner: sender.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(buildCoin(denomStandard, 375)), pendingRes.Rewards)
+	lockQueryRes, err := suite.app.CoinswapKeeper.Lock(suite.ctx, &types.QueryLockRequest{LockId: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(buildCoin(denomStandard, 125)), lockQueryRes.PendingRewards)
+
+	// only the owner can claim the rewards of a lock
+	_, err = suite.msgServer.ClaimLockRewards(suite.ctx, types.NewMsgClaimLockRewards(addrSender1.String(), 1))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	balance = suite.app.BankKeeper.GetBalance(suite.ctx, sender, denomStandard)
+	claimRes, err := suite.msgServer.ClaimLockRewards(suite.ctx, types.NewMsgClaimLockRewards(sender.String(), 1))
+	suite.Require().NoError(err)
+	suite.Require().Equal(pendingRes.Rewards, claimRes.Rewards)
+	suite.Require().Equal(balance.AddAmount(sdkmath.NewInt(375)), suite.app.BankKeeper.GetBalance(suite.ctx, sender, denomStandard))
+
+	// the last epoch distributes the remaining rewards, and the filled gauge is
+	// deleted with nothing to refund
+	balance = suite.app.BankKeeper.GetBalance(suite.ctx, sender, denomStandard)
+	suite.app.CoinswapKeeper.Hooks().AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 3)
+	_, found = suite.app.CoinswapKeeper.GetGauge(suite.ctx, gaugeRes.GaugeId)
+	suite.Require().False(found)
+	suite.Require().Equal(balance, suite.app.BankKeeper.GetBalance(suite.ctx, sender, denomStandard))
+
+	_, err = suite.msgServer.UnlockLiquidity(suite.ctx, types.NewMsgUnlockLiquidity(addrSender1.String(), 2))
+	suite.Require().ErrorIs(err, types.ErrLockNotMatured)
+
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	unlockRes, err := suite.msgServer.UnlockLiquidity(ctx, types.NewMsgUnlockLiquidity(addrSender1.String(), 2))
+	suite.Require().NoError(err)
+	suite.Require().Equal(lptCoins[0], unlockRes.Coin)
+	suite.Require().Equal(sdk.NewCoins(buildCoin(denomStandard, 250)), unlockRes.Rewards)
+	suite.Require().Equal(lptCoins[0], suite.app.BankKeeper.GetBalance(ctx, addrSender1, lptDenom))
+
+	_, err = suite.app.CoinswapKeeper.Lock(ctx, &types.QueryLockRequest{LockId: 2})
+	suite.Require().ErrorIs(err, types.ErrLockNotFound)
+	locksRes, err := suite.app.CoinswapKeeper.Locks(ctx, &types.QueryLocksRequest{LptDenom: lptDenom})
+	suite.Require().NoError(err)
+	suite.Require().Len(locksRes.Locks, 1)
+	suite.Require().Equal(sdkmath.NewInt(3000), suite.app.CoinswapKeeper.GetLockRewards(ctx, lptDenom).TotalLocked)
+	gaugesRes, err := suite.app.CoinswapKeeper.Gauges(ctx, &types.QueryGaugesRequest{LptDenom: lptDenom})
+	suite.Require().NoError(err)
+	suite.Require().Empty(gaugesRes.Gauges)
+
+	// the rewards of a gauge whose denom is not locked during its epochs are
+	// refunded to its owner once its epochs are filled
+	_, err = suite.msgServer.UnlockLiquidity(ctx, types.NewMsgUnlockLiquidity(sender.String(), 1))
+	suite.Require().NoError(err)
+	gaugeRes, err = suite.msgServer.CreateGauge(ctx, types.NewMsgCreateGauge(sender.String(), lptDenom, sdk.NewCoins(buildCoin(denomStandard, 1000)), 2))
+	suite.Require().NoError(err)
+	balance = suite.app.BankKeeper.GetBalance(ctx, sender, denomStandard)
+	suite.app.CoinswapKeeper.Hooks().AfterEpochEnd(ctx, epochstypes.DayEpochID, 4)
+	suite.Require().Equal(balance, suite.app.BankKeeper.GetBalance(ctx, sender, denomStandard))
+	suite.app.CoinswapKeeper.Hooks().AfterEpochEnd(ctx, epochstypes.DayEpochID, 5)
+	suite.Require().Equal(balance.AddAmount(sdkmath.NewInt(1000)), suite.app.BankKeeper.GetBalance(ctx, sender, denomStandard))
+	_, found = suite.app.CoinswapKeeper.GetGauge(ctx, gaugeRes.GaugeId)
+	suite.Require().False(found)
+}
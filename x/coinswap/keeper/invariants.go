@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
+)
+
+// RegisterInvariants registers the coinswap module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "pool-reserves", PoolReservesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "constant-product", ConstantProductInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "empty-pool-reserves", EmptyPoolReservesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the coinswap module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := PoolReservesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = ConstantProductInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return EmptyPoolReservesInvariant(k)(ctx)
+	}
+}
+
+// PoolReservesInvariant checks that the escrow of every pool with liquidity pool
+// coins outstanding holds a positive reserve of both its coins
+func PoolReservesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		for _, pool := range k.GetAllPools(ctx) {
+			standardReserve, tokenReserve, supply := k.getPoolReserves(ctx, pool)
+			if supply.IsPositive() && (!standardReserve.IsPositive() || !tokenReserve.IsPositive()) {
+				count++
+				msg += fmt.Sprintf("\tpool %s has a supply of %s with reserves %s%s and %s%s\n",
+					pool.LptDenom, supply, standardReserve, pool.StandardDenom, tokenReserve, pool.CounterpartyDenom)
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "pool-reserves",
+			fmt.Sprintf("amount of pools with an empty reserve found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// ConstantProductInvariant checks that the constant product per squared liquidity
+// pool coin of every constant product pool never decreased. Swaps only increase
+// the product of the reserves of a pool, by the fees left in the pool, and
+// deposits and withdrawals round in favor of the pool
+func ConstantProductInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		for _, pool := range k.GetAllPools(ctx) {
+			recorded, found := k.GetKPerShare(ctx, pool.LptDenom)
+			if !found {
+				continue
+			}
+			current, ok := k.getPoolKPerShare(ctx, pool)
+			if !ok || current.LT(recorded) {
+				count++
+				msg += fmt.Sprintf("\tpool %s constant product per share decreased from %s to %s\n",
+					pool.LptDenom, recorded, current)
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "constant-product",
+			fmt.Sprintf("amount of pools with a decreased constant product found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// EmptyPoolReservesInvariant checks that the escrow of every pool whose whole
+// liquidity pool coin supply has been burned holds at most dust
+func EmptyPoolReservesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		maxDust := sdkmath.NewInt(types.MaxDustReserve)
+		for _, pool := range k.GetAllPools(ctx) {
+			if pool.PoolType == types.POOL_TYPE_CONCENTRATED {
+				// the reserves of a concentrated liquidity pool belong to its positions
+				continue
+			}
+			standardReserve, tokenReserve, supply := k.getPoolReserves(ctx, pool)
+			if supply.IsZero() && (standardReserve.GT(maxDust) || tokenReserve.GT(maxDust)) {
+				count++
+				msg += fmt.Sprintf("\tpool %s has no supply with reserves %s%s and %s%s\n",
+					pool.LptDenom, standardReserve, pool.StandardDenom, tokenReserve, pool.CounterpartyDenom)
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "empty-pool-reserves",
+			fmt.Sprintf("amount of empty pools with reserves found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// recordKPerShare raises the constant product per share recorded for a constant
+// product pool to its current value, and clears it once the pool is emptied.
+// It is called after every change to the reserves or the supply of a pool
+func (k Keeper) recordKPerShare(ctx sdk.Context, lptDenom string) {
+	pool, has := k.GetPoolByLptDenom(ctx, lptDenom)
+	if !has || pool.PoolType != types.POOL_TYPE_CONSTANT_PRODUCT {
+		return
+	}
+
+	current, ok := k.getPoolKPerShare(ctx, pool)
+	if !ok {
+		k.deleteKPerShare(ctx, lptDenom)
+		return
+	}
+	if recorded, found := k.GetKPerShare(ctx, lptDenom); found && recorded.GTE(current) {
+		return
+	}
+	k.setKPerShare(ctx, lptDenom, current)
+}
+
+// getPoolKPerShare returns the product of the reserves of the pool divided by
+// the square of its liquidity pool coin supply, and false if the supply is zero
+func (k Keeper) getPoolKPerShare(ctx sdk.Context, pool types.Pool) (sdkmath.LegacyDec, bool) {
+	standardReserve, tokenReserve, supply := k.getPoolReserves(ctx, pool)
+	if !supply.IsPositive() {
+		return sdkmath.LegacyDec{}, false
+	}
+	return sdkmath.LegacyNewDecFromInt(standardReserve.Mul(tokenReserve)).
+		QuoInt(supply).
+		QuoInt(supply), true
+}
+
+// getPoolReserves returns the balances of both coins of the pool held by its
+// escrow, which may not have an account yet, and its liquidity pool coin supply
+func (k Keeper) getPoolReserves(ctx sdk.Context, pool types.Pool) (standardReserve, tokenReserve, supply sdkmath.Int) {
+	escrow := types.GetReservePoolAddr(pool.LptDenom)
+	standardReserve = k.bk.GetBalance(ctx, escrow, pool.StandardDenom).Amount
+	tokenReserve = k.bk.GetBalance(ctx, escrow, pool.CounterpartyDenom).Amount
+	supply = k.bk.GetSupply(ctx, pool.LptDenom).Amount
+	return
+}
+
+// GetKPerShare returns the highest constant product per share recorded for the pool
+func (k Keeper) GetKPerShare(ctx sdk.Context, lptDenom string) (sdkmath.LegacyDec, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get(types.GetKPerShareKey(lptDenom))
+	if bz == nil {
+		return sdkmath.LegacyDec{}, false
+	}
+
+	var kPerShare sdkmath.LegacyDec
+	if err := kPerShare.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return kPerShare, true
+}
+
+// setKPerShare records the constant product per share of the pool
+func (k Keeper) setKPerShare(ctx sdk.Context, lptDenom string, kPerShare sdkmath.LegacyDec) {
+	bz, err := kPerShare.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	store.Set(types.GetKPerShareKey(lptDenom), bz)
+}
+
+// deleteKPerShare clears the constant product per share recorded for the pool
+func (k Keeper) deleteKPerShare(ctx sdk.Context, lptDenom string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetKPerShareKey(lptDenom)); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Canto-Network/Canto/v8/x/coinswap/keeper"
+	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
+)
+
+func (suite *TestSuite) TestInvariants() {
+	sender, poolAddr := createReservePool(suite, denomBTC)
+	k := suite.app.CoinswapKeeper
+	lptDenom := types.GetLptDenom(1)
+
+	params := k.GetParams(suite.ctx)
+	params.Fee = sdkmath.LegacyNewDecWithPrec(3, 3)
+	params.SwapProtocolFeeShare = sdkmath.LegacyNewDecWithPrec(5, 1)
+	k.SetParams(suite.ctx, params)
+
+	initial, found := k.GetKPerShare(suite.ctx, lptDenom)
+	suite.Require().True(found)
+
+	// swaps both ways and partial withdrawals keep the invariants
+	deadline := time.Now().Add(time.Minute).Unix()
+	for _, msg := range []*types.MsgSwapOrder{
+		types.NewMsgSwapOrder(
+			types.Input{Address: sender.String(), Coin: buildCoin(denomStandard, 1_000_000)},
+			types.Output{Address: sender.String(), Coin: buildCoin(denomBTC, 1)},
+			deadline, false,
+		),
+		types.NewMsgSwapOrder(
+			types.Input{Address: sender.String(), Coin: buildCoin(denomBTC, 2_000_000)},
+			types.Output{Address: sender.String(), Coin: buildCoin(denomStandard, 1_000_000)},
+			deadline, true,
+		),
+	} {
+		_, err := suite.msgServer.SwapCoin(suite.ctx, msg)
+		suite.Require().NoError(err)
+	}
+	_, err := suite.msgServer.RemoveLiquidity(suite.ctx, types.NewMsgRemoveLiquidity(sdkmath.OneInt(), buildCoin(lptDenom, 3_333_333), sdkmath.OneInt(), deadline, sender.String()))
+	suite.Require().NoError(err)
+
+	msg, broken := keeper.AllInvariants(k)(suite.ctx)
+	suite.Require().False(broken, msg)
+	recorded, _ := k.GetKPerShare(suite.ctx, lptDenom)
+	suite.Require().True(recorded.GT(initial))
+
+	// coins leaving the escrow outside of the pool operations decrease the constant product
+	suite.Require().NoError(suite.app.BankKeeper.SendCoins(suite.ctx, poolAddr, sender, sdk.NewCoins(buildCoin(denomBTC, 1))))
+	_, broken = keeper.ConstantProductInvariant(k)(suite.ctx)
+	suite.Require().True(broken)
+	_, broken = keeper.PoolReservesInvariant(k)(suite.ctx)
+	suite.Require().False(broken)
+
+	suite.Require().NoError(suite.app.BankKeeper.SendCoins(suite.ctx, poolAddr, sender, sdk.NewCoins(suite.app.BankKeeper.GetBalance(suite.ctx, poolAddr, denomBTC))))
+	_, broken = keeper.PoolReservesInvariant(k)(suite.ctx)
+	suite.Require().True(broken)
+}
+
+func (suite *TestSuite) TestEmptyPoolReservesInvariant() {
+	sender, poolAddr := createReservePool(suite, denomBTC)
+	k := suite.app.CoinswapKeeper
+	lptDenom := types.GetLptDenom(1)
+
+	// withdrawing the whole supply empties the pool and clears its constant product
+	deadline := time.Now().Add(time.Minute).Unix()
+	lpt := suite.app.BankKeeper.GetBalance(suite.ctx, sender, lptDenom)
+	_, err := suite.msgServer.RemoveLiquidity(suite.ctx, types.NewMsgRemoveLiquidity(sdkmath.OneInt(), lpt, sdkmath.OneInt(), deadline, sender.String()))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, poolAddr).IsZero())
+	_, found := k.GetKPerShare(suite.ctx, lptDenom)
+	suite.Require().False(found)
+
+	msg, broken := keeper.AllInvariants(k)(suite.ctx)
+	suite.Require().False(broken, msg)
+
+	// reserves left in an empty pool beyond dust break the invariant
+	suite.Require().NoError(suite.app.BankKeeper.SendCoins(suite.ctx, sender, poolAddr, sdk.NewCoins(buildCoin(denomBTC, types.MaxDustReserve))))
+	_, broken = keeper.EmptyPoolReservesInvariant(k)(suite.ctx)
+	suite.Require().False(broken)
+	suite.Require().NoError(suite.app.BankKeeper.SendCoins(suite.ctx, sender, poolAddr, sdk.NewCoins(buildCoin(denomBTC, 1))))
+	_, broken = keeper.EmptyPoolReservesInvariant(k)(suite.ctx)
+	suite.Require().True(broken)
+}
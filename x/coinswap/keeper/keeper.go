@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	gogoprototypes "github.com/cosmos/gogoproto/types"
 
@@ -89,8 +90,8 @@ func (k Keeper) Swap(ctx sdk.Context, msg *types.MsgSwapOrder) error {
 	}
 
 	isDoubleSwap := (msg.Input.Coin.Denom != standardDenom) && (msg.Output.Coin.Denom != standardDenom)
-	if isDoubleSwap {
-		return errorsmod.Wrapf(types.ErrNotContainStandardDenom, "unsupported swap: standard coin must be in either Input or Output")
+	if isDoubleSwap && !k.hasPairPool(ctx, msg.Input.Coin.Denom, msg.Output.Coin.Denom) {
+		return errorsmod.Wrapf(types.ErrNotContainStandardDenom, "unsupported swap: standard coin must be in either Input or Output unless a pool of both exists, use MsgSwapExactAmountInRoute or MsgSwapExactAmountOutRoute for routed swaps")
 	}
 	if msg.IsBuyOrder {
 		amount, err = k.TradeInputForExactOutput(ctx, msg.Input, msg.Output)
@@ -115,7 +116,101 @@ func (k Keeper) Swap(ctx sdk.Context, msg *types.MsgSwapOrder) error {
 	return nil
 }
 
-// AddLiquidity adds liquidity to the specified pool
+// SwapExactAmountInRoute sells an exact amount of token_in for token_out, routing
+// through the standard denom pools if needed
+func (k Keeper) SwapExactAmountInRoute(ctx sdk.Context, msg *types.MsgSwapExactAmountInRoute) (sdk.Coin, error) {
+	recipient := msg.Recipient
+	if recipient == "" {
+		recipient = msg.Sender
+	}
+
+	amount, err := k.TradeExactInputForOutputRoute(ctx,
+		types.Input{Address: msg.Sender, Coin: msg.TokenIn},
+		types.Output{Address: recipient, Coin: msg.TokenOutMin},
+	)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	tokenOut := sdk.NewCoin(msg.TokenOutMin.Denom, amount)
+
+	route, err := k.GetSwapRoute(ctx, msg.TokenIn.Denom, msg.TokenOutMin.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapRoute,
+			sdk.NewAttribute(types.AttributeValueSender, msg.Sender),
+			sdk.NewAttribute(types.AttributeValueRecipient, recipient),
+			sdk.NewAttribute(types.AttributeValueTokenIn, msg.TokenIn.String()),
+			sdk.NewAttribute(types.AttributeValueTokenOut, tokenOut.String()),
+			sdk.NewAttribute(types.AttributeValueRoute, strings.Join(route, ",")),
+		),
+	)
+
+	return tokenOut, nil
+}
+
+// SwapExactAmountOutRoute buys an exact amount of token_out with token_in, routing
+// through the standard denom pools if needed
+func (k Keeper) SwapExactAmountOutRoute(ctx sdk.Context, msg *types.MsgSwapExactAmountOutRoute) (sdk.Coin, error) {
+	recipient := msg.Recipient
+	if recipient == "" {
+		recipient = msg.Sender
+	}
+
+	amount, err := k.TradeInputForExactOutputRoute(ctx,
+		types.Input{Address: msg.Sender, Coin: msg.TokenInMax},
+		types.Output{Address: recipient, Coin: msg.TokenOut},
+	)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	tokenIn := sdk.NewCoin(msg.TokenInMax.Denom, amount)
+
+	route, err := k.GetSwapRoute(ctx, msg.TokenInMax.Denom, msg.TokenOut.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapRoute,
+			sdk.NewAttribute(types.AttributeValueSender, msg.Sender),
+			sdk.NewAttribute(types.AttributeValueRecipient, recipient),
+			sdk.NewAttribute(types.AttributeValueTokenIn, tokenIn.String()),
+			sdk.NewAttribute(types.AttributeValueTokenOut, msg.TokenOut.String()),
+			sdk.NewAttribute(types.AttributeValueRoute, strings.Join(route, ",")),
+		),
+	)
+
+	return tokenIn, nil
+}
+
+// GetSwapRoute returns the denoms a swap from inputDenom to outputDenom goes through,
+// both ends included. The swap is direct if one of the denoms is the standard denom
+// or a pool of both exists
+func (k Keeper) GetSwapRoute(ctx sdk.Context, inputDenom, outputDenom string) ([]string, error) {
+	standardDenom, err := k.GetStandardDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.isDirectSwap(ctx, standardDenom, inputDenom, outputDenom) {
+		return []string{inputDenom, outputDenom}, nil
+	}
+	return []string{inputDenom, standardDenom, outputDenom}, nil
+}
+
+// isDirectSwap returns true if a swap between the two denoms is executed by a
+// single pool
+func (k Keeper) isDirectSwap(ctx sdk.Context, standardDenom, inputDenom, outputDenom string) bool {
+	return inputDenom == standardDenom || outputDenom == standardDenom || k.hasPairPool(ctx, inputDenom, outputDenom)
+}
+
+// AddLiquidity adds liquidity to the specified pool. The exact amount of the msg is
+// deposited in the pair denom, which defaults to the standard denom
 func (k Keeper) AddLiquidity(ctx sdk.Context, msg *types.MsgAddLiquidity) (sdk.Coin, error) {
 	standardDenom, err := k.GetStandardDenom(ctx)
 	if err != nil {
@@ -126,18 +221,37 @@ func (k Keeper) AddLiquidity(ctx sdk.Context, msg *types.MsgAddLiquidity) (sdk.C
 			"MaxToken: %s should not be StandardDenom", msg.MaxToken.String())
 	}
 
+	pairDenom := msg.PairDenom
+	if pairDenom == "" {
+		pairDenom = standardDenom
+	}
+	// the standard coin per pool cap only applies to the pools of the standard denom
+	isStandardPool := pairDenom == standardDenom
+
+	// every denom but the standard denom must be whitelisted in max swap amount
 	params := k.GetParams(ctx)
 	if !params.MaxSwapAmount.AmountOf(msg.MaxToken.Denom).IsPositive() {
 		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidDenom,
 			"MaxToken %s is not registered in max swap amount", msg.MaxToken.Denom)
 	}
+	if !isStandardPool && !params.MaxSwapAmount.AmountOf(pairDenom).IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidDenom,
+			"PairDenom %s is not registered in max swap amount", pairDenom)
+	}
 
 	var mintLiquidityAmt sdkmath.Int
 	var depositToken sdk.Coin
-	var standardCoin = sdk.NewCoin(standardDenom, msg.ExactStandardAmt)
+	var standardCoin = sdk.NewCoin(pairDenom, msg.ExactStandardAmt)
 
-	poolId := types.GetPoolId(msg.MaxToken.Denom)
+	poolId := types.GetPoolId(pairDenom, msg.MaxToken.Denom)
 	pool, exists := k.GetPool(ctx, poolId)
+	if exists && pool.PoolType == types.POOL_TYPE_CONCENTRATED {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidPoolType, "liquidity is provided to the concentrated liquidity pool %s with positions", pool.LptDenom)
+	}
+	if exists && pool.StandardDenom != pairDenom {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidDenom,
+			"the exact amount of the pool %s is deposited in %s, not %s", pool.LptDenom, pool.StandardDenom, pairDenom)
+	}
 
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
@@ -152,37 +266,39 @@ func (k Keeper) AddLiquidity(ctx sdk.Context, msg *types.MsgAddLiquidity) (sdk.C
 			return sdk.Coin{}, err
 		}
 
-		mintLiquidityAmt = msg.ExactStandardAmt
-
-		if mintLiquidityAmt.GT(params.MaxStandardCoinPerPool) {
-			return sdk.Coin{}, errorsmod.Wrap(types.ErrMaxedStandardDenom, fmt.Sprintf("liquidity amount not met, max standard coin amount: no bigger than %s, actual: %s", params.MaxStandardCoinPerPool.String(), mintLiquidityAmt.String()))
+		if isStandardPool && msg.ExactStandardAmt.GT(params.MaxStandardCoinPerPool) {
+			return sdk.Coin{}, errorsmod.Wrap(types.ErrMaxedStandardDenom, fmt.Sprintf("liquidity amount not met, max standard coin amount: no bigger than %s, actual: %s", params.MaxStandardCoinPerPool.String(), msg.ExactStandardAmt.String()))
 		}
 
+		mintLiquidityAmt = GetInitialLiquidity(msg.PoolType, msg.Amplification, msg.ExactStandardAmt, msg.MaxToken.Amount)
 		if mintLiquidityAmt.LT(msg.MinLiquidity) {
 			return sdk.Coin{}, errorsmod.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("liquidity amount not met, user expected: no less than %s, actual: %s", msg.MinLiquidity.String(), mintLiquidityAmt.String()))
 		}
 
 		depositToken = sdk.NewCoin(msg.MaxToken.Denom, msg.MaxToken.Amount)
-		pool = k.CreatePool(ctx, msg.MaxToken.Denom)
+		pool = k.CreatePool(ctx, pairDenom, msg.MaxToken.Denom, msg.PoolType, msg.Amplification)
 	} else {
+		if !pool.Status.DepositsEnabled() {
+			return sdk.Coin{}, errorsmod.Wrapf(types.ErrPoolPaused, "deposits are paused on pool %s, status: %s", pool.LptDenom, pool.Status)
+		}
+
 		balances, err := k.GetPoolBalances(ctx, pool.EscrowAddress)
 		if err != nil {
 			return sdk.Coin{}, err
 		}
 
-		standardReserveAmt := balances.AmountOf(standardDenom)
+		standardReserveAmt := balances.AmountOf(pairDenom)
 		tokenReserveAmt := balances.AmountOf(msg.MaxToken.Denom)
 		liquidity := k.bk.GetSupply(ctx, pool.LptDenom).Amount
 
 		if liquidity.Equal(sdkmath.ZeroInt()) {
 			// pool exists, but it is empty
 			// same with initial liquidity provide
-			mintLiquidityAmt = msg.ExactStandardAmt
-
-			if mintLiquidityAmt.GT(params.MaxStandardCoinPerPool) {
-				return sdk.Coin{}, errorsmod.Wrap(types.ErrMaxedStandardDenom, fmt.Sprintf("liquidity amount not met, max standard coin amount: no bigger than %s, actual: %s", params.MaxStandardCoinPerPool.String(), mintLiquidityAmt.String()))
+			if isStandardPool && msg.ExactStandardAmt.GT(params.MaxStandardCoinPerPool) {
+				return sdk.Coin{}, errorsmod.Wrap(types.ErrMaxedStandardDenom, fmt.Sprintf("liquidity amount not met, max standard coin amount: no bigger than %s, actual: %s", params.MaxStandardCoinPerPool.String(), msg.ExactStandardAmt.String()))
 			}
 
+			mintLiquidityAmt = GetInitialLiquidity(pool.PoolType, pool.Amplification, msg.ExactStandardAmt, msg.MaxToken.Amount)
 			if mintLiquidityAmt.LT(msg.MinLiquidity) {
 				return sdk.Coin{}, errorsmod.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("liquidity amount not met, user expected: no less than %s, actual: %s", msg.MinLiquidity.String(), mintLiquidityAmt.String()))
 			}
@@ -190,11 +306,13 @@ func (k Keeper) AddLiquidity(ctx sdk.Context, msg *types.MsgAddLiquidity) (sdk.C
 			depositToken = sdk.NewCoin(msg.MaxToken.Denom, msg.MaxToken.Amount)
 
 		} else {
-			if standardReserveAmt.GTE(params.MaxStandardCoinPerPool) {
-				return sdk.Coin{}, errorsmod.Wrap(types.ErrMaxedStandardDenom, fmt.Sprintf("pool standard coin is maxed out: %s", params.MaxStandardCoinPerPool.String()))
+			maxStandardInputAmt := msg.ExactStandardAmt
+			if isStandardPool {
+				if standardReserveAmt.GTE(params.MaxStandardCoinPerPool) {
+					return sdk.Coin{}, errorsmod.Wrap(types.ErrMaxedStandardDenom, fmt.Sprintf("pool standard coin is maxed out: %s", params.MaxStandardCoinPerPool.String()))
+				}
+				maxStandardInputAmt = sdkmath.MinInt(msg.ExactStandardAmt, params.MaxStandardCoinPerPool.Sub(standardReserveAmt))
 			}
-
-			maxStandardInputAmt := sdkmath.MinInt(msg.ExactStandardAmt, params.MaxStandardCoinPerPool.Sub(standardReserveAmt))
 			mintLiquidityAmt = (liquidity.Mul(maxStandardInputAmt)).Quo(standardReserveAmt)
 			if mintLiquidityAmt.LT(msg.MinLiquidity) {
 				return sdk.Coin{}, errorsmod.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("liquidity amount not met, user expected: no less than %s, actual: %s", msg.MinLiquidity.String(), mintLiquidityAmt.String()))
@@ -202,7 +320,7 @@ func (k Keeper) AddLiquidity(ctx sdk.Context, msg *types.MsgAddLiquidity) (sdk.C
 
 			depositAmt := (tokenReserveAmt.Mul(maxStandardInputAmt)).Quo(standardReserveAmt).AddRaw(1)
 			depositToken = sdk.NewCoin(msg.MaxToken.Denom, depositAmt)
-			standardCoin = sdk.NewCoin(standardDenom, maxStandardInputAmt)
+			standardCoin = sdk.NewCoin(pairDenom, maxStandardInputAmt)
 			if depositAmt.GT(msg.MaxToken.Amount) {
 				return sdk.Coin{}, errorsmod.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("token amount not met, user expected: no more than %s, actual: %s", msg.MaxToken.String(), depositToken.String()))
 			}
@@ -218,7 +336,7 @@ func (k Keeper) AddLiquidity(ctx sdk.Context, msg *types.MsgAddLiquidity) (sdk.C
 		sdk.NewEvent(
 			types.EventTypeAddLiquidity,
 			sdk.NewAttribute(types.AttributeValueSender, msg.Sender),
-			sdk.NewAttribute(types.AttributeValueTokenPair, types.GetTokenPairByDenom(msg.MaxToken.Denom, standardDenom)),
+			sdk.NewAttribute(types.AttributeValueTokenPair, types.GetTokenPairByDenom(msg.MaxToken.Denom, pairDenom)),
 		),
 	)
 	return k.addLiquidity(ctx, sender, reservePoolAddress, standardCoin, depositToken, pool.LptDenom, mintLiquidityAmt)
@@ -231,6 +349,10 @@ func (k Keeper) addLiquidity(ctx sdk.Context,
 	lptDenom string,
 	mintLiquidityAmt sdkmath.Int,
 ) (sdk.Coin, error) {
+	if err := k.updateTwap(ctx, lptDenom); err != nil {
+		return sdk.Coin{}, err
+	}
+
 	depositedTokens := sdk.NewCoins(standardCoin, token)
 	// transfer deposited token into coinswaps Account
 	if err := k.bk.SendCoins(ctx, sender, reservePoolAddress, depositedTokens); err != nil {
@@ -246,20 +368,21 @@ func (k Keeper) addLiquidity(ctx sdk.Context,
 		return sdk.Coin{}, err
 	}
 
+	k.recordKPerShare(ctx, lptDenom)
 	return mintToken, nil
 }
 
 // RemoveLiquidity removes liquidity from the specified pool
 func (k Keeper) RemoveLiquidity(ctx sdk.Context, msg *types.MsgRemoveLiquidity) (sdk.Coins, error) {
-	standardDenom, err := k.GetStandardDenom(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	pool, exists := k.GetPoolByLptDenom(ctx, msg.WithdrawLiquidity.Denom)
 	if !exists {
 		return nil, errorsmod.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", msg.WithdrawLiquidity.Denom)
 	}
+	if pool.PoolType == types.POOL_TYPE_CONCENTRATED {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPoolType, "liquidity is removed from the concentrated liquidity pool %s with positions", pool.LptDenom)
+	}
+	// liquidity can be removed whatever the pool status, so that the providers
+	// can always exit a paused pool
 
 	balances, err := k.GetPoolBalances(ctx, pool.EscrowAddress)
 	if err != nil {
@@ -267,6 +390,7 @@ func (k Keeper) RemoveLiquidity(ctx sdk.Context, msg *types.MsgRemoveLiquidity)
 	}
 
 	lptDenom := msg.WithdrawLiquidity.Denom
+	standardDenom := pool.StandardDenom
 	minTokenDenom := pool.CounterpartyDenom
 
 	standardReserveAmt := balances.AmountOf(standardDenom)
@@ -320,6 +444,10 @@ func (k Keeper) RemoveLiquidity(ctx sdk.Context, msg *types.MsgRemoveLiquidity)
 }
 
 func (k Keeper) removeLiquidity(ctx sdk.Context, poolAddr, sender sdk.AccAddress, deductUniCoin, standardWithdrawCoin, tokenWithdrawCoin sdk.Coin) (sdk.Coins, error) {
+	if err := k.updateTwap(ctx, deductUniCoin.Denom); err != nil {
+		return nil, err
+	}
+
 	deltaCoins := sdk.NewCoins(deductUniCoin)
 
 	// send liquidity vouchers to be burned from sender account to module account
@@ -333,8 +461,12 @@ func (k Keeper) removeLiquidity(ctx sdk.Context, poolAddr, sender sdk.AccAddress
 
 	// transfer withdrawn liquidity from coinswap reserve pool account to sender account
 	coins := sdk.NewCoins(standardWithdrawCoin, tokenWithdrawCoin)
+	if err := k.bk.SendCoins(ctx, poolAddr, sender, coins); err != nil {
+		return nil, err
+	}
 
-	return coins, k.bk.SendCoins(ctx, poolAddr, sender, coins)
+	k.recordKPerShare(ctx, deductUniCoin.Denom)
+	return coins, nil
 }
 
 // GetParams gets the parameters for the coinswap module.
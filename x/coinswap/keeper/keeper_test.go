@@ -150,8 +150,10 @@ func (suite *TestSuite) TestParams() {
 			params: types.Params{
 				Fee:                    sdkmath.LegacyNewDec(0),
 				PoolCreationFee:        sdk.Coin{sdk.DefaultBondDenom, sdkmath.ZeroInt()},
+				GaugeCreationFee:       sdk.Coin{sdk.DefaultBondDenom, sdkmath.ZeroInt()},
 				TaxRate:                sdkmath.LegacyNewDec(0),
 				MaxStandardCoinPerPool: sdkmath.NewInt(10_000_000_000),
+				SwapProtocolFeeShare:   sdkmath.LegacyZeroDec(),
 				MaxSwapAmount: sdk.NewCoins(
 					sdk.NewInt64Coin("usdc", 10_000_000),
 					sdk.NewInt64Coin("usdt", 10_000_000),
@@ -249,8 +251,10 @@ func (suite *TestSuite) TestLiquidity() {
 	params := types.Params{
 		Fee:                    sdkmath.LegacyNewDec(0),
 		PoolCreationFee:        sdk.Coin{sdk.DefaultBondDenom, sdkmath.ZeroInt()},
+		GaugeCreationFee:       sdk.Coin{sdk.DefaultBondDenom, sdkmath.ZeroInt()},
 		TaxRate:                sdkmath.LegacyNewDec(0),
 		MaxStandardCoinPerPool: sdkmath.NewInt(10_000_000_000),
+		SwapProtocolFeeShare:   sdkmath.LegacyZeroDec(),
 		MaxSwapAmount:          sdk.NewCoins(sdk.NewInt64Coin(denomBTC, 10_000_000)),
 	}
 	suite.app.CoinswapKeeper.SetParams(suite.ctx, params)
@@ -286,7 +290,7 @@ func (suite *TestSuite) TestLiquidity() {
 	_, err = suite.app.CoinswapKeeper.AddLiquidity(suite.ctx, msg)
 	suite.NoError(err)
 
-	poolId := types.GetPoolId(denomBTC)
+	poolId := types.GetPoolId(denomStandard, denomBTC)
 	pool, has := suite.app.CoinswapKeeper.GetPool(suite.ctx, poolId)
 	suite.Require().True(has)
 
@@ -433,7 +437,7 @@ func (suite *TestSuite) TestLiquidity() {
 	_, err = suite.app.CoinswapKeeper.AddLiquidity(suite.ctx, msg)
 	suite.NoError(err)
 
-	poolId = types.GetPoolId(denomBTC)
+	poolId = types.GetPoolId(denomStandard, denomBTC)
 	pool, has = suite.app.CoinswapKeeper.GetPool(suite.ctx, poolId)
 	suite.Require().True(has)
 
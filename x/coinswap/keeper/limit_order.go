@@ -0,0 +1,267 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
+)
+
+// PlaceLimitOrder escrows the token sold by the order in the module account and
+// stores the order until it is filled, cancelled or expired
+func (k Keeper) PlaceLimitOrder(ctx sdk.Context, msg *types.MsgPlaceLimitOrder) (uint64, error) {
+	lptDenom, err := k.GetLptDenomFromDenoms(ctx, msg.TokenIn.Denom, msg.MinTokenOut.Denom)
+	if err != nil {
+		return 0, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return 0, err
+	}
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(msg.TokenIn)); err != nil {
+		return 0, err
+	}
+
+	order := types.LimitOrder{
+		Id:          k.getLimitOrderSequence(ctx),
+		Owner:       msg.Sender,
+		LptDenom:    lptDenom,
+		TokenIn:     msg.TokenIn,
+		MinTokenOut: msg.MinTokenOut,
+		Deadline:    msg.Deadline,
+	}
+	k.SetLimitOrder(ctx, order)
+	k.setLimitOrderSequence(ctx, order.Id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePlaceLimitOrder,
+			sdk.NewAttribute(types.AttributeValueOrderId, fmt.Sprintf("%d", order.Id)),
+			sdk.NewAttribute(types.AttributeValueOwner, order.Owner),
+			sdk.NewAttribute(types.AttributeValueTokenPair, lptDenom),
+			sdk.NewAttribute(types.AttributeValueTokenIn, order.TokenIn.String()),
+			sdk.NewAttribute(types.AttributeValueTokenOut, order.MinTokenOut.String()),
+		),
+	)
+	return order.Id, nil
+}
+
+// CancelLimitOrder deletes an open limit order of the sender and refunds its
+// escrowed token
+func (k Keeper) CancelLimitOrder(ctx sdk.Context, msg *types.MsgCancelLimitOrder) (sdk.Coin, error) {
+	order, found := k.GetLimitOrder(ctx, msg.OrderId)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrLimitOrderNotFound, "order id: %d", msg.OrderId)
+	}
+	if order.Owner != msg.Sender {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "limit order %d is not owned by %s", order.Id, msg.Sender)
+	}
+
+	if err := k.refundLimitOrder(ctx, order); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelLimitOrder,
+			sdk.NewAttribute(types.AttributeValueOrderId, fmt.Sprintf("%d", order.Id)),
+			sdk.NewAttribute(types.AttributeValueOwner, order.Owner),
+			sdk.NewAttribute(types.AttributeValueAmount, order.TokenIn.String()),
+		),
+	)
+	return order.TokenIn, nil
+}
+
+// FillLimitOrders examines up to LimitOrderFillBudget open limit orders, resuming
+// after the last order examined in the previous block. Orders past their deadline
+// are refunded, the others are filled if their pool sells at least their minimum
+// token out for their escrowed token
+func (k Keeper) FillLimitOrders(ctx sdk.Context) {
+	budget := k.GetParams(ctx).LimitOrderFillBudget
+	if budget == 0 {
+		return
+	}
+
+	orders := k.getLimitOrdersFrom(ctx, k.getLimitOrderCursor(ctx), int(budget))
+	if len(orders) == 0 {
+		return
+	}
+
+	for _, order := range orders {
+		if ctx.BlockHeader().Time.After(time.Unix(order.Deadline, 0)) {
+			k.expireLimitOrder(ctx, order)
+			continue
+		}
+		k.fillLimitOrder(ctx, order)
+	}
+	k.setLimitOrderCursor(ctx, orders[len(orders)-1].Id+1)
+}
+
+// fillLimitOrder swaps the escrowed token of the order for at least its minimum
+// token out. The order is left open if the swap fails, the state changes of the
+// failed swap being discarded
+func (k Keeper) fillLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	cacheCtx, write := ctx.CacheContext()
+	input := types.Input{Address: k.ak.GetModuleAddress(types.ModuleName).String(), Coin: order.TokenIn}
+	output := types.Output{Address: order.Owner, Coin: order.MinTokenOut}
+	boughtAmt, err := k.TradeExactInputForOutput(cacheCtx, input, output)
+	if err != nil {
+		return
+	}
+	k.deleteLimitOrder(cacheCtx, order)
+
+	cacheCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFillLimitOrder,
+			sdk.NewAttribute(types.AttributeValueOrderId, fmt.Sprintf("%d", order.Id)),
+			sdk.NewAttribute(types.AttributeValueOwner, order.Owner),
+			sdk.NewAttribute(types.AttributeValueTokenIn, order.TokenIn.String()),
+			sdk.NewAttribute(types.AttributeValueTokenOut, sdk.NewCoin(order.MinTokenOut.Denom, boughtAmt).String()),
+		),
+	)
+	write()
+}
+
+// expireLimitOrder deletes an order past its deadline and refunds its escrowed token
+func (k Keeper) expireLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	if err := k.refundLimitOrder(ctx, order); err != nil {
+		k.Logger(ctx).Error("failed to refund expired limit order", "order_id", order.Id, "error", err.Error())
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExpireLimitOrder,
+			sdk.NewAttribute(types.AttributeValueOrderId, fmt.Sprintf("%d", order.Id)),
+			sdk.NewAttribute(types.AttributeValueOwner, order.Owner),
+			sdk.NewAttribute(types.AttributeValueAmount, order.TokenIn.String()),
+		),
+	)
+}
+
+// refundLimitOrder sends the escrowed token of the order back to its owner and
+// deletes the order
+func (k Keeper) refundLimitOrder(ctx sdk.Context, order types.LimitOrder) error {
+	owner, err := sdk.AccAddressFromBech32(order.Owner)
+	if err != nil {
+		return err
+	}
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(order.TokenIn)); err != nil {
+		return err
+	}
+	k.deleteLimitOrder(ctx, order)
+	return nil
+}
+
+// GetLimitOrder returns the open limit order of the given id
+func (k Keeper) GetLimitOrder(ctx sdk.Context, orderId uint64) (types.LimitOrder, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get(types.GetLimitOrderKey(orderId))
+	if bz == nil {
+		return types.LimitOrder{}, false
+	}
+
+	var order types.LimitOrder
+	k.cdc.MustUnmarshal(bz, &order)
+	return order, true
+}
+
+// SetLimitOrder stores the limit order and indexes it by owner and pool
+func (k Keeper) SetLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&order)
+	store.Set(types.GetLimitOrderKey(order.Id), bz)
+
+	id := sdk.Uint64ToBigEndian(order.Id)
+	store.Set(types.GetLimitOrderByOwnerKey(order.Owner, order.Id), id)
+	store.Set(types.GetLimitOrderByPoolKey(order.LptDenom, order.Id), id)
+}
+
+// deleteLimitOrder deletes the limit order and its indexes
+func (k Keeper) deleteLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	store := k.storeService.OpenKVStore(ctx)
+	for _, key := range [][]byte{
+		types.GetLimitOrderKey(order.Id),
+		types.GetLimitOrderByOwnerKey(order.Owner, order.Id),
+		types.GetLimitOrderByPoolKey(order.LptDenom, order.Id),
+	} {
+		if err := store.Delete(key); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// GetAllLimitOrders returns all the open limit orders
+func (k Keeper) GetAllLimitOrders(ctx sdk.Context) (orders []types.LimitOrder) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyLimitOrder+"/"))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.LimitOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &order)
+		orders = append(orders, order)
+	}
+	return
+}
+
+// getLimitOrdersFrom returns up to limit open limit orders, starting from the
+// order of id cursor and wrapping around to the first order
+func (k Keeper) getLimitOrdersFrom(ctx sdk.Context, cursor uint64, limit int) (orders []types.LimitOrder) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	start := []byte(types.KeyLimitOrder + "/")
+	end := storetypes.PrefixEndBytes(start)
+	for _, bounds := range [][2][]byte{
+		{types.GetLimitOrderKey(cursor), end},
+		{start, types.GetLimitOrderKey(cursor)},
+	} {
+		iterator := store.Iterator(bounds[0], bounds[1])
+		for ; iterator.Valid() && len(orders) < limit; iterator.Next() {
+			var order types.LimitOrder
+			k.cdc.MustUnmarshal(iterator.Value(), &order)
+			orders = append(orders, order)
+		}
+		iterator.Close()
+	}
+	return
+}
+
+// getLimitOrderSequence gets the id of the next limit order from the store.
+func (k Keeper) getLimitOrderSequence(ctx sdk.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get([]byte(types.KeyNextLimitOrderSequence))
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setLimitOrderSequence sets the id of the next limit order to the store.
+func (k Keeper) setLimitOrderSequence(ctx sdk.Context, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := sdk.Uint64ToBigEndian(sequence)
+	store.Set([]byte(types.KeyNextLimitOrderSequence), bz)
+}
+
+// getLimitOrderCursor gets the id of the limit order the next block resumes filling from.
+func (k Keeper) getLimitOrderCursor(ctx sdk.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get([]byte(types.KeyLimitOrderCursor))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setLimitOrderCursor sets the id of the limit order the next block resumes filling from.
+func (k Keeper) setLimitOrderCursor(ctx sdk.Context, cursor uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := sdk.Uint64ToBigEndian(cursor)
+	store.Set([]byte(types.KeyLimitOrderCursor), bz)
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
+)
+
+func (suite *TestSuite) TestLimitOrders() {
+	sender, _ := createReservePool(suite, denomBTC)
+	moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	deadline := suite.ctx.BlockHeader().Time.Add(time.Hour).Unix()
+
+	testCases := []struct {
+		name string
+		msg  *types.MsgPlaceLimitOrder
+		err  error
+	}{
+		{"fail - equal denoms", types.NewMsgPlaceLimitOrder(sender.String(), buildCoin(denomBTC, 1_000_000), buildCoin(denomBTC, 1_000_000), deadline), types.ErrEqualDenom},
+		{"fail - pool not exists", types.NewMsgPlaceLimitOrder(sender.String(), buildCoin(denomETH, 1_000_000), buildCoin(denomStandard, 1_000_000), deadline), types.ErrReservePoolNotExists},
+		{"fail - deadline passed", types.NewMsgPlaceLimitOrder(sender.String(), buildCoin(denomBTC, 1_000_000), buildCoin(denomStandard, 1_000_000), 1), types.ErrInvalidDeadline},
+		{"fail - insufficient funds", types.NewMsgPlaceLimitOrder(sender.String(), buildCoin(denomBTC, 100_000_000_000), buildCoin(denomStandard, 1_000_000), deadline), sdkerrors.ErrInsufficientFunds},
+	}
+	for _, tc := range testCases {
+		_, err := suite.msgServer.PlaceLimitOrder(suite.ctx, tc.msg)
+		suite.Require().ErrorIs(err, tc.err, tc.name)
+	}
+
+	// the pool sells 999_900 standard coins for 1_000_000 btc
+	res, err := suite.msgServer.PlaceLimitOrder(suite.ctx, types.NewMsgPlaceLimitOrder(sender.String(), buildCoin(denomBTC, 1_000_000), buildCoin(denomStandard, 1_000_000), deadline))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.OrderId)
+	res, err = suite.msgServer.PlaceLimitOrder(suite.ctx, types.NewMsgPlaceLimitOrder(sender.String(), buildCoin(denomBTC, 1_000_000), buildCoin(denomStandard, 990_000), deadline))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res.OrderId)
+	suite.Require().Equal(buildCoin(denomBTC, 2_000_000), suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, denomBTC))
+
+	for _, req := range []*types.QueryLimitOrdersRequest{
+		{},
+		{Owner: sender.String()},
+		{LptDenom: "lpt-1"},
+		{Owner: sender.String(), LptDenom: "lpt-1"},
+	} {
+		ordersRes, err := suite.app.CoinswapKeeper.LimitOrders(suite.ctx, req)
+		suite.Require().NoError(err)
+		suite.Require().Len(ordersRes.Orders, 2)
+	}
+	ordersRes, err := suite.app.CoinswapKeeper.LimitOrders(suite.ctx, &types.QueryLimitOrdersRequest{Owner: addrSender1.String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(ordersRes.Orders)
+
+	// the fill budget is disabled by the pool parameters of the test
+	suite.Require().NoError(suite.app.CoinswapKeeper.EndBlocker(suite.ctx))
+	suite.Require().Len(suite.app.CoinswapKeeper.GetAllLimitOrders(suite.ctx), 2)
+
+	params := suite.app.CoinswapKeeper.GetParams(suite.ctx)
+	params.LimitOrderFillBudget = 1
+	suite.app.CoinswapKeeper.SetParams(suite.ctx, params)
+
+	// the first order can not be filled, the second order is examined by the next block
+	suite.Require().NoError(suite.app.CoinswapKeeper.EndBlocker(suite.ctx))
+	suite.Require().Len(suite.app.CoinswapKeeper.GetAllLimitOrders(suite.ctx), 2)
+
+	standardBalance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, denomStandard)
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(suite.app.CoinswapKeeper.EndBlocker(suite.ctx))
+	_, found := suite.app.CoinswapKeeper.GetLimitOrder(suite.ctx, 2)
+	suite.Require().False(found)
+	bought := suite.app.BankKeeper.GetBalance(suite.ctx, sender, denomStandard).Sub(standardBalance)
+	suite.Require().True(bought.Amount.GTE(buildCoin(denomStandard, 990_000).Amount))
+	suite.Require().Equal(buildCoin(denomBTC, 1_000_000), suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, denomBTC))
+
+	var fillEvent *sdk.Event
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeFillLimitOrder {
+			fillEvent = &event
+		}
+	}
+	suite.Require().NotNil(fillEvent)
+
+	// only the owner can cancel the order
+	_, err = suite.msgServer.CancelLimitOrder(suite.ctx, types.NewMsgCancelLimitOrder(addrSender1.String(), 1))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.CancelLimitOrder(suite.ctx, types.NewMsgCancelLimitOrder(sender.String(), 2))
+	suite.Require().ErrorIs(err, types.ErrLimitOrderNotFound)
+
+	btcBalance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, denomBTC)
+	cancelRes, err := suite.msgServer.CancelLimitOrder(suite.ctx, types.NewMsgCancelLimitOrder(sender.String(), 1))
+	suite.Require().NoError(err)
+	suite.Require().Equal(buildCoin(denomBTC, 1_000_000), cancelRes.Refund)
+	suite.Require().Equal(btcBalance.Add(cancelRes.Refund), suite.app.BankKeeper.GetBalance(suite.ctx, sender, denomBTC))
+	_, err = suite.app.CoinswapKeeper.LimitOrder(suite.ctx, &types.QueryLimitOrderRequest{OrderId: 1})
+	suite.Require().ErrorIs(err, types.ErrLimitOrderNotFound)
+
+	// an order past its deadline is refunded
+	res, err = suite.msgServer.PlaceLimitOrder(suite.ctx, types.NewMsgPlaceLimitOrder(sender.String(), buildCoin(denomBTC, 1_000_000), buildCoin(denomStandard, 1_000_000), deadline))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), res.OrderId)
+	orderRes, err := suite.app.CoinswapKeeper.LimitOrder(suite.ctx, &types.QueryLimitOrderRequest{OrderId: 3})
+	suite.Require().NoError(err)
+	suite.Require().Equal("lpt-1", orderRes.Order.LptDenom)
+
+	ctx := suite.ctx.WithBlockTime(time.Unix(deadline, 0).Add(time.Second))
+	suite.Require().NoError(suite.app.CoinswapKeeper.EndBlocker(ctx))
+	suite.Require().Empty(suite.app.CoinswapKeeper.GetAllLimitOrders(ctx))
+	suite.Require().Equal(btcBalance.Add(cancelRes.Refund), suite.app.BankKeeper.GetBalance(ctx, sender, denomBTC))
+	suite.Require().True(suite.app.BankKeeper.GetBalance(ctx, moduleAddr, denomBTC).IsZero())
+}
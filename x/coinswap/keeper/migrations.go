@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	v4 "github.com/Canto-Network/Canto/v8/x/coinswap/migrations/v4"
+)
+
+var _ module.MigrationHandler = Migrator{}.Migrate3to4
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate3to4 migrates from consensus version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	if err := v4.UpdateParams(ctx, &m.keeper.paramSpace); err != nil {
+		return err
+	}
+	store := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
+	return v4.MigratePoolIds(store, m.keeper.cdc)
+}
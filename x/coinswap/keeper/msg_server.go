@@ -29,6 +29,10 @@ func (m msgServer) AddLiquidity(goCtx context.Context, msg *types.MsgAddLiquidit
 		return nil, err
 	}
 
+	if err := types.ValidatePairDenom(msg.PairDenom, msg.MaxToken); err != nil {
+		return nil, err
+	}
+
 	if err := types.ValidateExactStandardAmt(msg.ExactStandardAmt); err != nil {
 		return nil, err
 	}
@@ -41,6 +45,14 @@ func (m msgServer) AddLiquidity(goCtx context.Context, msg *types.MsgAddLiquidit
 		return nil, err
 	}
 
+	if err := types.ValidatePoolType(msg.PoolType, msg.Amplification); err != nil {
+		return nil, err
+	}
+
+	if msg.PoolType == types.POOL_TYPE_CONCENTRATED {
+		return nil, errorsmod.Wrap(types.ErrInvalidPoolType, "concentrated liquidity pools are provided with MsgCreatePosition")
+	}
+
 	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
 	}
@@ -102,6 +114,76 @@ func (m msgServer) RemoveLiquidity(goCtx context.Context, msg *types.MsgRemoveLi
 	}, nil
 }
 
+func (m msgServer) AddLiquiditySingle(goCtx context.Context, msg *types.MsgAddLiquiditySingle) (*types.MsgAddLiquiditySingleResponse, error) {
+	if err := types.ValidateLptDenom(msg.LptDenom); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateSingleToken(msg.TokenIn, true); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateMinLiquidity(msg.MinLiquidity); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateDeadline(msg.Deadline); err != nil {
+		return nil, err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// check that deadline has not passed
+	if ctx.BlockHeader().Time.After(time.Unix(msg.Deadline, 0)) {
+		return nil, errorsmod.Wrap(types.ErrInvalidDeadline, "deadline has passed for MsgAddLiquiditySingle")
+	}
+
+	mintToken, err := m.Keeper.AddLiquiditySingle(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddLiquiditySingleResponse{
+		MintToken: mintToken,
+	}, nil
+}
+
+func (m msgServer) RemoveLiquiditySingle(goCtx context.Context, msg *types.MsgRemoveLiquiditySingle) (*types.MsgRemoveLiquiditySingleResponse, error) {
+	if err := types.ValidateWithdrawLiquidity(msg.WithdrawLiquidity); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateSingleToken(msg.TokenOutMin, false); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateDeadline(msg.Deadline); err != nil {
+		return nil, err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// check that deadline has not passed
+	if ctx.BlockHeader().Time.After(time.Unix(msg.Deadline, 0)) {
+		return nil, errorsmod.Wrap(types.ErrInvalidDeadline, "deadline has passed for MsgRemoveLiquiditySingle")
+	}
+
+	tokenOut, err := m.Keeper.RemoveLiquiditySingle(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveLiquiditySingleResponse{
+		TokenOut: tokenOut,
+	}, nil
+}
+
 func (m msgServer) SwapCoin(goCtx context.Context, msg *types.MsgSwapOrder) (*types.MsgSwapCoinResponse, error) {
 	if err := types.ValidateInput(msg.Input); err != nil {
 		return nil, err
@@ -136,6 +218,332 @@ func (m msgServer) SwapCoin(goCtx context.Context, msg *types.MsgSwapOrder) (*ty
 	return &types.MsgSwapCoinResponse{}, nil
 }
 
+func (m msgServer) SwapExactAmountInRoute(goCtx context.Context, msg *types.MsgSwapExactAmountInRoute) (*types.MsgSwapExactAmountInRouteResponse, error) {
+	if err := types.ValidateSwapRoute(msg.Sender, msg.Recipient, msg.TokenIn, msg.TokenOutMin); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateDeadline(msg.Deadline); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// check that deadline has not passed
+	if ctx.BlockHeader().Time.After(time.Unix(msg.Deadline, 0)) {
+		return nil, errorsmod.Wrap(types.ErrInvalidDeadline, "deadline has passed for MsgSwapExactAmountInRoute")
+	}
+
+	if m.Keeper.blockedAddrs[msg.Recipient] {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", msg.Recipient)
+	}
+
+	tokenOut, err := m.Keeper.SwapExactAmountInRoute(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSwapExactAmountInRouteResponse{
+		TokenOut: tokenOut,
+	}, nil
+}
+
+func (m msgServer) SwapExactAmountOutRoute(goCtx context.Context, msg *types.MsgSwapExactAmountOutRoute) (*types.MsgSwapExactAmountOutRouteResponse, error) {
+	if err := types.ValidateSwapRoute(msg.Sender, msg.Recipient, msg.TokenInMax, msg.TokenOut); err != nil {
+		return nil, err
+	}
+
+	if !msg.TokenOut.IsPositive() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid output (%s)", msg.TokenOut.String())
+	}
+
+	if err := types.ValidateDeadline(msg.Deadline); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// check that deadline has not passed
+	if ctx.BlockHeader().Time.After(time.Unix(msg.Deadline, 0)) {
+		return nil, errorsmod.Wrap(types.ErrInvalidDeadline, "deadline has passed for MsgSwapExactAmountOutRoute")
+	}
+
+	if m.Keeper.blockedAddrs[msg.Recipient] {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", msg.Recipient)
+	}
+
+	tokenIn, err := m.Keeper.SwapExactAmountOutRoute(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSwapExactAmountOutRouteResponse{
+		TokenIn: tokenIn,
+	}, nil
+}
+
+func (m msgServer) PlaceLimitOrder(goCtx context.Context, msg *types.MsgPlaceLimitOrder) (*types.MsgPlaceLimitOrderResponse, error) {
+	if err := types.ValidateLimitOrderCoins(msg.TokenIn, msg.MinTokenOut); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateDeadline(msg.Deadline); err != nil {
+		return nil, err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// check that deadline has not passed
+	if ctx.BlockHeader().Time.After(time.Unix(msg.Deadline, 0)) {
+		return nil, errorsmod.Wrap(types.ErrInvalidDeadline, "deadline has passed for MsgPlaceLimitOrder")
+	}
+
+	orderId, err := m.Keeper.PlaceLimitOrder(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPlaceLimitOrderResponse{
+		OrderId: orderId,
+	}, nil
+}
+
+func (m msgServer) CancelLimitOrder(goCtx context.Context, msg *types.MsgCancelLimitOrder) (*types.MsgCancelLimitOrderResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	refund, err := m.Keeper.CancelLimitOrder(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelLimitOrderResponse{
+		Refund: refund,
+	}, nil
+}
+
+func (m msgServer) CreatePosition(goCtx context.Context, msg *types.MsgCreatePosition) (*types.MsgCreatePositionResponse, error) {
+	if err := types.ValidateMaxToken(msg.MaxToken); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateMaxAmount(msg.MaxStandardAmt); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateTickRange(msg.LowerTick, msg.UpperTick); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateMinLiquidity(msg.MinLiquidity); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateInitialPrice(msg.InitialPrice); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateDeadline(msg.Deadline); err != nil {
+		return nil, err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// check that deadline has not passed
+	if ctx.BlockHeader().Time.After(time.Unix(msg.Deadline, 0)) {
+		return nil, errorsmod.Wrap(types.ErrInvalidDeadline, "deadline has passed for MsgCreatePosition")
+	}
+
+	position, amounts, err := m.Keeper.CreatePosition(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePositionResponse{
+		PositionId: position.Id,
+		Liquidity:  position.Liquidity,
+		Amounts:    amounts,
+	}, nil
+}
+
+func (m msgServer) IncreasePosition(goCtx context.Context, msg *types.MsgIncreasePosition) (*types.MsgIncreasePositionResponse, error) {
+	if err := types.ValidateMaxAmount(msg.MaxTokenAmt); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateMaxAmount(msg.MaxStandardAmt); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateMinLiquidity(msg.MinLiquidity); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateDeadline(msg.Deadline); err != nil {
+		return nil, err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// check that deadline has not passed
+	if ctx.BlockHeader().Time.After(time.Unix(msg.Deadline, 0)) {
+		return nil, errorsmod.Wrap(types.ErrInvalidDeadline, "deadline has passed for MsgIncreasePosition")
+	}
+
+	liquidity, amounts, err := m.Keeper.IncreasePosition(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgIncreasePositionResponse{
+		Liquidity: liquidity,
+		Amounts:   amounts,
+	}, nil
+}
+
+func (m msgServer) DecreasePosition(goCtx context.Context, msg *types.MsgDecreasePosition) (*types.MsgDecreasePositionResponse, error) {
+	if err := types.ValidatePositionLiquidity(msg.Liquidity); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateMinToken(msg.MinTokenAmt); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateMinStandardAmt(msg.MinStandardAmt); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateDeadline(msg.Deadline); err != nil {
+		return nil, err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// check that deadline has not passed
+	if ctx.BlockHeader().Time.After(time.Unix(msg.Deadline, 0)) {
+		return nil, errorsmod.Wrap(types.ErrInvalidDeadline, "deadline has passed for MsgDecreasePosition")
+	}
+
+	amounts, err := m.Keeper.DecreasePosition(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDecreasePositionResponse{
+		Amounts: amounts,
+	}, nil
+}
+
+func (m msgServer) CollectFees(goCtx context.Context, msg *types.MsgCollectFees) (*types.MsgCollectFeesResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	fees, err := m.Keeper.CollectFees(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCollectFeesResponse{
+		Fees: fees,
+	}, nil
+}
+
+func (m msgServer) CreateGauge(goCtx context.Context, msg *types.MsgCreateGauge) (*types.MsgCreateGaugeResponse, error) {
+	if err := types.ValidateLptDenom(msg.LptDenom); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateGaugeCoins(msg.Coins, msg.NumEpochs); err != nil {
+		return nil, err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	gaugeId, err := m.Keeper.CreateGauge(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateGaugeResponse{
+		GaugeId: gaugeId,
+	}, nil
+}
+
+func (m msgServer) LockLiquidity(goCtx context.Context, msg *types.MsgLockLiquidity) (*types.MsgLockLiquidityResponse, error) {
+	if err := types.ValidateLockCoin(msg.Coin); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateLockDuration(msg.Duration); err != nil {
+		return nil, err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	lockId, err := m.Keeper.LockLiquidity(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgLockLiquidityResponse{
+		LockId: lockId,
+	}, nil
+}
+
+func (m msgServer) UnlockLiquidity(goCtx context.Context, msg *types.MsgUnlockLiquidity) (*types.MsgUnlockLiquidityResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	coin, rewards, err := m.Keeper.UnlockLiquidity(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnlockLiquidityResponse{
+		Coin:    coin,
+		Rewards: rewards,
+	}, nil
+}
+
+func (m msgServer) ClaimLockRewards(goCtx context.Context, msg *types.MsgClaimLockRewards) (*types.MsgClaimLockRewardsResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rewards, err := m.Keeper.ClaimLockRewards(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimLockRewardsResponse{
+		Rewards: rewards,
+	}, nil
+}
+
 func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != req.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
@@ -150,3 +558,55 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (k msgServer) UpdatePoolParams(goCtx context.Context, req *types.MsgUpdatePoolParams) (*types.MsgUpdatePoolParamsResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	if err := types.ValidateLptDenom(req.LptDenom); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidatePoolFee(req.Fee); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidatePriceDeviation(req.MaxBlockPriceDeviation); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidatePriceDeviation(req.MaxEpochPriceDeviation); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pool, has := k.GetPoolByLptDenom(ctx, req.LptDenom)
+	if !has {
+		return nil, errorsmod.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", req.LptDenom)
+	}
+
+	pool.Fee = req.Fee
+	pool.MaxBlockPriceDeviation = req.MaxBlockPriceDeviation
+	pool.MaxEpochPriceDeviation = req.MaxEpochPriceDeviation
+	k.setPool(ctx, &pool)
+
+	return &types.MsgUpdatePoolParamsResponse{}, nil
+}
+
+func (k msgServer) SetPoolStatus(goCtx context.Context, req *types.MsgSetPoolStatus) (*types.MsgSetPoolStatusResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	if err := types.ValidateLptDenom(req.LptDenom); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.SetPoolStatus(ctx, req.LptDenom, req.Status); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPoolStatusResponse{}, nil
+}
@@ -2,9 +2,11 @@ package keeper_test
 
 import (
 	"math"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/Canto-Network/Canto/v8/testutil"
+	"github.com/Canto-Network/Canto/v8/x/coinswap/keeper"
 	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -23,8 +25,10 @@ func (suite *TestSuite) TestEventEmit() {
 	params := types.Params{
 		Fee:                    sdkmath.LegacyNewDec(0),
 		PoolCreationFee:        sdk.Coin{sdk.DefaultBondDenom, sdkmath.ZeroInt()},
+		GaugeCreationFee:       sdk.Coin{sdk.DefaultBondDenom, sdkmath.ZeroInt()},
 		TaxRate:                sdkmath.LegacyNewDec(0),
 		MaxStandardCoinPerPool: sdkmath.NewInt(10_000_000_000),
+		SwapProtocolFeeShare:   sdkmath.LegacyZeroDec(),
 		MaxSwapAmount: sdk.NewCoins(
 			sdk.NewInt64Coin("btc", 10_000_000),
 		),
@@ -89,6 +93,58 @@ func (suite *TestSuite) TestMsgSwapOrder_ValidateBasic() {
 	}
 }
 
+func (suite *TestSuite) TestMsgSwapExactAmountInRoute_ValidateBasic() {
+	msg := types.MsgSwapExactAmountInRoute{}
+	suite.Require().Equal("/canto.coinswap.v1.MsgSwapExactAmountInRoute", sdk.MsgTypeURL(&msg))
+
+	tests := []struct {
+		name    string
+		msg     *types.MsgSwapExactAmountInRoute
+		wantErr bool
+	}{
+		{name: "invalid sender", wantErr: true, msg: types.NewMsgSwapExactAmountInRoute("", buildCoin("btc", 1000), buildCoin("eth", 1), "", 2611213344)},
+		{name: "invalid recipient", wantErr: true, msg: types.NewMsgSwapExactAmountInRoute(sender, buildCoin("btc", 1000), buildCoin("eth", 1), "invalid", 2611213344)},
+		{name: "invalid input coin amount", wantErr: true, msg: types.NewMsgSwapExactAmountInRoute(sender, buildCoin("btc", 0), buildCoin("eth", 1), "", 2611213344)},
+		{name: "invalid output coin denom", wantErr: true, msg: types.NewMsgSwapExactAmountInRoute(sender, buildCoin("btc", 1000), buildCoin("lpt-1", 1), "", 2611213344)},
+		{name: "equal denom", wantErr: true, msg: types.NewMsgSwapExactAmountInRoute(sender, buildCoin("btc", 1000), buildCoin("btc", 1), "", 2611213344)},
+		{name: "passed deadline", wantErr: true, msg: types.NewMsgSwapExactAmountInRoute(sender, buildCoin("btc", 1000), buildCoin("eth", 1), "", 10)},
+	}
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			_, err := suite.msgServer.SwapExactAmountInRoute(suite.ctx, tt.msg)
+			if tt.wantErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *TestSuite) TestMsgSwapExactAmountOutRoute() {
+	sender, _ := createReservePool(suite, denomBTC)
+	createReservePool(suite, denomETH)
+
+	msg := types.NewMsgSwapExactAmountOutRoute(sender.String(), buildCoin(denomBTC, 10_000), buildCoin(denomETH, 0), "", 2611213344)
+	_, err := suite.msgServer.SwapExactAmountOutRoute(suite.ctx, msg)
+	suite.Require().Error(err)
+
+	msg = types.NewMsgSwapExactAmountOutRoute(sender.String(), buildCoin(denomBTC, 10_000), buildCoin(denomETH, 1_000), "", 2611213344)
+	res, err := suite.msgServer.SwapExactAmountOutRoute(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(denomBTC, res.TokenIn.Denom)
+	suite.Require().True(res.TokenIn.Amount.LTE(msg.TokenInMax.Amount))
+
+	events := suite.ctx.EventManager().Events()
+	found, index := findEventTypeIndex(events, types.EventTypeSwapRoute)
+	suite.Require().True(found)
+	for _, attr := range events[index].Attributes {
+		if attr.Key == types.AttributeValueRoute {
+			suite.Require().Equal(denomBTC+","+denomStandard+","+denomETH, attr.Value)
+		}
+	}
+}
+
 func (suite *TestSuite) TestMsgAddLiquidity_ValidateBasic() {
 	msg := types.MsgAddLiquidity{}
 	suite.Require().Equal("/canto.coinswap.v1.MsgAddLiquidity", sdk.MsgTypeURL(&msg))
@@ -297,6 +353,124 @@ func (suite *TestSuite) TestMsgRemoveLiquidity_ValidateBasic() {
 	}
 }
 
+func (suite *TestSuite) TestMsgUpdatePoolParams() {
+	sender, _ := createReservePool(suite, denomBTC)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	poolFee := sdkmath.LegacyNewDecWithPrec(1, 2)
+	invalidFee := sdkmath.LegacyOneDec()
+	invalidPriceDeviation := sdkmath.LegacyZeroDec()
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdatePoolParams
+		expErr bool
+	}{
+		{"fail - invalid authority", &types.MsgUpdatePoolParams{Authority: sender.String(), LptDenom: "lpt-1", Fee: &poolFee}, true},
+		{"fail - pool not exists", &types.MsgUpdatePoolParams{Authority: authority, LptDenom: "lpt-100", Fee: &poolFee}, true},
+		{"fail - invalid fee", &types.MsgUpdatePoolParams{Authority: authority, LptDenom: "lpt-1", Fee: &invalidFee}, true},
+		{"fail - invalid max price deviation", &types.MsgUpdatePoolParams{Authority: authority, LptDenom: "lpt-1", Fee: &poolFee, MaxBlockPriceDeviation: &invalidPriceDeviation}, true},
+		{"ok - set pool fee", &types.MsgUpdatePoolParams{Authority: authority, LptDenom: "lpt-1", Fee: &poolFee}, false},
+	}
+
+	for _, tc := range testCases {
+		_, err := suite.msgServer.UpdatePoolParams(suite.ctx, tc.msg)
+		if tc.expErr {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+	suite.Require().Equal(poolFee, suite.app.CoinswapKeeper.GetPoolFee(suite.ctx, "lpt-1"))
+
+	// swaps are priced with the pool fee
+	poolBalances, err := suite.app.CoinswapKeeper.GetPoolBalancesByLptDenom(suite.ctx, "lpt-1")
+	suite.Require().NoError(err)
+	input := types.Input{Address: sender.String(), Coin: buildCoin(denomBTC, 10_000)}
+	output := types.Output{Address: sender.String(), Coin: buildCoin(denomStandard, 0)}
+	amt, err := suite.app.CoinswapKeeper.TradeExactInputForOutput(suite.ctx, input, output)
+	suite.Require().NoError(err)
+	expAmt := keeper.GetInputPrice(input.Coin.Amount, poolBalances.AmountOf(denomBTC), poolBalances.AmountOf(denomStandard), poolFee)
+	suite.Require().Equal(expAmt, amt)
+
+	// clearing the override falls back to the fee param
+	_, err = suite.msgServer.UpdatePoolParams(suite.ctx, &types.MsgUpdatePoolParams{Authority: authority, LptDenom: "lpt-1"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.app.CoinswapKeeper.GetParams(suite.ctx).Fee, suite.app.CoinswapKeeper.GetPoolFee(suite.ctx, "lpt-1"))
+}
+
+func (suite *TestSuite) TestMsgSetPoolStatus() {
+	sender, _ := createReservePool(suite, denomBTC)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	deadline := time.Now().Add(time.Minute).Unix()
+
+	params := suite.app.CoinswapKeeper.GetParams(suite.ctx)
+	params.MaxStandardCoinPerPool = sdkmath.NewInt(20_000_000_000)
+	suite.app.CoinswapKeeper.SetParams(suite.ctx, params)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgSetPoolStatus
+		expErr bool
+	}{
+		{"fail - invalid authority", &types.MsgSetPoolStatus{Authority: sender.String(), LptDenom: "lpt-1", Status: types.POOL_STATUS_SWAPS_PAUSED}, true},
+		{"fail - pool not exists", &types.MsgSetPoolStatus{Authority: authority, LptDenom: "lpt-100", Status: types.POOL_STATUS_SWAPS_PAUSED}, true},
+		{"fail - invalid status", &types.MsgSetPoolStatus{Authority: authority, LptDenom: "lpt-1", Status: types.PoolStatus(3)}, true},
+		{"ok - pause swaps", &types.MsgSetPoolStatus{Authority: authority, LptDenom: "lpt-1", Status: types.POOL_STATUS_SWAPS_PAUSED}, false},
+	}
+
+	for _, tc := range testCases {
+		_, err := suite.msgServer.SetPoolStatus(suite.ctx, tc.msg)
+		if tc.expErr {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+
+	input := types.Input{Address: sender.String(), Coin: buildCoin(denomBTC, 10_000)}
+	output := types.Output{Address: sender.String(), Coin: buildCoin(denomStandard, 0)}
+	addLiquidity := types.NewMsgAddLiquidity(buildCoin(denomBTC, 2_000_000), sdkmath.NewInt(1_000_000), sdkmath.OneInt(), deadline, sender.String())
+	removeLiquidity := types.NewMsgRemoveLiquidity(sdkmath.OneInt(), buildCoin("lpt-1", 100_000), sdkmath.OneInt(), deadline, sender.String())
+
+	statusCases := []struct {
+		status     types.PoolStatus
+		swapErr    bool
+		depositErr bool
+	}{
+		{types.POOL_STATUS_SWAPS_PAUSED, true, false},
+		{types.POOL_STATUS_WITHDRAW_ONLY, true, true},
+		{types.POOL_STATUS_ACTIVE, false, false},
+	}
+
+	for _, tc := range statusCases {
+		_, err := suite.msgServer.SetPoolStatus(suite.ctx, &types.MsgSetPoolStatus{Authority: authority, LptDenom: "lpt-1", Status: tc.status})
+		suite.Require().NoError(err)
+		suite.Require().Equal(!tc.swapErr, suite.app.CoinswapKeeper.IsSwapEnabled(suite.ctx, "lpt-1"))
+
+		res, err := suite.app.CoinswapKeeper.LiquidityPool(suite.ctx, &types.QueryLiquidityPoolRequest{LptDenom: "lpt-1"})
+		suite.Require().NoError(err)
+		suite.Require().Equal(tc.status, res.Pool.Status)
+
+		_, err = suite.app.CoinswapKeeper.TradeExactInputForOutput(suite.ctx, input, output)
+		if tc.swapErr {
+			suite.Require().ErrorIs(err, types.ErrPoolPaused, tc.status.String())
+		} else {
+			suite.Require().NoError(err, tc.status.String())
+		}
+
+		_, err = suite.app.CoinswapKeeper.AddLiquidity(suite.ctx, addLiquidity)
+		if tc.depositErr {
+			suite.Require().ErrorIs(err, types.ErrPoolPaused, tc.status.String())
+		} else {
+			suite.Require().NoError(err, tc.status.String())
+		}
+
+		// liquidity can always be removed
+		_, err = suite.app.CoinswapKeeper.RemoveLiquidity(suite.ctx, removeLiquidity)
+		suite.Require().NoError(err, tc.status.String())
+	}
+}
+
 func (suite *TestSuite) TestMsgExecutionByProposal() {
 	suite.SetupTest()
 
@@ -337,8 +511,10 @@ func (suite *TestSuite) TestMsgExecutionByProposal() {
 				Params: types.Params{
 					Fee:                    sdkmath.LegacyNewDec(0),
 					PoolCreationFee:        sdk.Coin{denomStandard, sdkmath.ZeroInt()},
+					GaugeCreationFee:       sdk.Coin{denomStandard, sdkmath.ZeroInt()},
 					TaxRate:                sdkmath.LegacyNewDec(0),
 					MaxStandardCoinPerPool: sdkmath.NewInt(10_000_000_000),
+					SwapProtocolFeeShare:   sdkmath.LegacyZeroDec(),
 					MaxSwapAmount: sdk.NewCoins(sdk.NewInt64Coin(denomBTC, 10_000_000),
 						sdk.NewInt64Coin(denomETH, 10_000_000),
 					),
@@ -348,8 +524,10 @@ func (suite *TestSuite) TestMsgExecutionByProposal() {
 				changeParams := types.Params{
 					Fee:                    sdkmath.LegacyNewDec(0),
 					PoolCreationFee:        sdk.Coin{denomStandard, sdkmath.ZeroInt()},
+					GaugeCreationFee:       sdk.Coin{denomStandard, sdkmath.ZeroInt()},
 					TaxRate:                sdkmath.LegacyNewDec(0),
 					MaxStandardCoinPerPool: sdkmath.NewInt(10_000_000_000),
+					SwapProtocolFeeShare:   sdkmath.LegacyZeroDec(),
 					MaxSwapAmount: sdk.NewCoins(sdk.NewInt64Coin(denomBTC, 10_000_000),
 						sdk.NewInt64Coin(denomETH, 10_000_000),
 					),
@@ -6,6 +6,7 @@ import (
 	gogoprototypes "github.com/cosmos/gogoproto/types"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,17 +14,20 @@ import (
 	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
 )
 
-// CreatePool create a liquidity that saves relevant information about popular pool tokens
-func (k Keeper) CreatePool(ctx sdk.Context, counterpartyDenom string) types.Pool {
-	standardDenom, _ := k.GetStandardDenom(ctx)
+// CreatePool create a liquidity that saves relevant information about popular pool tokens.
+// The standard denom of the pool is the denom of its exact deposit leg, which is
+// either the standard denom of the module or any other denom for pair pools
+func (k Keeper) CreatePool(ctx sdk.Context, standardDenom, counterpartyDenom string, poolType types.PoolType, amplification uint64) types.Pool {
 	sequence := k.getSequence(ctx)
 	lptDenom := types.GetLptDenom(sequence)
 	pool := &types.Pool{
-		Id:                types.GetPoolId(counterpartyDenom),
+		Id:                types.GetPoolId(standardDenom, counterpartyDenom),
 		StandardDenom:     standardDenom,
 		CounterpartyDenom: counterpartyDenom,
 		EscrowAddress:     types.GetReservePoolAddr(lptDenom).String(),
 		LptDenom:          lptDenom,
+		PoolType:          poolType,
+		Amplification:     amplification,
 	}
 	k.setSequence(ctx, sequence+1)
 	k.setPool(ctx, pool)
@@ -69,6 +73,26 @@ func (k Keeper) GetPoolByLptDenom(ctx sdk.Context, lptDenom string) (types.Pool,
 	return k.GetPool(ctx, poolId.Value)
 }
 
+// GetPoolFee returns the swap fee of the liquidity pool, falling back to the
+// fee param if the pool has no fee override
+func (k Keeper) GetPoolFee(ctx sdk.Context, lptDenom string) sdkmath.LegacyDec {
+	pool, has := k.GetPoolByLptDenom(ctx, lptDenom)
+	if has && pool.Fee != nil {
+		return *pool.Fee
+	}
+	return k.GetParams(ctx).Fee
+}
+
+// GetInitialLiquidity returns the amount of liquidity minted by the first deposit to
+// an empty pool: the standard amount for constant product pools, and the
+// invariant of the deposit for stableswap pools
+func GetInitialLiquidity(poolType types.PoolType, amplification uint64, standardAmt, tokenAmt sdkmath.Int) sdkmath.Int {
+	if poolType == types.POOL_TYPE_STABLESWAP {
+		return GetStableSwapInvariant(standardAmt, tokenAmt, amplification)
+	}
+	return standardAmt
+}
+
 // GetPoolBalances return the liquidity pool by the specified anotherCoinDenom
 func (k Keeper) GetPoolBalances(ctx sdk.Context, escrowAddress string) (coins sdk.Coins, err error) {
 	address, err := sdk.AccAddressFromBech32(escrowAddress)
@@ -97,21 +121,28 @@ func (k Keeper) GetLptDenomFromDenoms(ctx sdk.Context, denom1, denom2 string) (s
 		return "", types.ErrEqualDenom
 	}
 
+	pool, has := k.GetPool(ctx, types.GetPoolId(denom1, denom2))
+	if has {
+		return pool.LptDenom, nil
+	}
+
 	standardDenom, _ := k.GetStandardDenom(ctx)
 	if denom1 != standardDenom && denom2 != standardDenom {
-		return "", errorsmod.Wrap(types.ErrNotContainStandardDenom, fmt.Sprintf("standard denom: %s, denom1: %s, denom2: %s", standardDenom, denom1, denom2))
+		return "", errorsmod.Wrap(types.ErrNotContainStandardDenom, fmt.Sprintf("no pool of denom1: %s and denom2: %s, standard denom: %s", denom1, denom2, standardDenom))
 	}
 
 	counterpartyDenom := denom1
 	if counterpartyDenom == standardDenom {
 		counterpartyDenom = denom2
 	}
-	poolId := types.GetPoolId(counterpartyDenom)
-	pool, has := k.GetPool(ctx, poolId)
-	if !has {
-		return "", errorsmod.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", counterpartyDenom)
-	}
-	return pool.LptDenom, nil
+	return "", errorsmod.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", counterpartyDenom)
+}
+
+// hasPairPool returns true if a pool of the two denominations, none of which
+// is the standard denom, exists
+func (k Keeper) hasPairPool(ctx sdk.Context, denom1, denom2 string) bool {
+	_, has := k.GetPool(ctx, types.GetPoolId(denom1, denom2))
+	return has
 }
 
 // ValidatePool Verify the legitimacy of the liquidity pool
@@ -132,6 +163,37 @@ func (k Keeper) ValidatePool(ctx sdk.Context, lptDenom string) error {
 	return nil
 }
 
+// SetPoolStatus sets the operations allowed on the pool of the liquidity pool token
+func (k Keeper) SetPoolStatus(ctx sdk.Context, lptDenom string, status types.PoolStatus) error {
+	if err := types.ValidatePoolStatus(status); err != nil {
+		return err
+	}
+
+	pool, has := k.GetPoolByLptDenom(ctx, lptDenom)
+	if !has {
+		return errorsmod.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", lptDenom)
+	}
+
+	pool.Status = status
+	k.setPool(ctx, &pool)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetPoolStatus,
+			sdk.NewAttribute(types.AttributeValuePoolId, pool.Id),
+			sdk.NewAttribute(types.AttributeValueStatus, status.String()),
+		),
+	)
+	return nil
+}
+
+// IsSwapEnabled returns true if the pool of the liquidity pool token exists and
+// its status allows swaps
+func (k Keeper) IsSwapEnabled(ctx sdk.Context, lptDenom string) bool {
+	pool, has := k.GetPoolByLptDenom(ctx, lptDenom)
+	return has && pool.Status.SwapsEnabled()
+}
+
 func (k Keeper) setPool(ctx sdk.Context, pool *types.Pool) {
 	store := k.storeService.OpenKVStore(ctx)
 	bz := k.cdc.MustMarshal(pool)
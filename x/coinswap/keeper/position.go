@@ -0,0 +1,712 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
+)
+
+// CreatePosition deposits the coins providing the most liquidity between the ticks
+// of the msg into a concentrated liquidity pool, creating the pool at the initial
+// price of the msg if it does not exist
+func (k Keeper) CreatePosition(ctx sdk.Context, msg *types.MsgCreatePosition) (types.Position, sdk.Coins, error) {
+	standardDenom, err := k.GetStandardDenom(ctx)
+	if err != nil {
+		return types.Position{}, nil, err
+	}
+	if standardDenom == msg.MaxToken.Denom {
+		return types.Position{}, nil, errorsmod.Wrapf(types.ErrInvalidDenom,
+			"MaxToken: %s should not be StandardDenom", msg.MaxToken.String())
+	}
+
+	params := k.GetParams(ctx)
+	if !params.MaxSwapAmount.AmountOf(msg.MaxToken.Denom).IsPositive() {
+		return types.Position{}, nil, errorsmod.Wrapf(types.ErrInvalidDenom,
+			"MaxToken %s is not registered in max swap amount", msg.MaxToken.Denom)
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return types.Position{}, nil, err
+	}
+
+	pool, exists := k.GetPool(ctx, types.GetPoolId(standardDenom, msg.MaxToken.Denom))
+	if !exists {
+		if msg.InitialPrice == nil {
+			return types.Position{}, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "initial price is required to create the pool of %s", msg.MaxToken.Denom)
+		}
+		sqrtPrice, err := msg.InitialPrice.ApproxSqrt()
+		if err != nil {
+			return types.Position{}, nil, err
+		}
+		if sqrtPrice.LT(TickToSqrtPrice(types.MinTick)) || sqrtPrice.GT(TickToSqrtPrice(types.MaxTick)) {
+			return types.Position{}, nil, errorsmod.Wrapf(types.ErrInvalidTick, "initial price %s is out of the tick bounds", msg.InitialPrice)
+		}
+
+		// deduct the user's fee for creating a Liquidity pool
+		if err := k.DeductPoolCreationFee(ctx, sender); err != nil {
+			return types.Position{}, nil, err
+		}
+
+		pool = k.CreatePool(ctx, standardDenom, msg.MaxToken.Denom, types.POOL_TYPE_CONCENTRATED, 0)
+		k.SetConcentratedPoolState(ctx, types.ConcentratedPoolState{
+			PoolId:                  pool.Id,
+			SqrtPrice:               sqrtPrice,
+			CurrentTick:             SqrtPriceToTick(sqrtPrice),
+			Liquidity:               sdkmath.ZeroInt(),
+			FeeGrowthGlobalStandard: sdkmath.LegacyZeroDec(),
+			FeeGrowthGlobalToken:    sdkmath.LegacyZeroDec(),
+		})
+	} else if pool.PoolType != types.POOL_TYPE_CONCENTRATED {
+		return types.Position{}, nil, errorsmod.Wrapf(types.ErrInvalidPoolType, "pool %s is not a concentrated liquidity pool", pool.LptDenom)
+	}
+
+	position := types.Position{
+		Id:                          k.getPositionSequence(ctx),
+		Owner:                       msg.Sender,
+		LptDenom:                    pool.LptDenom,
+		LowerTick:                   msg.LowerTick,
+		UpperTick:                   msg.UpperTick,
+		Liquidity:                   sdkmath.ZeroInt(),
+		FeeGrowthInsideStandardLast: sdkmath.LegacyZeroDec(),
+		FeeGrowthInsideTokenLast:    sdkmath.LegacyZeroDec(),
+	}
+	liquidity, amounts, err := k.increasePosition(ctx, pool, &position, sender, msg.MaxToken.Amount, msg.MaxStandardAmt, msg.MinLiquidity)
+	if err != nil {
+		return types.Position{}, nil, err
+	}
+	k.SetPosition(ctx, position)
+	k.setPositionSequence(ctx, position.Id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreatePosition,
+			sdk.NewAttribute(types.AttributeValuePositionId, fmt.Sprintf("%d", position.Id)),
+			sdk.NewAttribute(types.AttributeValueOwner, position.Owner),
+			sdk.NewAttribute(types.AttributeValueTokenPair, pool.LptDenom),
+			sdk.NewAttribute(types.AttributeValueLiquidity, liquidity.String()),
+			sdk.NewAttribute(types.AttributeValueAmount, amounts.String()),
+		),
+	)
+	return position, amounts, nil
+}
+
+// IncreasePosition deposits the coins providing the most liquidity to the position
+// of the sender within the max amounts of the msg
+func (k Keeper) IncreasePosition(ctx sdk.Context, msg *types.MsgIncreasePosition) (sdkmath.Int, sdk.Coins, error) {
+	position, pool, err := k.getOwnedPosition(ctx, msg.PositionId, msg.Sender)
+	if err != nil {
+		return sdkmath.ZeroInt(), nil, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkmath.ZeroInt(), nil, err
+	}
+
+	liquidity, amounts, err := k.increasePosition(ctx, pool, &position, sender, msg.MaxTokenAmt, msg.MaxStandardAmt, msg.MinLiquidity)
+	if err != nil {
+		return sdkmath.ZeroInt(), nil, err
+	}
+	k.SetPosition(ctx, position)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIncreasePosition,
+			sdk.NewAttribute(types.AttributeValuePositionId, fmt.Sprintf("%d", position.Id)),
+			sdk.NewAttribute(types.AttributeValueOwner, position.Owner),
+			sdk.NewAttribute(types.AttributeValueLiquidity, liquidity.String()),
+			sdk.NewAttribute(types.AttributeValueAmount, amounts.String()),
+		),
+	)
+	return liquidity, amounts, nil
+}
+
+// DecreasePosition removes liquidity from the position of the sender and sends the
+// coins it held to the sender. Liquidity can be removed whatever the pool status
+func (k Keeper) DecreasePosition(ctx sdk.Context, msg *types.MsgDecreasePosition) (sdk.Coins, error) {
+	position, pool, err := k.getOwnedPosition(ctx, msg.PositionId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Liquidity.GT(position.Liquidity) {
+		return nil, errorsmod.Wrap(types.ErrInsufficientFunds, fmt.Sprintf("insufficient liquidity of position %d, user expected: %s, actual: %s", position.Id, msg.Liquidity.String(), position.Liquidity.String()))
+	}
+
+	state, found := k.GetConcentratedPoolState(ctx, pool.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrReservePoolNotExists, "concentrated pool state: %s", pool.Id)
+	}
+
+	tokenAmt, standardAmt := GetAmountsForLiquidity(state.SqrtPrice, TickToSqrtPrice(position.LowerTick), TickToSqrtPrice(position.UpperTick), msg.Liquidity, false)
+	if standardAmt.LT(msg.MinStandardAmt) {
+		return nil, errorsmod.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("standard coin amount not met, user expected: no less than %s, actual: %s", msg.MinStandardAmt.String(), standardAmt.String()))
+	}
+	if tokenAmt.LT(msg.MinTokenAmt) {
+		return nil, errorsmod.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("token amount not met, user expected: no less than %s, actual: %s", msg.MinTokenAmt.String(), tokenAmt.String()))
+	}
+
+	if err := k.updateTwap(ctx, pool.LptDenom); err != nil {
+		return nil, err
+	}
+	k.updatePosition(ctx, pool, &state, &position, msg.Liquidity.Neg())
+
+	amounts := sdk.NewCoins(sdk.NewCoin(pool.StandardDenom, standardAmt), sdk.NewCoin(pool.CounterpartyDenom, tokenAmt))
+	if err := k.sendFromPosition(ctx, pool, position, amounts); err != nil {
+		return nil, err
+	}
+	k.setOrDeletePosition(ctx, position)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDecreasePosition,
+			sdk.NewAttribute(types.AttributeValuePositionId, fmt.Sprintf("%d", position.Id)),
+			sdk.NewAttribute(types.AttributeValueOwner, position.Owner),
+			sdk.NewAttribute(types.AttributeValueLiquidity, msg.Liquidity.String()),
+			sdk.NewAttribute(types.AttributeValueAmount, amounts.String()),
+		),
+	)
+	return amounts, nil
+}
+
+// CollectFees sends the swap fees earned by the position of the sender to the sender
+func (k Keeper) CollectFees(ctx sdk.Context, msg *types.MsgCollectFees) (sdk.Coins, error) {
+	position, pool, err := k.getOwnedPosition(ctx, msg.PositionId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if position.Liquidity.IsPositive() {
+		state, found := k.GetConcentratedPoolState(ctx, pool.Id)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrReservePoolNotExists, "concentrated pool state: %s", pool.Id)
+		}
+		k.updatePosition(ctx, pool, &state, &position, sdkmath.ZeroInt())
+	}
+
+	fees := position.TokensOwed
+	position.TokensOwed = nil
+	if err := k.sendFromPosition(ctx, pool, position, fees); err != nil {
+		return nil, err
+	}
+	k.setOrDeletePosition(ctx, position)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCollectFees,
+			sdk.NewAttribute(types.AttributeValuePositionId, fmt.Sprintf("%d", position.Id)),
+			sdk.NewAttribute(types.AttributeValueOwner, position.Owner),
+			sdk.NewAttribute(types.AttributeValueAmount, fees.String()),
+		),
+	)
+	return fees, nil
+}
+
+// GetPositionFees returns the swap fees earned by the position and not collected
+// yet, including the fees not accrued to the position yet
+func (k Keeper) GetPositionFees(ctx sdk.Context, position types.Position) (sdk.Coins, error) {
+	if !position.Liquidity.IsPositive() {
+		return position.TokensOwed, nil
+	}
+
+	pool, has := k.GetPoolByLptDenom(ctx, position.LptDenom)
+	if !has {
+		return nil, errorsmod.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", position.LptDenom)
+	}
+	state, found := k.GetConcentratedPoolState(ctx, pool.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrReservePoolNotExists, "concentrated pool state: %s", pool.Id)
+	}
+
+	lower, _ := k.GetTick(ctx, pool.Id, position.LowerTick)
+	upper, _ := k.GetTick(ctx, pool.Id, position.UpperTick)
+	accrueFees(pool, state, lower, upper, &position)
+	return position.TokensOwed, nil
+}
+
+// increasePosition deposits the coins providing the most liquidity to the position
+// within the max amounts, and returns the liquidity added and the coins deposited
+func (k Keeper) increasePosition(
+	ctx sdk.Context,
+	pool types.Pool,
+	position *types.Position,
+	sender sdk.AccAddress,
+	maxTokenAmt, maxStandardAmt, minLiquidity sdkmath.Int,
+) (sdkmath.Int, sdk.Coins, error) {
+	if !pool.Status.DepositsEnabled() {
+		return sdkmath.ZeroInt(), nil, errorsmod.Wrapf(types.ErrPoolPaused, "deposits are paused on pool %s, status: %s", pool.LptDenom, pool.Status)
+	}
+
+	state, found := k.GetConcentratedPoolState(ctx, pool.Id)
+	if !found {
+		return sdkmath.ZeroInt(), nil, errorsmod.Wrapf(types.ErrReservePoolNotExists, "concentrated pool state: %s", pool.Id)
+	}
+
+	sqrtA, sqrtB := TickToSqrtPrice(position.LowerTick), TickToSqrtPrice(position.UpperTick)
+	liquidity := GetLiquidityForAmounts(state.SqrtPrice, sqrtA, sqrtB, maxTokenAmt, maxStandardAmt)
+	if !liquidity.IsPositive() || liquidity.LT(minLiquidity) {
+		return sdkmath.ZeroInt(), nil, errorsmod.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("liquidity amount not met, user expected: no less than %s, actual: %s", minLiquidity.String(), liquidity.String()))
+	}
+
+	tokenAmt, standardAmt := GetAmountsForLiquidity(state.SqrtPrice, sqrtA, sqrtB, liquidity, true)
+	if tokenAmt.GT(maxTokenAmt) {
+		return sdkmath.ZeroInt(), nil, errorsmod.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("token amount not met, user expected: no more than %s, actual: %s", maxTokenAmt.String(), tokenAmt.String()))
+	}
+	if standardAmt.GT(maxStandardAmt) {
+		return sdkmath.ZeroInt(), nil, errorsmod.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("standard coin amount not met, user expected: no more than %s, actual: %s", maxStandardAmt.String(), standardAmt.String()))
+	}
+
+	// the escrow account of a new pool is created by the first deposit
+	escrow := types.GetReservePoolAddr(pool.LptDenom)
+	balances := k.bk.GetAllBalances(ctx, escrow)
+	maxStandardCoinPerPool := k.GetParams(ctx).MaxStandardCoinPerPool
+	if balances.AmountOf(pool.StandardDenom).Add(standardAmt).GT(maxStandardCoinPerPool) {
+		return sdkmath.ZeroInt(), nil, errorsmod.Wrap(types.ErrMaxedStandardDenom, fmt.Sprintf("pool standard coin is maxed out: %s", maxStandardCoinPerPool.String()))
+	}
+
+	if err := k.updateTwap(ctx, pool.LptDenom); err != nil {
+		return sdkmath.ZeroInt(), nil, err
+	}
+
+	amounts := sdk.NewCoins(sdk.NewCoin(pool.StandardDenom, standardAmt), sdk.NewCoin(pool.CounterpartyDenom, tokenAmt))
+	if err := k.bk.SendCoins(ctx, sender, escrow, amounts); err != nil {
+		return sdkmath.ZeroInt(), nil, err
+	}
+
+	k.updatePosition(ctx, pool, &state, position, liquidity)
+	return liquidity, amounts, nil
+}
+
+// updatePosition accrues the fees earned by the position, then adds liquidityDelta
+// to the liquidity of the position, of its ticks and of the pool if the position
+// is in range
+func (k Keeper) updatePosition(ctx sdk.Context, pool types.Pool, state *types.ConcentratedPoolState, position *types.Position, liquidityDelta sdkmath.Int) {
+	lower := k.updateTick(ctx, *state, position.LowerTick, liquidityDelta, false)
+	upper := k.updateTick(ctx, *state, position.UpperTick, liquidityDelta, true)
+	accrueFees(pool, *state, lower, upper, position)
+
+	position.Liquidity = position.Liquidity.Add(liquidityDelta)
+	if position.LowerTick <= state.CurrentTick && state.CurrentTick < position.UpperTick {
+		state.Liquidity = state.Liquidity.Add(liquidityDelta)
+	}
+	k.SetConcentratedPoolState(ctx, *state)
+
+	for _, tick := range []types.Tick{lower, upper} {
+		if tick.LiquidityGross.IsPositive() {
+			k.SetTick(ctx, tick)
+		} else {
+			k.deleteTick(ctx, tick)
+		}
+	}
+}
+
+// updateTick returns the tick of the pool at index, initialized if needed, with
+// liquidityDelta added to its liquidity as the lower or upper tick of a position
+func (k Keeper) updateTick(ctx sdk.Context, state types.ConcentratedPoolState, index int64, liquidityDelta sdkmath.Int, upper bool) types.Tick {
+	tick, found := k.GetTick(ctx, state.PoolId, index)
+	if !found {
+		// the fees earned so far are considered earned below the tick
+		tick = types.Tick{
+			PoolId:                   state.PoolId,
+			Index:                    index,
+			LiquidityGross:           sdkmath.ZeroInt(),
+			LiquidityNet:             sdkmath.ZeroInt(),
+			FeeGrowthOutsideStandard: sdkmath.LegacyZeroDec(),
+			FeeGrowthOutsideToken:    sdkmath.LegacyZeroDec(),
+		}
+		if index <= state.CurrentTick {
+			tick.FeeGrowthOutsideStandard = state.FeeGrowthGlobalStandard
+			tick.FeeGrowthOutsideToken = state.FeeGrowthGlobalToken
+		}
+	}
+
+	tick.LiquidityGross = tick.LiquidityGross.Add(liquidityDelta)
+	if upper {
+		tick.LiquidityNet = tick.LiquidityNet.Sub(liquidityDelta)
+	} else {
+		tick.LiquidityNet = tick.LiquidityNet.Add(liquidityDelta)
+	}
+	return tick
+}
+
+// accrueFees adds to the fees owed to the position the fees earned inside its ticks
+// since they were last accrued
+func accrueFees(pool types.Pool, state types.ConcentratedPoolState, lower, upper types.Tick, position *types.Position) {
+	feeGrowthInside := func(global, lowerOutside, upperOutside sdkmath.LegacyDec) sdkmath.LegacyDec {
+		below := lowerOutside
+		if state.CurrentTick < lower.Index {
+			below = global.Sub(lowerOutside)
+		}
+		above := upperOutside
+		if state.CurrentTick >= upper.Index {
+			above = global.Sub(upperOutside)
+		}
+		return global.Sub(below).Sub(above)
+	}
+	standardInside := feeGrowthInside(state.FeeGrowthGlobalStandard, lower.FeeGrowthOutsideStandard, upper.FeeGrowthOutsideStandard)
+	tokenInside := feeGrowthInside(state.FeeGrowthGlobalToken, lower.FeeGrowthOutsideToken, upper.FeeGrowthOutsideToken)
+
+	if position.Liquidity.IsPositive() {
+		liquidity := sdkmath.LegacyNewDecFromInt(position.Liquidity)
+		position.TokensOwed = position.TokensOwed.Add(sdk.NewCoins(
+			sdk.NewCoin(pool.StandardDenom, standardInside.Sub(position.FeeGrowthInsideStandardLast).MulTruncate(liquidity).TruncateInt()),
+			sdk.NewCoin(pool.CounterpartyDenom, tokenInside.Sub(position.FeeGrowthInsideTokenLast).MulTruncate(liquidity).TruncateInt()),
+		)...)
+	}
+	position.FeeGrowthInsideStandardLast = standardInside
+	position.FeeGrowthInsideTokenLast = tokenInside
+}
+
+// getOwnedPosition returns the position of the given id and its pool, or an error
+// if the position is not owned by sender
+func (k Keeper) getOwnedPosition(ctx sdk.Context, positionId uint64, sender string) (types.Position, types.Pool, error) {
+	position, found := k.GetPosition(ctx, positionId)
+	if !found {
+		return types.Position{}, types.Pool{}, errorsmod.Wrapf(types.ErrPositionNotFound, "position id: %d", positionId)
+	}
+	if position.Owner != sender {
+		return types.Position{}, types.Pool{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "position %d is not owned by %s", position.Id, sender)
+	}
+
+	pool, has := k.GetPoolByLptDenom(ctx, position.LptDenom)
+	if !has {
+		return types.Position{}, types.Pool{}, errorsmod.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", position.LptDenom)
+	}
+	return position, pool, nil
+}
+
+// sendFromPosition sends the coins from the reserve pool to the owner of the position
+func (k Keeper) sendFromPosition(ctx sdk.Context, pool types.Pool, position types.Position, coins sdk.Coins) error {
+	if coins.IsZero() {
+		return nil
+	}
+	owner, err := sdk.AccAddressFromBech32(position.Owner)
+	if err != nil {
+		return err
+	}
+	return k.bk.SendCoins(ctx, types.GetReservePoolAddr(pool.LptDenom), owner, coins)
+}
+
+// setOrDeletePosition stores the position, or deletes it once it has neither
+// liquidity nor fees owed
+func (k Keeper) setOrDeletePosition(ctx sdk.Context, position types.Position) {
+	if position.Liquidity.IsZero() && position.TokensOwed.IsZero() {
+		k.deletePosition(ctx, position)
+		return
+	}
+	k.SetPosition(ctx, position)
+}
+
+// concentratedSwapResult defines the outcome of a swap simulated on a concentrated
+// liquidity pool
+type concentratedSwapResult struct {
+	// amount sold, fee included
+	amountIn sdkmath.Int
+	// amount bought
+	amountOut sdkmath.Int
+	// pricing state of the pool after the swap
+	state types.ConcentratedPoolState
+	// ticks crossed by the swap
+	crossedTicks []types.Tick
+}
+
+// simulateConcentratedSwap simulates selling the amount of tokenInDenom to the pool
+// if exactIn is set, buying the amount of the other coin otherwise. The liquidity
+// between consecutive initialized ticks is consumed until the amount is swapped
+func (k Keeper) simulateConcentratedSwap(ctx sdk.Context, pool types.Pool, tokenInDenom string, amount sdkmath.Int, exactIn bool) (concentratedSwapResult, error) {
+	state, found := k.GetConcentratedPoolState(ctx, pool.Id)
+	if !found {
+		return concentratedSwapResult{}, errorsmod.Wrapf(types.ErrReservePoolNotExists, "concentrated pool state: %s", pool.Id)
+	}
+
+	fee := k.GetPoolFee(ctx, pool.LptDenom)
+	lpFeeShare := sdkmath.LegacyOneDec().Sub(k.GetParams(ctx).SwapProtocolFeeShare)
+	sellToken := tokenInDenom == pool.CounterpartyDenom
+
+	result := concentratedSwapResult{amountIn: sdkmath.ZeroInt(), amountOut: sdkmath.ZeroInt()}
+	remaining := amount
+	for remaining.IsPositive() {
+		tick, found := k.getNextInitializedTick(ctx, pool.Id, state.CurrentTick, sellToken)
+		sqrtTarget := TickToSqrtPrice(types.MaxTick)
+		switch {
+		case found:
+			sqrtTarget = TickToSqrtPrice(tick.Index)
+		case sellToken:
+			sqrtTarget = TickToSqrtPrice(types.MinTick)
+		}
+		if !found && state.SqrtPrice.Equal(sqrtTarget) {
+			return concentratedSwapResult{}, errorsmod.Wrapf(types.ErrInsufficientFunds, "insufficient liquidity in pool %s", pool.LptDenom)
+		}
+
+		sqrtNext, amountIn, amountOut, feeAmt := computeConcentratedSwapStep(state.SqrtPrice, sqrtTarget, state.Liquidity, remaining, fee, sellToken, exactIn)
+		if exactIn {
+			remaining = remaining.Sub(amountIn).Sub(feeAmt)
+		} else {
+			remaining = remaining.Sub(amountOut)
+		}
+		result.amountIn = result.amountIn.Add(amountIn).Add(feeAmt)
+		result.amountOut = result.amountOut.Add(amountOut)
+
+		// the fee net of the protocol share is earned by the liquidity in range
+		if state.Liquidity.IsPositive() {
+			feeGrowth := sdkmath.LegacyNewDecFromInt(feeAmt).MulTruncate(lpFeeShare).QuoTruncate(sdkmath.LegacyNewDecFromInt(state.Liquidity))
+			if sellToken {
+				state.FeeGrowthGlobalToken = state.FeeGrowthGlobalToken.Add(feeGrowth)
+			} else {
+				state.FeeGrowthGlobalStandard = state.FeeGrowthGlobalStandard.Add(feeGrowth)
+			}
+		}
+
+		state.SqrtPrice = sqrtNext
+		if !sqrtNext.Equal(sqrtTarget) {
+			// the amount is swapped before the next initialized tick
+			state.CurrentTick = SqrtPriceToTick(sqrtNext)
+			break
+		}
+		if !found {
+			// the price reached the tick bounds
+			state.CurrentTick = SqrtPriceToTick(sqrtNext)
+			continue
+		}
+
+		// cross the tick, the fees outside of it are now on the other side
+		tick.FeeGrowthOutsideStandard = state.FeeGrowthGlobalStandard.Sub(tick.FeeGrowthOutsideStandard)
+		tick.FeeGrowthOutsideToken = state.FeeGrowthGlobalToken.Sub(tick.FeeGrowthOutsideToken)
+		if sellToken {
+			state.Liquidity = state.Liquidity.Sub(tick.LiquidityNet)
+			state.CurrentTick = tick.Index - 1
+		} else {
+			state.Liquidity = state.Liquidity.Add(tick.LiquidityNet)
+			state.CurrentTick = tick.Index
+		}
+		result.crossedTicks = append(result.crossedTicks, tick)
+	}
+
+	if !exactIn && remaining.IsPositive() {
+		return concentratedSwapResult{}, errorsmod.Wrapf(types.ErrInsufficientFunds, "insufficient liquidity in pool %s", pool.LptDenom)
+	}
+	result.state = state
+	return result, nil
+}
+
+// simulateConcentratedTrade simulates the swap of coinSold for coinBought on the
+// pool, selling exactly coinSold for at least coinBought if exactIn is set,
+// buying exactly coinBought for at most coinSold otherwise
+func (k Keeper) simulateConcentratedTrade(ctx sdk.Context, pool types.Pool, coinSold, coinBought sdk.Coin, exactIn bool) (concentratedSwapResult, error) {
+	if exactIn {
+		result, err := k.simulateConcentratedSwap(ctx, pool, coinSold.Denom, coinSold.Amount, true)
+		if err != nil {
+			return concentratedSwapResult{}, err
+		}
+		if result.amountOut.LT(coinBought.Amount) {
+			return concentratedSwapResult{}, errorsmod.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("insufficient amount of %s, user expected: %s, actual: %s", coinBought.Denom, coinBought.Amount.String(), result.amountOut.String()))
+		}
+		return result, nil
+	}
+
+	result, err := k.simulateConcentratedSwap(ctx, pool, coinSold.Denom, coinBought.Amount, false)
+	if err != nil {
+		return concentratedSwapResult{}, err
+	}
+	if result.amountIn.GT(coinSold.Amount) {
+		return concentratedSwapResult{}, errorsmod.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("insufficient amount of %s, user expected: %s, actual: %s", coinSold.Denom, coinSold.Amount.String(), result.amountIn.String()))
+	}
+	return result, nil
+}
+
+// applyConcentratedSwap stores the pricing state and the crossed ticks of the
+// pool after the swap of coinSold for coinBought in the direction of the trade
+func (k Keeper) applyConcentratedSwap(ctx sdk.Context, pool types.Pool, coinSold, coinBought sdk.Coin, exactIn bool) error {
+	result, err := k.simulateConcentratedTrade(ctx, pool, coinSold, coinBought, exactIn)
+	if err != nil {
+		return err
+	}
+
+	k.SetConcentratedPoolState(ctx, result.state)
+	for _, tick := range result.crossedTicks {
+		k.SetTick(ctx, tick)
+	}
+	return nil
+}
+
+// getConcentratedSpotPrice returns the current price of the counterparty coin in
+// the standard coin of a concentrated liquidity pool
+func (k Keeper) getConcentratedSpotPrice(ctx sdk.Context, pool types.Pool) (sdkmath.LegacyDec, error) {
+	state, found := k.GetConcentratedPoolState(ctx, pool.Id)
+	if !found {
+		return sdkmath.LegacyZeroDec(), errorsmod.Wrapf(types.ErrReservePoolNotExists, "concentrated pool state: %s", pool.Id)
+	}
+	return state.SqrtPrice.Mul(state.SqrtPrice), nil
+}
+
+// getNextInitializedTick returns the greatest initialized tick of the pool lower
+// than or equal to the current tick if lte is set, the lowest initialized tick
+// greater than the current tick otherwise
+func (k Keeper) getNextInitializedTick(ctx sdk.Context, poolId string, currentTick int64, lte bool) (types.Tick, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix := types.GetTickPrefix(poolId)
+
+	var iterator storetypes.Iterator
+	if lte {
+		iterator = store.ReverseIterator(prefix, types.GetTickKey(poolId, currentTick+1))
+	} else {
+		iterator = store.Iterator(types.GetTickKey(poolId, currentTick+1), storetypes.PrefixEndBytes(prefix))
+	}
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.Tick{}, false
+	}
+
+	var tick types.Tick
+	k.cdc.MustUnmarshal(iterator.Value(), &tick)
+	return tick, true
+}
+
+// GetConcentratedPoolState returns the pricing state of the concentrated liquidity pool
+func (k Keeper) GetConcentratedPoolState(ctx sdk.Context, poolId string) (types.ConcentratedPoolState, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get(types.GetConcentratedPoolStateKey(poolId))
+	if bz == nil {
+		return types.ConcentratedPoolState{}, false
+	}
+
+	var state types.ConcentratedPoolState
+	k.cdc.MustUnmarshal(bz, &state)
+	return state, true
+}
+
+// SetConcentratedPoolState stores the pricing state of a concentrated liquidity pool
+func (k Keeper) SetConcentratedPoolState(ctx sdk.Context, state types.ConcentratedPoolState) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&state)
+	store.Set(types.GetConcentratedPoolStateKey(state.PoolId), bz)
+}
+
+// GetAllConcentratedPoolStates returns the pricing states of all the concentrated liquidity pools
+func (k Keeper) GetAllConcentratedPoolStates(ctx sdk.Context) (states []types.ConcentratedPoolState) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyConcentratedPoolState+"/"))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var state types.ConcentratedPoolState
+		k.cdc.MustUnmarshal(iterator.Value(), &state)
+		states = append(states, state)
+	}
+	return
+}
+
+// GetTick returns the initialized tick of the pool at index
+func (k Keeper) GetTick(ctx sdk.Context, poolId string, index int64) (types.Tick, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get(types.GetTickKey(poolId, index))
+	if bz == nil {
+		return types.Tick{}, false
+	}
+
+	var tick types.Tick
+	k.cdc.MustUnmarshal(bz, &tick)
+	return tick, true
+}
+
+// SetTick stores the initialized tick
+func (k Keeper) SetTick(ctx sdk.Context, tick types.Tick) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&tick)
+	store.Set(types.GetTickKey(tick.PoolId, tick.Index), bz)
+}
+
+// deleteTick deletes the tick once no position is bounded by it
+func (k Keeper) deleteTick(ctx sdk.Context, tick types.Tick) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetTickKey(tick.PoolId, tick.Index)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllTicks returns the initialized ticks of all the concentrated liquidity pools
+func (k Keeper) GetAllTicks(ctx sdk.Context) (ticks []types.Tick) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyTick+"/"))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var tick types.Tick
+		k.cdc.MustUnmarshal(iterator.Value(), &tick)
+		ticks = append(ticks, tick)
+	}
+	return
+}
+
+// GetPosition returns the concentrated liquidity position of the given id
+func (k Keeper) GetPosition(ctx sdk.Context, positionId uint64) (types.Position, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get(types.GetPositionKey(positionId))
+	if bz == nil {
+		return types.Position{}, false
+	}
+
+	var position types.Position
+	k.cdc.MustUnmarshal(bz, &position)
+	return position, true
+}
+
+// SetPosition stores the position and indexes it by owner and pool
+func (k Keeper) SetPosition(ctx sdk.Context, position types.Position) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&position)
+	store.Set(types.GetPositionKey(position.Id), bz)
+
+	id := sdk.Uint64ToBigEndian(position.Id)
+	store.Set(types.GetPositionByOwnerKey(position.Owner, position.Id), id)
+	store.Set(types.GetPositionByPoolKey(position.LptDenom, position.Id), id)
+}
+
+// deletePosition deletes the position and its indexes
+func (k Keeper) deletePosition(ctx sdk.Context, position types.Position) {
+	store := k.storeService.OpenKVStore(ctx)
+	for _, key := range [][]byte{
+		types.GetPositionKey(position.Id),
+		types.GetPositionByOwnerKey(position.Owner, position.Id),
+		types.GetPositionByPoolKey(position.LptDenom, position.Id),
+	} {
+		if err := store.Delete(key); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// GetAllPositions returns all the concentrated liquidity positions
+func (k Keeper) GetAllPositions(ctx sdk.Context) (positions []types.Position) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyPosition+"/"))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var position types.Position
+		k.cdc.MustUnmarshal(iterator.Value(), &position)
+		positions = append(positions, position)
+	}
+	return
+}
+
+// getPositionSequence gets the id of the next position from the store.
+func (k Keeper) getPositionSequence(ctx sdk.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get([]byte(types.KeyNextPositionSequence))
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setPositionSequence sets the id of the next position to the store.
+func (k Keeper) setPositionSequence(ctx sdk.Context, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := sdk.Uint64ToBigEndian(sequence)
+	store.Set([]byte(types.KeyNextPositionSequence), bz)
+}
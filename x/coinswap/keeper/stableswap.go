@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// stableswapIterations bounds the newton iterations used to solve the
+// stableswap invariant
+const stableswapIterations = 255
+
+// GetStableSwapInputPrice returns the amount of coins bought (calculated) given the input amount being sold (exact)
+// on a stableswap pool with the amplification amp. The fee is included in the input coins being sold
+func GetStableSwapInputPrice(inputAmt, inputReserve, outputReserve sdkmath.Int, fee sdkmath.LegacyDec, amp uint64) sdkmath.Int {
+	d := GetStableSwapInvariant(inputReserve, outputReserve, amp)
+	inputAmtWithFee := sdkmath.LegacyNewDecFromInt(inputAmt).Mul(sdkmath.LegacyOneDec().Sub(fee)).TruncateInt()
+	y := getStableSwapY(inputReserve.Add(inputAmtWithFee), d, amp)
+
+	// round down the amount bought in favor of the pool
+	outputAmt := outputReserve.Sub(y).Sub(sdkmath.OneInt())
+	if outputAmt.IsNegative() {
+		return sdkmath.ZeroInt()
+	}
+	return outputAmt
+}
+
+// GetStableSwapOutputPrice returns the amount of coins sold (calculated) given the output amount being bought (exact)
+// on a stableswap pool with the amplification amp. The fee is included in the input coins being sold
+func GetStableSwapOutputPrice(outputAmt, inputReserve, outputReserve sdkmath.Int, fee sdkmath.LegacyDec, amp uint64) sdkmath.Int {
+	d := GetStableSwapInvariant(inputReserve, outputReserve, amp)
+	x := getStableSwapY(outputReserve.Sub(outputAmt), d, amp)
+
+	// round up the amount sold in favor of the pool
+	inputAmtWithFee := x.Sub(inputReserve).Add(sdkmath.OneInt())
+	return sdkmath.LegacyNewDecFromInt(inputAmtWithFee).Quo(sdkmath.LegacyOneDec().Sub(fee)).Ceil().TruncateInt()
+}
+
+// GetStableSwapInvariant returns the invariant D of a two coins stableswap pool, solving
+// Ann * (x + y) + D = Ann * D + D^3 / (4 * x * y), where Ann = amp * 2
+func GetStableSwapInvariant(x, y sdkmath.Int, amp uint64) sdkmath.Int {
+	if !x.IsPositive() || !y.IsPositive() {
+		return sdkmath.ZeroInt()
+	}
+
+	sum := x.Add(y)
+	ann := sdkmath.NewIntFromUint64(amp).MulRaw(2)
+	d := sum
+	for i := 0; i < stableswapIterations; i++ {
+		// dP = D^3 / (4 * x * y)
+		dP := d.Mul(d).Quo(x.MulRaw(2)).Mul(d).Quo(y.MulRaw(2))
+		prev := d
+		d = ann.Mul(sum).Add(dP.MulRaw(2)).Mul(d).
+			Quo(ann.SubRaw(1).Mul(d).Add(dP.MulRaw(3)))
+		if d.Sub(prev).Abs().LTE(sdkmath.OneInt()) {
+			break
+		}
+	}
+	return d
+}
+
+// getStableSwapY returns the reserve of one coin of a stableswap pool with the
+// invariant d, given the reserve x of the other coin
+func getStableSwapY(x, d sdkmath.Int, amp uint64) sdkmath.Int {
+	ann := sdkmath.NewIntFromUint64(amp).MulRaw(2)
+
+	// y^2 + (b - D) * y = c
+	c := d.Mul(d).Quo(x.MulRaw(2)).Mul(d).Quo(ann.MulRaw(2))
+	b := x.Add(d.Quo(ann))
+	y := d
+	for i := 0; i < stableswapIterations; i++ {
+		prev := y
+		y = y.Mul(y).Add(c).Quo(y.MulRaw(2).Add(b).Sub(d))
+		if y.Sub(prev).Abs().LTE(sdkmath.OneInt()) {
+			break
+		}
+	}
+	return y
+}
+
+// GetStableSwapSpotPrice returns the marginal price of the x coin in the y coin of a
+// stableswap pool, without fee and slippage
+func GetStableSwapSpotPrice(x, y sdkmath.Int, amp uint64) sdkmath.LegacyDec {
+	if !x.IsPositive() || !y.IsPositive() {
+		return sdkmath.LegacyZeroDec()
+	}
+
+	// price = (4 * Ann * x^2 * y^2 + D^3 * y) / (4 * Ann * x^2 * y^2 + D^3 * x),
+	// computed with big.Int as the products overflow sdkmath.Int
+	d := GetStableSwapInvariant(x, y, amp).BigInt()
+	d3 := new(big.Int).Exp(d, big.NewInt(3), nil)
+	xy := new(big.Int).Mul(x.BigInt(), y.BigInt())
+	term := new(big.Int).Mul(xy, xy)
+	term.Mul(term, new(big.Int).SetUint64(amp*8))
+
+	numerator := new(big.Int).Add(term, new(big.Int).Mul(d3, y.BigInt()))
+	denominator := new(big.Int).Add(term, new(big.Int).Mul(d3, x.BigInt()))
+
+	numerator.Mul(numerator, sdkmath.LegacyOneDec().BigInt())
+	return sdkmath.LegacyNewDecFromBigIntWithPrec(numerator.Quo(numerator, denominator), sdkmath.LegacyPrecision)
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Canto-Network/Canto/v8/x/coinswap/keeper"
+	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
+)
+
+func (suite *TestSuite) TestGetStableSwapInvariant() {
+	testCases := []struct {
+		name string
+		x    sdkmath.Int
+		y    sdkmath.Int
+		amp  uint64
+		expD sdkmath.Int
+	}{
+		{"balanced pool", sdkmath.NewInt(1_000_000), sdkmath.NewInt(1_000_000), 100, sdkmath.NewInt(2_000_000)},
+		{"empty reserve", sdkmath.ZeroInt(), sdkmath.NewInt(1_000_000), 100, sdkmath.ZeroInt()},
+	}
+	for _, tc := range testCases {
+		suite.Require().Equal(tc.expD, keeper.GetStableSwapInvariant(tc.x, tc.y, tc.amp), tc.name)
+	}
+
+	// the invariant is symmetric and lies between the constant product and constant sum invariants
+	x, y := sdkmath.NewInt(1_000_000), sdkmath.NewInt(3_000_000)
+	d := keeper.GetStableSwapInvariant(x, y, 100)
+	suite.Require().Equal(d, keeper.GetStableSwapInvariant(y, x, 100))
+	suite.Require().True(d.LT(x.Add(y)))
+	cpD, err := x.Mul(y).MulRaw(4).ToLegacyDec().ApproxSqrt()
+	suite.Require().NoError(err)
+	suite.Require().True(d.GT(cpD.TruncateInt()))
+}
+
+func (suite *TestSuite) TestGetStableSwapPrice() {
+	reserve := sdkmath.NewInt(10_000_000_000)
+	amt := sdkmath.NewInt(100_000_000)
+	fee := sdkmath.LegacyNewDecWithPrec(3, 3)
+
+	// the marginal price of a balanced pool is one
+	suite.Require().Equal(sdkmath.LegacyOneDec(), keeper.GetStableSwapSpotPrice(reserve, reserve, 100))
+
+	// slippage is lower than on a constant product pool
+	stableOut := keeper.GetStableSwapInputPrice(amt, reserve, reserve, fee, 100)
+	cpOut := keeper.GetInputPrice(amt, reserve, reserve, fee)
+	suite.Require().True(stableOut.GT(cpOut))
+	suite.Require().True(stableOut.LT(amt))
+
+	// buying back the output costs at least the input
+	stableIn := keeper.GetStableSwapOutputPrice(stableOut, reserve, reserve, fee, 100)
+	suite.Require().True(stableIn.GTE(amt))
+	suite.Require().True(stableIn.Sub(amt).LTE(sdkmath.NewInt(2)))
+
+	// a higher amplification flattens the curve
+	suite.Require().True(keeper.GetStableSwapInputPrice(amt, reserve, reserve, fee, 1000).GT(stableOut))
+}
+
+func (suite *TestSuite) TestStableSwapPool() {
+	sender, _ := createReservePool(suite, denomETH)
+
+	depositAmt := sdkmath.NewInt(10_000_000_000)
+	msg := types.NewMsgAddLiquidity(sdk.NewCoin(denomBTC, depositAmt), depositAmt, sdkmath.OneInt(), time.Now().Add(time.Minute).Unix(), sender.String())
+	msg.PoolType = types.POOL_TYPE_STABLESWAP
+	msg.Amplification = 100
+
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(denomBTC, depositAmt.MulRaw(2))))
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, sdk.NewCoins(sdk.NewCoin(denomBTC, depositAmt.MulRaw(2))))
+	suite.Require().NoError(err)
+
+	liquidity, err := suite.app.CoinswapKeeper.AddLiquidity(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(depositAmt.MulRaw(2), liquidity.Amount)
+
+	pool, has := suite.app.CoinswapKeeper.GetPool(suite.ctx, types.GetPoolId(denomStandard, denomBTC))
+	suite.Require().True(has)
+	suite.Require().Equal(types.POOL_TYPE_STABLESWAP, pool.PoolType)
+	suite.Require().Equal(uint64(100), pool.Amplification)
+
+	res, err := suite.app.CoinswapKeeper.LiquidityPool(suite.ctx, &types.QueryLiquidityPoolRequest{LptDenom: pool.LptDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.POOL_TYPE_STABLESWAP, res.Pool.PoolType)
+	suite.Require().Equal(uint64(100), res.Pool.Amplification)
+
+	// a swap on the stableswap pool returns more than on the constant product pool
+	input := types.Input{Address: sender.String(), Coin: sdk.NewInt64Coin(denomBTC, 5_000_000)}
+	output := types.Output{Address: sender.String(), Coin: sdk.NewInt64Coin(denomStandard, 0)}
+	stableOut, err := suite.app.CoinswapKeeper.TradeExactInputForOutput(suite.ctx, input, output)
+	suite.Require().NoError(err)
+
+	input.Coin = sdk.NewInt64Coin(denomETH, 5_000_000)
+	cpOut, err := suite.app.CoinswapKeeper.TradeExactInputForOutput(suite.ctx, input, output)
+	suite.Require().NoError(err)
+	suite.Require().True(stableOut.GT(cpOut))
+
+	// the amplification must be set for stableswap pools only
+	suite.Require().Error(types.ValidatePoolType(types.POOL_TYPE_STABLESWAP, 0))
+	suite.Require().Error(types.ValidatePoolType(types.POOL_TYPE_STABLESWAP, types.MaxAmplification+1))
+	suite.Require().Error(types.ValidatePoolType(types.POOL_TYPE_CONSTANT_PRODUCT, 100))
+	suite.Require().NoError(types.ValidatePoolType(types.POOL_TYPE_CONSTANT_PRODUCT, 0))
+}
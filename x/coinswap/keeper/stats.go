@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
+)
+
+// recordSwapStats adds the swap of coinSold for coinBought to the statistics of the
+// ongoing epoch of the pool, if the pool statistics are enabled
+func (k Keeper) recordSwapStats(ctx sdk.Context, lptDenom string, coinSold, coinBought sdk.Coin) error {
+	if k.GetParams(ctx).PoolStatsEpochIdentifier == "" {
+		return nil
+	}
+
+	pool, has := k.GetPoolByLptDenom(ctx, lptDenom)
+	if !has {
+		return errorsmod.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", lptDenom)
+	}
+
+	stats := k.GetCurrentPoolStats(ctx, pool.Id)
+	stats.SwapCount++
+	for _, coin := range []sdk.Coin{coinSold, coinBought} {
+		if coin.Denom == pool.CounterpartyDenom {
+			stats.TokenVolume = stats.TokenVolume.Add(coin.Amount)
+		} else {
+			stats.StandardVolume = stats.StandardVolume.Add(coin.Amount)
+		}
+	}
+
+	fee := sdk.NewCoin(coinSold.Denom, sdkmath.LegacyNewDecFromInt(coinSold.Amount).Mul(k.GetPoolFee(ctx, lptDenom)).TruncateInt())
+	if fee.IsPositive() {
+		stats.Fees = stats.Fees.Add(fee)
+	}
+
+	k.setCurrentPoolStats(ctx, stats)
+	return nil
+}
+
+// RollUpPoolStats closes the statistics of the ongoing epoch of every pool as the
+// statistics of the epoch epochNumber, and starts new ones
+func (k Keeper) RollUpPoolStats(ctx sdk.Context, epochNumber int64) {
+	store := k.storeService.OpenKVStore(ctx)
+	for _, pool := range k.GetAllPools(ctx) {
+		stats := k.GetCurrentPoolStats(ctx, pool.Id)
+		stats.EpochNumber = epochNumber
+		stats.Liquidity = k.bk.GetSupply(ctx, pool.LptDenom).Amount
+		k.SetPoolStats(ctx, stats)
+
+		if err := store.Delete(types.GetCurrentPoolStatsKey(pool.Id)); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// GetCurrentPoolStats returns the statistics of the ongoing epoch of the pool
+func (k Keeper) GetCurrentPoolStats(ctx sdk.Context, poolId string) types.PoolStats {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get(types.GetCurrentPoolStatsKey(poolId))
+	if bz == nil {
+		return types.PoolStats{
+			PoolId:         poolId,
+			StandardVolume: sdkmath.ZeroInt(),
+			TokenVolume:    sdkmath.ZeroInt(),
+			Liquidity:      sdkmath.ZeroInt(),
+		}
+	}
+
+	var stats types.PoolStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats
+}
+
+// GetAllCurrentPoolStats returns the statistics of the ongoing epoch of all the pools
+func (k Keeper) GetAllCurrentPoolStats(ctx sdk.Context) (stats []types.PoolStats) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyCurrentPoolStats))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var s types.PoolStats
+		k.cdc.MustUnmarshal(iterator.Value(), &s)
+		stats = append(stats, s)
+	}
+	return
+}
+
+func (k Keeper) setCurrentPoolStats(ctx sdk.Context, stats types.PoolStats) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&stats)
+	store.Set(types.GetCurrentPoolStatsKey(stats.PoolId), bz)
+}
+
+// GetPoolStats returns the statistics of an ended epoch of the pool
+func (k Keeper) GetPoolStats(ctx sdk.Context, poolId string, epochNumber int64) (types.PoolStats, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get(types.GetPoolStatsKey(poolId, epochNumber))
+	if bz == nil {
+		return types.PoolStats{}, false
+	}
+
+	var stats types.PoolStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats, true
+}
+
+// SetPoolStats stores the statistics of an ended epoch of the pool
+func (k Keeper) SetPoolStats(ctx sdk.Context, stats types.PoolStats) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&stats)
+	store.Set(types.GetPoolStatsKey(stats.PoolId, stats.EpochNumber), bz)
+}
+
+// GetAllPoolStats returns the statistics of the ended epochs of all the pools
+func (k Keeper) GetAllPoolStats(ctx sdk.Context) (stats []types.PoolStats) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyPoolStats+"/"))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var s types.PoolStats
+		k.cdc.MustUnmarshal(iterator.Value(), &s)
+		stats = append(stats, s)
+	}
+	return
+}
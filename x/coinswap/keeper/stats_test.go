@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
+	epochstypes "github.com/Canto-Network/Canto/v8/x/epochs/types"
+)
+
+func (suite *TestSuite) TestPoolStats() {
+	sender, _ := createReservePool(suite, denomBTC)
+	poolId := types.GetPoolId(denomStandard, denomBTC)
+
+	params := suite.app.CoinswapKeeper.GetParams(suite.ctx)
+	params.Fee = sdkmath.LegacyNewDecWithPrec(3, 3)
+	params.PoolStatsEpochIdentifier = epochstypes.DayEpochID
+	suite.app.CoinswapKeeper.SetParams(suite.ctx, params)
+
+	// sell 1_000_000 btc, then buy 1_000_000 btc
+	input := types.Input{Address: sender.String(), Coin: sdk.NewInt64Coin(denomBTC, 1_000_000)}
+	output := types.Output{Address: sender.String(), Coin: sdk.NewInt64Coin(denomStandard, 0)}
+	bought, err := suite.app.CoinswapKeeper.TradeExactInputForOutput(suite.ctx, input, output)
+	suite.Require().NoError(err)
+
+	input = types.Input{Address: sender.String(), Coin: sdk.NewInt64Coin(denomStandard, 2_000_000)}
+	output = types.Output{Address: sender.String(), Coin: sdk.NewInt64Coin(denomBTC, 1_000_000)}
+	sold, err := suite.app.CoinswapKeeper.TradeInputForExactOutput(suite.ctx, input, output)
+	suite.Require().NoError(err)
+
+	stats := suite.app.CoinswapKeeper.GetCurrentPoolStats(suite.ctx, poolId)
+	suite.Require().Equal(uint64(2), stats.SwapCount)
+	suite.Require().Equal(bought.Add(sold), stats.StandardVolume)
+	suite.Require().Equal(sdkmath.NewInt(2_000_000), stats.TokenVolume)
+	suite.Require().Equal(sdk.NewCoins(
+		sdk.NewInt64Coin(denomBTC, 3_000),
+		sdk.NewCoin(denomStandard, sdkmath.LegacyNewDecFromInt(sold).Mul(params.Fee).TruncateInt()),
+	), stats.Fees)
+
+	// other epochs are ignored
+	suite.app.CoinswapKeeper.Hooks().AfterEpochEnd(suite.ctx, epochstypes.WeekEpochID, 1)
+	suite.Require().Empty(suite.app.CoinswapKeeper.GetAllPoolStats(suite.ctx))
+
+	suite.app.CoinswapKeeper.Hooks().AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 1)
+	suite.Require().Empty(suite.app.CoinswapKeeper.GetAllCurrentPoolStats(suite.ctx))
+	stats.EpochNumber = 1
+	stats.Liquidity = suite.app.BankKeeper.GetSupply(suite.ctx, "lpt-1").Amount
+	rolledUp, found := suite.app.CoinswapKeeper.GetPoolStats(suite.ctx, poolId, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(stats, rolledUp)
+
+	// an epoch without swaps still records the liquidity
+	suite.app.CoinswapKeeper.Hooks().AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 2)
+
+	res, err := suite.app.CoinswapKeeper.PoolStats(suite.ctx, &types.QueryPoolStatsRequest{LptDenom: "lpt-1"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Stats, 2)
+	suite.Require().Equal(stats, res.Stats[0])
+	suite.Require().Equal(int64(2), res.Stats[1].EpochNumber)
+	suite.Require().Zero(res.Stats[1].SwapCount)
+	suite.Require().Equal(stats.Liquidity, res.Stats[1].Liquidity)
+
+	res, err = suite.app.CoinswapKeeper.PoolStats(suite.ctx, &types.QueryPoolStatsRequest{LptDenom: "lpt-1", Pagination: &query.PageRequest{Limit: 1, Reverse: true}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Stats, 1)
+	suite.Require().Equal(int64(2), res.Stats[0].EpochNumber)
+
+	_, err = suite.app.CoinswapKeeper.PoolStats(suite.ctx, &types.QueryPoolStatsRequest{LptDenom: "lpt-2"})
+	suite.Require().ErrorIs(err, types.ErrReservePoolNotExists)
+
+	allRes, err := suite.app.CoinswapKeeper.AllPoolStats(suite.ctx, &types.QueryAllPoolStatsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(allRes.Stats, 2)
+
+	// disabled statistics are neither recorded nor rolled up
+	params.PoolStatsEpochIdentifier = ""
+	suite.app.CoinswapKeeper.SetParams(suite.ctx, params)
+	_, err = suite.app.CoinswapKeeper.TradeExactInputForOutput(suite.ctx,
+		types.Input{Address: sender.String(), Coin: sdk.NewInt64Coin(denomBTC, 1_000_000)},
+		types.Output{Address: sender.String(), Coin: sdk.NewInt64Coin(denomStandard, 0)},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.app.CoinswapKeeper.GetAllCurrentPoolStats(suite.ctx))
+	suite.app.CoinswapKeeper.Hooks().AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 3)
+	suite.Require().Len(suite.app.CoinswapKeeper.GetAllPoolStats(suite.ctx), 2)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -10,12 +11,37 @@ import (
 	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
 )
 
-func (k Keeper) swapCoins(ctx sdk.Context, sender, recipient sdk.AccAddress, coinSold, coinBought sdk.Coin) error {
+// swapCoins swaps coinSold of sender for coinBought of recipient, exactIn being set
+// if the amount of coinSold is the exact one of the trade, the amount of coinBought otherwise
+func (k Keeper) swapCoins(ctx sdk.Context, sender, recipient sdk.AccAddress, coinSold, coinBought sdk.Coin, exactIn bool) error {
 	lptDenom, err := k.GetLptDenomFromDenoms(ctx, coinSold.Denom, coinBought.Denom)
 	if err != nil {
 		return err
 	}
 
+	pool, has := k.GetPoolByLptDenom(ctx, lptDenom)
+	if !has {
+		return errorsmod.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", lptDenom)
+	}
+	if !pool.Status.SwapsEnabled() {
+		return errorsmod.Wrapf(types.ErrPoolPaused, "swaps are paused on pool %s, status: %s", lptDenom, pool.Status)
+	}
+
+	priceReference, err := k.checkPriceDeviation(ctx, pool, coinSold, coinBought, exactIn)
+	if err != nil {
+		return err
+	}
+
+	if err := k.updateTwap(ctx, lptDenom); err != nil {
+		return err
+	}
+
+	if pool.PoolType == types.POOL_TYPE_CONCENTRATED {
+		if err := k.applyConcentratedSwap(ctx, pool, coinSold, coinBought, exactIn); err != nil {
+			return err
+		}
+	}
+
 	poolAddr := types.GetReservePoolAddr(lptDenom)
 	if err := k.bk.SendCoins(ctx, sender, poolAddr, sdk.NewCoins(coinSold)); err != nil {
 		return err
@@ -25,7 +51,24 @@ func (k Keeper) swapCoins(ctx sdk.Context, sender, recipient sdk.AccAddress, coi
 		recipient = sender
 	}
 
-	return k.bk.SendCoins(ctx, poolAddr, recipient, sdk.NewCoins(coinBought))
+	if err := k.bk.SendCoins(ctx, poolAddr, recipient, sdk.NewCoins(coinBought)); err != nil {
+		return err
+	}
+
+	if priceReference != nil {
+		k.SetPriceReference(ctx, *priceReference)
+	}
+
+	if err := k.recordSwapStats(ctx, lptDenom, coinSold, coinBought); err != nil {
+		return err
+	}
+
+	if err := k.DeductProtocolFee(ctx, lptDenom, coinSold); err != nil {
+		return err
+	}
+
+	k.recordKPerShare(ctx, lptDenom)
+	return nil
 }
 
 /*
@@ -41,6 +84,15 @@ func (k Keeper) calculateWithExactInput(ctx sdk.Context, exactSoldCoin sdk.Coin,
 		return sdkmath.ZeroInt(), err
 	}
 
+	// the reserves of a concentrated liquidity pool do not price its swaps
+	if pool, has := k.GetPoolByLptDenom(ctx, lptDenom); has && pool.PoolType == types.POOL_TYPE_CONCENTRATED {
+		result, err := k.simulateConcentratedSwap(ctx, pool, exactSoldCoin.Denom, exactSoldCoin.Amount, true)
+		if err != nil {
+			return sdkmath.ZeroInt(), err
+		}
+		return result.amountOut, nil
+	}
+
 	reservePoolAddress := types.GetReservePoolAddr(lptDenom).String()
 	reservePool, err := k.GetPoolBalances(ctx, reservePoolAddress)
 	if err != nil {
@@ -56,15 +108,19 @@ func (k Keeper) calculateWithExactInput(ctx sdk.Context, exactSoldCoin sdk.Coin,
 	if !outputReserve.IsPositive() {
 		return sdkmath.ZeroInt(), errorsmod.Wrap(types.ErrInsufficientFunds, fmt.Sprintf("reserve pool insufficient funds, actual [%s%s]", outputReserve.String(), boughtTokenDenom))
 	}
-	param := k.GetParams(ctx)
+	pool, has := k.GetPoolByLptDenom(ctx, lptDenom)
+	if !has {
+		return sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", lptDenom)
+	}
 
-	boughtTokenAmt := GetInputPrice(exactSoldCoin.Amount, inputReserve, outputReserve, param.Fee)
+	boughtTokenAmt := k.GetPoolInputPrice(ctx, pool, exactSoldCoin.Amount, inputReserve, outputReserve)
 	return boughtTokenAmt, nil
 }
 
 /*
 *
 Sell exact amount of a token for buying another, one of them must be standard token
+unless a pool of both exists
 @param input: exact amount of the token to be sold
 @param output: min amount of the token to be bought
 @param sender: address of the sender
@@ -97,23 +153,11 @@ func (k Keeper) TradeExactInputForOutput(ctx sdk.Context, input types.Input, out
 		return sdkmath.Int{}, err
 	}
 
-	var quoteCoinToSwap sdk.Coin
-	if boughtToken.Denom != standardDenom {
-		quoteCoinToSwap = boughtToken
-	} else {
-		quoteCoinToSwap = input.Coin
-	}
-
-	maxSwapAmount, err := k.GetMaximumSwapAmount(ctx, quoteCoinToSwap.Denom)
-	if err != nil {
+	if err := k.checkMaximumSwapAmount(ctx, standardDenom, input.Coin, boughtToken); err != nil {
 		return sdkmath.ZeroInt(), err
 	}
 
-	if quoteCoinToSwap.Amount.GT(maxSwapAmount.Amount) {
-		return sdkmath.ZeroInt(), errorsmod.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("expected swap amount %s%s exceeding swap amount limit %s%s", quoteCoinToSwap.Amount.String(), quoteCoinToSwap.Denom, maxSwapAmount.Amount.String(), maxSwapAmount.Denom))
-	}
-
-	if err := k.swapCoins(ctx, inputAddress, outputAddress, input.Coin, boughtToken); err != nil {
+	if err := k.swapCoins(ctx, inputAddress, outputAddress, input.Coin, boughtToken, true); err != nil {
 		return sdkmath.ZeroInt(), err
 	}
 	return boughtTokenAmt, nil
@@ -132,6 +176,15 @@ func (k Keeper) calculateWithExactOutput(ctx sdk.Context, exactBoughtCoin sdk.Co
 		return sdkmath.ZeroInt(), err
 	}
 
+	// the reserves of a concentrated liquidity pool do not price its swaps
+	if pool, has := k.GetPoolByLptDenom(ctx, lptDenom); has && pool.PoolType == types.POOL_TYPE_CONCENTRATED {
+		result, err := k.simulateConcentratedSwap(ctx, pool, soldTokenDenom, exactBoughtCoin.Amount, false)
+		if err != nil {
+			return sdkmath.ZeroInt(), err
+		}
+		return result.amountIn, nil
+	}
+
 	poolAddr := types.GetReservePoolAddr(lptDenom).String()
 	reservePool, err := k.GetPoolBalances(ctx, poolAddr)
 	if err != nil {
@@ -150,15 +203,19 @@ func (k Keeper) calculateWithExactOutput(ctx sdk.Context, exactBoughtCoin sdk.Co
 	if exactBoughtCoin.Amount.GTE(outputReserve) {
 		return sdkmath.ZeroInt(), errorsmod.Wrap(types.ErrInsufficientFunds, fmt.Sprintf("reserve pool insufficient balance of %s, user expected: %s, actual: %s", exactBoughtCoin.Denom, exactBoughtCoin.Amount.String(), outputReserve.String()))
 	}
-	param := k.GetParams(ctx)
+	pool, has := k.GetPoolByLptDenom(ctx, lptDenom)
+	if !has {
+		return sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", lptDenom)
+	}
 
-	soldTokenAmt := GetOutputPrice(exactBoughtCoin.Amount, inputReserve, outputReserve, param.Fee)
+	soldTokenAmt := k.GetPoolOutputPrice(ctx, pool, exactBoughtCoin.Amount, inputReserve, outputReserve)
 	return soldTokenAmt, nil
 }
 
 /*
 *
 Buy exact amount of a token by specifying the max amount of another token, one of them must be standard token
+unless a pool of both exists
 @param input : max amount of the token to be paid
 @param output : exact amount of the token to be bought
 @param sender : address of the sender
@@ -192,29 +249,332 @@ func (k Keeper) TradeInputForExactOutput(ctx sdk.Context, input types.Input, out
 		return sdkmath.Int{}, err
 	}
 
-	var quoteCoinToSwap sdk.Coin
-	if soldToken.Denom != standardDenom {
-		quoteCoinToSwap = soldToken
-	} else {
-		quoteCoinToSwap = output.Coin
+	if err := k.checkMaximumSwapAmount(ctx, standardDenom, soldToken, output.Coin); err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+
+	if err := k.swapCoins(ctx, inputAddress, outputAddress, soldToken, output.Coin, false); err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+
+	return soldTokenAmt, nil
+}
+
+/*
+*
+Sell exact amount of a token for buying another, routing through the standard token pools
+when neither of them is the standard token and no pool of both exists
+@param input: exact amount of the token to be sold
+@param output: min amount of the token to be bought
+@return: actual amount of the token to be bought
+*/
+func (k Keeper) TradeExactInputForOutputRoute(ctx sdk.Context, input types.Input, output types.Output) (sdkmath.Int, error) {
+	standardDenom, err := k.GetStandardDenom(ctx)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+
+	if k.isDirectSwap(ctx, standardDenom, input.Coin.Denom, output.Coin.Denom) {
+		return k.TradeExactInputForOutput(ctx, input, output)
 	}
 
-	maxSwapAmount, err := k.GetMaximumSwapAmount(ctx, quoteCoinToSwap.Denom)
+	// both legs are executed on a cached context so that nothing is
+	// committed unless the whole route succeeds
+	cacheCtx, write := ctx.CacheContext()
+
+	// first leg: sell the input token for the standard token, which is kept by the sender
+	standardAmt, err := k.TradeExactInputForOutput(cacheCtx, input, types.Output{
+		Address: input.Address,
+		Coin:    sdk.NewCoin(standardDenom, sdkmath.ZeroInt()),
+	})
 	if err != nil {
 		return sdkmath.ZeroInt(), err
 	}
 
-	if quoteCoinToSwap.Amount.GT(maxSwapAmount.Amount) {
-		return sdkmath.ZeroInt(), errorsmod.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("expected swap amount %s%s exceeding swap amount limit %s%s", quoteCoinToSwap.Amount.String(), quoteCoinToSwap.Denom, maxSwapAmount.Amount.String(), maxSwapAmount.Denom))
+	// second leg: sell the standard token received for the output token,
+	// the end-to-end minimum output is asserted here
+	boughtTokenAmt, err := k.TradeExactInputForOutput(cacheCtx, types.Input{
+		Address: input.Address,
+		Coin:    sdk.NewCoin(standardDenom, standardAmt),
+	}, output)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
 	}
 
-	if err := k.swapCoins(ctx, inputAddress, outputAddress, soldToken, output.Coin); err != nil {
+	write()
+	return boughtTokenAmt, nil
+}
+
+/*
+*
+Buy exact amount of a token by specifying the max amount of another token, routing through
+the standard token pools when neither of them is the standard token and no pool of both exists
+@param input : max amount of the token to be paid
+@param output : exact amount of the token to be bought
+@return : actual amount of the token to be paid
+*/
+func (k Keeper) TradeInputForExactOutputRoute(ctx sdk.Context, input types.Input, output types.Output) (sdkmath.Int, error) {
+	standardDenom, err := k.GetStandardDenom(ctx)
+	if err != nil {
 		return sdkmath.ZeroInt(), err
 	}
 
+	if k.isDirectSwap(ctx, standardDenom, input.Coin.Denom, output.Coin.Denom) {
+		return k.TradeInputForExactOutput(ctx, input, output)
+	}
+
+	// calculate backwards from the exact output the amount of standard token
+	// needed by the second leg, then the input token needed by the first leg
+	standardAmt, err := k.calculateWithExactOutput(ctx, output.Coin, standardDenom)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+	soldTokenAmt, err := k.calculateWithExactOutput(ctx, sdk.NewCoin(standardDenom, standardAmt), input.Coin.Denom)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+
+	// assert that the calculated amount is less than the
+	// max amount the buyer is willing to pay.
+	if soldTokenAmt.GT(input.Coin.Amount) {
+		return sdkmath.ZeroInt(), errorsmod.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("insufficient amount of %s, user expected: %s, actual: %s", input.Coin.Denom, input.Coin.Amount.String(), soldTokenAmt.String()))
+	}
+
+	// both legs are executed on a cached context so that nothing is
+	// committed unless the whole route succeeds
+	cacheCtx, write := ctx.CacheContext()
+
+	// first leg: buy the standard token needed by the second leg, which is kept by the sender
+	if _, err := k.TradeInputForExactOutput(cacheCtx, types.Input{
+		Address: input.Address,
+		Coin:    sdk.NewCoin(input.Coin.Denom, soldTokenAmt),
+	}, types.Output{
+		Address: input.Address,
+		Coin:    sdk.NewCoin(standardDenom, standardAmt),
+	}); err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+
+	// second leg: buy the exact output with the standard token bought
+	if _, err := k.TradeInputForExactOutput(cacheCtx, types.Input{
+		Address: input.Address,
+		Coin:    sdk.NewCoin(standardDenom, standardAmt),
+	}, output); err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+
+	write()
 	return soldTokenAmt, nil
 }
 
+// QuoteSwapExactIn estimates the result of selling an exact amount of tokenIn for
+// tokenOutDenom without executing the swap, routing through the standard denom if needed
+func (k Keeper) QuoteSwapExactIn(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string) (*types.QueryEstimateSwapExactInResponse, error) {
+	standardDenom, err := k.GetStandardDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	route, err := k.GetSwapRoute(ctx, tokenIn.Denom, tokenOutDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryEstimateSwapExactInResponse{Route: route}
+
+	// idealAmt tracks the output of the route with no slippage, used for the price impact
+	coinIn := tokenIn
+	idealAmt := sdkmath.LegacyNewDecFromInt(tokenIn.Amount)
+	for _, denom := range route[1:] {
+		spotPrice, err := k.getSpotPrice(ctx, coinIn.Denom, denom)
+		if err != nil {
+			return nil, err
+		}
+
+		lptDenom, err := k.GetLptDenomFromDenoms(ctx, coinIn.Denom, denom)
+		if err != nil {
+			return nil, err
+		}
+		fee := k.GetPoolFee(ctx, lptDenom)
+
+		boughtTokenAmt, err := k.calculateWithExactInput(ctx, coinIn, denom)
+		if err != nil {
+			return nil, err
+		}
+		coinOut := sdk.NewCoin(denom, boughtTokenAmt)
+
+		exceeded, err := k.isMaximumSwapAmountExceeded(ctx, standardDenom, coinIn, coinOut)
+		if err != nil {
+			return nil, err
+		}
+
+		res.MaxSwapAmountExceeded = res.MaxSwapAmountExceeded || exceeded
+		res.Fees = res.Fees.Add(sdk.NewCoin(coinIn.Denom, sdkmath.LegacyNewDecFromInt(coinIn.Amount).Mul(fee).TruncateInt()))
+		idealAmt = idealAmt.Mul(sdkmath.LegacyOneDec().Sub(fee)).Mul(spotPrice)
+		coinIn = coinOut
+	}
+
+	res.TokenOut = coinIn
+	res.PriceImpact = sdkmath.LegacyZeroDec()
+	if idealAmt.IsPositive() {
+		res.PriceImpact = sdkmath.LegacyOneDec().Sub(sdkmath.LegacyNewDecFromInt(coinIn.Amount).Quo(idealAmt))
+	}
+	return res, nil
+}
+
+// QuoteSwapExactOut estimates the result of buying an exact amount of tokenOut with
+// tokenInDenom without executing the swap, routing through the standard denom if needed
+func (k Keeper) QuoteSwapExactOut(ctx sdk.Context, tokenOut sdk.Coin, tokenInDenom string) (*types.QueryEstimateSwapExactOutResponse, error) {
+	standardDenom, err := k.GetStandardDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	route, err := k.GetSwapRoute(ctx, tokenInDenom, tokenOut.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryEstimateSwapExactOutResponse{Route: route}
+
+	// idealAmt tracks the input of the route with no slippage, used for the price impact
+	coinOut := tokenOut
+	idealAmt := sdkmath.LegacyNewDecFromInt(tokenOut.Amount)
+	for i := len(route) - 2; i >= 0; i-- {
+		denom := route[i]
+		spotPrice, err := k.getSpotPrice(ctx, denom, coinOut.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		lptDenom, err := k.GetLptDenomFromDenoms(ctx, denom, coinOut.Denom)
+		if err != nil {
+			return nil, err
+		}
+		fee := k.GetPoolFee(ctx, lptDenom)
+
+		soldTokenAmt, err := k.calculateWithExactOutput(ctx, coinOut, denom)
+		if err != nil {
+			return nil, err
+		}
+		coinIn := sdk.NewCoin(denom, soldTokenAmt)
+
+		exceeded, err := k.isMaximumSwapAmountExceeded(ctx, standardDenom, coinIn, coinOut)
+		if err != nil {
+			return nil, err
+		}
+
+		res.MaxSwapAmountExceeded = res.MaxSwapAmountExceeded || exceeded
+		res.Fees = res.Fees.Add(sdk.NewCoin(denom, sdkmath.LegacyNewDecFromInt(soldTokenAmt).Mul(fee).TruncateInt()))
+		idealAmt = idealAmt.Quo(sdkmath.LegacyOneDec().Sub(fee).Mul(spotPrice))
+		coinOut = coinIn
+	}
+
+	res.TokenIn = coinOut
+	res.PriceImpact = sdkmath.LegacyZeroDec()
+	if coinOut.Amount.IsPositive() {
+		res.PriceImpact = sdkmath.LegacyOneDec().Sub(idealAmt.QuoInt(coinOut.Amount))
+	}
+	return res, nil
+}
+
+// getSpotPrice returns the current price of inputDenom in outputDenom of the pool
+// holding both, without fee and slippage
+func (k Keeper) getSpotPrice(ctx sdk.Context, inputDenom, outputDenom string) (sdkmath.LegacyDec, error) {
+	lptDenom, err := k.GetLptDenomFromDenoms(ctx, inputDenom, outputDenom)
+	if err != nil {
+		return sdkmath.LegacyZeroDec(), err
+	}
+
+	pool, has := k.GetPoolByLptDenom(ctx, lptDenom)
+	if !has {
+		return sdkmath.LegacyZeroDec(), errorsmod.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", lptDenom)
+	}
+
+	if pool.PoolType == types.POOL_TYPE_CONCENTRATED {
+		price, err := k.getConcentratedSpotPrice(ctx, pool)
+		if err != nil || inputDenom == pool.CounterpartyDenom {
+			return price, err
+		}
+		return sdkmath.LegacyOneDec().Quo(price), nil
+	}
+
+	reservePool, err := k.GetPoolBalancesByLptDenom(ctx, lptDenom)
+	if err != nil {
+		return sdkmath.LegacyZeroDec(), err
+	}
+
+	inputReserve := reservePool.AmountOf(inputDenom)
+	outputReserve := reservePool.AmountOf(outputDenom)
+	if !inputReserve.IsPositive() {
+		return sdkmath.LegacyZeroDec(), errorsmod.Wrap(types.ErrInsufficientFunds, fmt.Sprintf("reserve pool insufficient funds, actual [%s%s]", inputReserve.String(), inputDenom))
+	}
+	return GetPoolSpotPrice(pool, inputReserve, outputReserve), nil
+}
+
+// GetPoolInputPrice returns the amount of coins bought (calculated) given the input amount being sold (exact),
+// priced with the fee and the invariant of the pool
+func (k Keeper) GetPoolInputPrice(ctx sdk.Context, pool types.Pool, inputAmt, inputReserve, outputReserve sdkmath.Int) sdkmath.Int {
+	fee := k.GetPoolFee(ctx, pool.LptDenom)
+	if pool.PoolType == types.POOL_TYPE_STABLESWAP {
+		return GetStableSwapInputPrice(inputAmt, inputReserve, outputReserve, fee, pool.Amplification)
+	}
+	return GetInputPrice(inputAmt, inputReserve, outputReserve, fee)
+}
+
+// GetPoolOutputPrice returns the amount of coins sold (calculated) given the output amount being bought (exact),
+// priced with the fee and the invariant of the pool
+func (k Keeper) GetPoolOutputPrice(ctx sdk.Context, pool types.Pool, outputAmt, inputReserve, outputReserve sdkmath.Int) sdkmath.Int {
+	fee := k.GetPoolFee(ctx, pool.LptDenom)
+	if pool.PoolType == types.POOL_TYPE_STABLESWAP {
+		return GetStableSwapOutputPrice(outputAmt, inputReserve, outputReserve, fee, pool.Amplification)
+	}
+	return GetOutputPrice(outputAmt, inputReserve, outputReserve, fee)
+}
+
+// GetPoolSpotPrice returns the marginal price of the input coin in the output coin of the pool,
+// without fee and slippage
+func GetPoolSpotPrice(pool types.Pool, inputReserve, outputReserve sdkmath.Int) sdkmath.LegacyDec {
+	if !inputReserve.IsPositive() {
+		return sdkmath.LegacyZeroDec()
+	}
+	if pool.PoolType == types.POOL_TYPE_STABLESWAP {
+		return GetStableSwapSpotPrice(inputReserve, outputReserve, pool.Amplification)
+	}
+	return sdkmath.LegacyNewDecFromInt(outputReserve).QuoInt(inputReserve)
+}
+
+// checkMaximumSwapAmount checks that no coin of a swap but the standard coin
+// exceeds its max swap amount: the non standard coin of a swap in a pool of the
+// standard denom, and both coins of a swap in a pair pool
+func (k Keeper) checkMaximumSwapAmount(ctx sdk.Context, standardDenom string, coinSold, coinBought sdk.Coin) error {
+	for _, quoteCoinToSwap := range []sdk.Coin{coinSold, coinBought} {
+		if quoteCoinToSwap.Denom == standardDenom {
+			continue
+		}
+
+		maxSwapAmount, err := k.GetMaximumSwapAmount(ctx, quoteCoinToSwap.Denom)
+		if err != nil {
+			return err
+		}
+
+		if quoteCoinToSwap.Amount.GT(maxSwapAmount.Amount) {
+			return errorsmod.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("expected swap amount %s%s exceeding swap amount limit %s%s", quoteCoinToSwap.Amount.String(), quoteCoinToSwap.Denom, maxSwapAmount.Amount.String(), maxSwapAmount.Denom))
+		}
+	}
+	return nil
+}
+
+// isMaximumSwapAmountExceeded returns whether swapping coinSold for coinBought exceeds
+// the max swap amount of a non standard coin, as checked by the trade functions
+func (k Keeper) isMaximumSwapAmountExceeded(ctx sdk.Context, standardDenom string, coinSold, coinBought sdk.Coin) (bool, error) {
+	err := k.checkMaximumSwapAmount(ctx, standardDenom, coinSold, coinBought)
+	if errors.Is(err, types.ErrConstraintNotMet) {
+		return true, nil
+	}
+	return false, err
+}
+
 func (k Keeper) GetMaximumSwapAmount(ctx sdk.Context, denom string) (sdk.Coin, error) {
 	params := k.GetParams(ctx)
 	for _, coin := range params.MaxSwapAmount {
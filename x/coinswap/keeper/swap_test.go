@@ -10,6 +10,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/Canto-Network/Canto/v8/x/coinswap/keeper"
 	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
@@ -78,7 +79,7 @@ func (suite *TestSuite) TestGetOutputPrice() {
 func (suite *TestSuite) TestSwap() {
 	sender, reservePoolAddr := createReservePool(suite, denomBTC)
 
-	poolId := types.GetPoolId(denomBTC)
+	poolId := types.GetPoolId(denomStandard, denomBTC)
 	pool, has := suite.app.CoinswapKeeper.GetPool(suite.ctx, poolId)
 	suite.Require().True(has)
 
@@ -202,13 +203,78 @@ func (suite *TestSuite) TestSwap() {
 	suite.Equal(expCoins.Sort().String(), senderBalances.Sort().String())
 }
 
+func (suite *TestSuite) TestPairPool() {
+	sender, _ := createReservePool(suite, denomBTC)
+	k := suite.app.CoinswapKeeper
+	ethCoins := sdk.NewCoins(buildCoin(denomETH, 10_000_000_000))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, ethCoins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, ethCoins))
+
+	deadline := time.Now().Add(time.Minute).Unix()
+	newMsg := func(maxToken sdk.Coin, pairDenom string) *types.MsgAddLiquidity {
+		msg := types.NewMsgAddLiquidity(maxToken, sdkmath.NewInt(2_000_000), sdkmath.OneInt(), deadline, sender.String())
+		msg.PairDenom = pairDenom
+		return msg
+	}
+
+	_, err := suite.msgServer.AddLiquidity(suite.ctx, newMsg(buildCoin(denomETH, 1_000_000), denomETH))
+	suite.Require().ErrorIs(err, types.ErrEqualDenom)
+	_, err = suite.msgServer.AddLiquidity(suite.ctx, newMsg(buildCoin(denomETH, 1_000_000), "uatom"))
+	suite.Require().ErrorIs(err, types.ErrInvalidDenom)
+
+	res, err := suite.msgServer.AddLiquidity(suite.ctx, newMsg(buildCoin(denomETH, 1_000_000), denomBTC))
+	suite.Require().NoError(err)
+	suite.Require().Equal(buildCoin(types.GetLptDenom(2), 2_000_000), *res.MintToken)
+
+	pool, has := k.GetPool(suite.ctx, types.GetPoolId(denomETH, denomBTC))
+	suite.Require().True(has)
+	suite.Require().Equal(types.GetLptDenom(2), pool.LptDenom)
+	suite.Require().Equal(denomBTC, pool.StandardDenom)
+	suite.Require().Equal(denomETH, pool.CounterpartyDenom)
+
+	// the exact amount of a pair pool is always deposited in the same denom
+	_, err = suite.msgServer.AddLiquidity(suite.ctx, newMsg(buildCoin(denomBTC, 1_000_000), denomETH))
+	suite.Require().ErrorIs(err, types.ErrInvalidDenom)
+
+	// the pair pool is used for direct swaps and routes
+	route, err := k.GetSwapRoute(suite.ctx, denomETH, denomBTC)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{denomETH, denomBTC}, route)
+
+	ethBalance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, denomETH)
+	_, err = suite.msgServer.SwapCoin(suite.ctx, types.NewMsgSwapOrder(
+		types.Input{Address: sender.String(), Coin: buildCoin(denomBTC, 1000)},
+		types.Output{Address: sender.String(), Coin: buildCoin(denomETH, 1)},
+		deadline, false,
+	))
+	suite.Require().NoError(err)
+	suite.Require().Equal(ethBalance.AddAmount(sdkmath.NewInt(499)), suite.app.BankKeeper.GetBalance(suite.ctx, sender, denomETH))
+
+	// the max swap amount applies to both legs of a pair pool swap
+	_, err = suite.msgServer.SwapCoin(suite.ctx, types.NewMsgSwapOrder(
+		types.Input{Address: sender.String(), Coin: buildCoin(denomBTC, 20_000_000)},
+		types.Output{Address: sender.String(), Coin: buildCoin(denomETH, 1)},
+		deadline, false,
+	))
+	suite.Require().ErrorIs(err, types.ErrConstraintNotMet)
+
+	coins, err := k.RemoveLiquidity(suite.ctx, types.NewMsgRemoveLiquidity(sdkmath.OneInt(), *res.MintToken, sdkmath.OneInt(), deadline, sender.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(buildCoin(denomBTC, 2_001_000), buildCoin(denomETH, 999_501)), coins)
+
+	msg, broken := keeper.AllInvariants(k)(suite.ctx)
+	suite.Require().False(broken, msg)
+}
+
 func createReservePool(suite *TestSuite, denom string) (sdk.AccAddress, sdk.AccAddress) {
 	// Set parameters
 	params := types.Params{
 		Fee:                    sdkmath.LegacyNewDec(0),
 		PoolCreationFee:        sdk.Coin{denomStandard, sdkmath.ZeroInt()},
+		GaugeCreationFee:       sdk.Coin{denomStandard, sdkmath.ZeroInt()},
 		TaxRate:                sdkmath.LegacyNewDec(0),
 		MaxStandardCoinPerPool: sdkmath.NewInt(10_000_000_000),
+		SwapProtocolFeeShare:   sdkmath.LegacyZeroDec(),
 		MaxSwapAmount: sdk.NewCoins(sdk.NewInt64Coin(denomBTC, 10_000_000),
 			sdk.NewInt64Coin(denomETH, 10_000_000),
 		),
@@ -239,7 +305,7 @@ func createReservePool(suite *TestSuite, denom string) (sdk.AccAddress, sdk.AccA
 	_, err = suite.app.CoinswapKeeper.AddLiquidity(suite.ctx, msg)
 	suite.NoError(err)
 
-	poolId := types.GetPoolId(denom)
+	poolId := types.GetPoolId(denomStandard, denom)
 	pool, has := suite.app.CoinswapKeeper.GetPool(suite.ctx, poolId)
 	suite.Require().True(has)
 	reservePoolAddr := types.GetReservePoolAddr(pool.LptDenom)
@@ -339,6 +405,145 @@ func (suite *TestSuite) TestTradeExactInputForOutput() {
 	}
 }
 
+func (suite *TestSuite) TestTradeExactInputForOutputRoute() {
+	sender, btcPoolAddr := createReservePool(suite, denomBTC)
+	_, ethPoolAddr := createReservePool(suite, denomETH)
+
+	btcPoolBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, btcPoolAddr)
+	ethPoolBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, ethPoolAddr)
+	senderBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, sender)
+	params := suite.app.CoinswapKeeper.GetParams(suite.ctx)
+
+	inputCoin := sdk.NewCoin(denomBTC, sdkmath.NewInt(1_000_000))
+	standardAmt := keeper.GetInputPrice(inputCoin.Amount, btcPoolBalances.AmountOf(denomBTC), btcPoolBalances.AmountOf(denomStandard), params.Fee)
+	expectAmt := keeper.GetInputPrice(standardAmt, ethPoolBalances.AmountOf(denomStandard), ethPoolBalances.AmountOf(denomETH), params.Fee)
+
+	// failed because the end-to-end minimum output is not met
+	_, err := suite.app.CoinswapKeeper.TradeExactInputForOutputRoute(suite.ctx,
+		types.Input{Address: sender.String(), Coin: inputCoin},
+		types.Output{Address: sender.String(), Coin: sdk.NewCoin(denomETH, expectAmt.AddRaw(1))},
+	)
+	suite.Require().ErrorIs(err, types.ErrConstraintNotMet)
+
+	// failed because the first leg exceeds the maximum swap amount
+	_, err = suite.app.CoinswapKeeper.TradeExactInputForOutputRoute(suite.ctx,
+		types.Input{Address: sender.String(), Coin: sdk.NewCoin(denomBTC, sdkmath.NewInt(10_000_001))},
+		types.Output{Address: sender.String(), Coin: sdk.NewCoin(denomETH, sdkmath.ZeroInt())},
+	)
+	suite.Require().ErrorIs(err, types.ErrConstraintNotMet)
+
+	amt, err := suite.app.CoinswapKeeper.TradeExactInputForOutputRoute(suite.ctx,
+		types.Input{Address: sender.String(), Coin: inputCoin},
+		types.Output{Address: sender.String(), Coin: sdk.NewCoin(denomETH, expectAmt)},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectAmt, amt)
+
+	standardCoin := sdk.NewCoin(denomStandard, standardAmt)
+	outputCoin := sdk.NewCoin(denomETH, expectAmt)
+	assertResult(suite, btcPoolAddr, sender,
+		btcPoolBalances.Add(inputCoin).Sub(standardCoin),
+		senderBalances.Add(outputCoin).Sub(inputCoin),
+	)
+	suite.Require().Equal(
+		ethPoolBalances.Add(standardCoin).Sub(outputCoin).String(),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, ethPoolAddr).String(),
+	)
+}
+
+func (suite *TestSuite) TestTradeInputForExactOutputRoute() {
+	sender, btcPoolAddr := createReservePool(suite, denomBTC)
+	_, ethPoolAddr := createReservePool(suite, denomETH)
+
+	btcPoolBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, btcPoolAddr)
+	ethPoolBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, ethPoolAddr)
+	senderBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, sender)
+	params := suite.app.CoinswapKeeper.GetParams(suite.ctx)
+
+	outputCoin := sdk.NewCoin(denomETH, sdkmath.NewInt(1_000_000))
+	standardAmt := keeper.GetOutputPrice(outputCoin.Amount, ethPoolBalances.AmountOf(denomStandard), ethPoolBalances.AmountOf(denomETH), params.Fee)
+	expectAmt := keeper.GetOutputPrice(standardAmt, btcPoolBalances.AmountOf(denomBTC), btcPoolBalances.AmountOf(denomStandard), params.Fee)
+
+	// failed because the end-to-end maximum input is not met
+	_, err := suite.app.CoinswapKeeper.TradeInputForExactOutputRoute(suite.ctx,
+		types.Input{Address: sender.String(), Coin: sdk.NewCoin(denomBTC, expectAmt.SubRaw(1))},
+		types.Output{Address: sender.String(), Coin: outputCoin},
+	)
+	suite.Require().ErrorIs(err, types.ErrConstraintNotMet)
+
+	// failed because the second leg exceeds the maximum swap amount
+	_, err = suite.app.CoinswapKeeper.TradeInputForExactOutputRoute(suite.ctx,
+		types.Input{Address: sender.String(), Coin: sdk.NewCoin(denomBTC, sdkmath.NewInt(100_000_000))},
+		types.Output{Address: sender.String(), Coin: sdk.NewCoin(denomETH, sdkmath.NewInt(10_000_001))},
+	)
+	suite.Require().ErrorIs(err, types.ErrConstraintNotMet)
+
+	amt, err := suite.app.CoinswapKeeper.TradeInputForExactOutputRoute(suite.ctx,
+		types.Input{Address: sender.String(), Coin: sdk.NewCoin(denomBTC, expectAmt)},
+		types.Output{Address: sender.String(), Coin: outputCoin},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectAmt, amt)
+
+	standardCoin := sdk.NewCoin(denomStandard, standardAmt)
+	inputCoin := sdk.NewCoin(denomBTC, expectAmt)
+	assertResult(suite, btcPoolAddr, sender,
+		btcPoolBalances.Add(inputCoin).Sub(standardCoin),
+		senderBalances.Add(outputCoin).Sub(inputCoin),
+	)
+	suite.Require().Equal(
+		ethPoolBalances.Add(standardCoin).Sub(outputCoin).String(),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, ethPoolAddr).String(),
+	)
+}
+
+func (suite *TestSuite) TestSwapProtocolFee() {
+	sender, poolAddr := createReservePool(suite, denomBTC)
+
+	params := suite.app.CoinswapKeeper.GetParams(suite.ctx)
+	params.Fee = sdkmath.LegacyNewDecWithPrec(3, 3)
+	params.SwapProtocolFeeShare = sdkmath.LegacyNewDecWithPrec(5, 1)
+	suite.app.CoinswapKeeper.SetParams(suite.ctx, params)
+
+	feeCollectorAddr := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, feeCollectorAddr)
+	poolBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, poolAddr)
+	senderBlances := suite.app.BankKeeper.GetAllBalances(suite.ctx, sender)
+
+	inputCoin := sdk.NewCoin(denomBTC, sdkmath.NewInt(1_000_000))
+	input := types.Input{Address: sender.String(), Coin: inputCoin}
+	output := types.Output{Address: sender.String(), Coin: sdk.NewCoin(denomStandard, sdkmath.ZeroInt())}
+
+	amt, err := suite.app.CoinswapKeeper.TradeExactInputForOutput(suite.ctx, input, output)
+	suite.NoError(err)
+
+	// 1_000_000 * 0.003 * 0.5
+	protocolFee := sdk.NewCoins(sdk.NewInt64Coin(denomBTC, 1500))
+	sold := sdk.NewCoins(inputCoin)
+	bought := sdk.NewCoins(sdk.NewCoin(denomStandard, amt))
+
+	assertResult(suite, poolAddr, sender,
+		poolBalances.Add(sold...).Sub(bought...).Sub(protocolFee...),
+		senderBlances.Add(bought...).Sub(sold...),
+	)
+	suite.Equal(
+		feeCollectorBalances.Add(protocolFee...).String(),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, feeCollectorAddr).String(),
+	)
+
+	pool, has := suite.app.CoinswapKeeper.GetPoolByLptDenom(suite.ctx, "lpt-1")
+	suite.True(has)
+	suite.Equal(protocolFee.String(), suite.app.CoinswapKeeper.GetProtocolFees(suite.ctx, pool.Id).String())
+
+	// no protocol fee is taken when the share is zero
+	params.SwapProtocolFeeShare = sdkmath.LegacyZeroDec()
+	suite.app.CoinswapKeeper.SetParams(suite.ctx, params)
+
+	_, err = suite.app.CoinswapKeeper.TradeExactInputForOutput(suite.ctx, input, output)
+	suite.NoError(err)
+	suite.Equal(protocolFee.String(), suite.app.CoinswapKeeper.GetProtocolFees(suite.ctx, pool.Id).String())
+}
+
 func assertResult(suite *TestSuite, reservePoolAddr, sender sdk.AccAddress, expectPoolBalance, expectSenderBalance sdk.Coins) {
 	reservePoolBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, reservePoolAddr)
 	senderBlances := suite.app.BankKeeper.GetAllBalances(suite.ctx, sender)
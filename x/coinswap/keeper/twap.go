@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
+)
+
+// updateTwap appends a twap record for the pool at its first swap or liquidity
+// change of the block. It must be called before the reserves are changed, so
+// the price accumulated since the previous record is the one left by the
+// previous block.
+func (k Keeper) updateTwap(ctx sdk.Context, lptDenom string) error {
+	pool, has := k.GetPoolByLptDenom(ctx, lptDenom)
+	if !has {
+		return errorsmod.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", lptDenom)
+	}
+
+	record := types.TwapRecord{
+		PoolId:          pool.Id,
+		Height:          ctx.BlockHeight(),
+		Time:            ctx.BlockTime(),
+		PriceCumulative: sdkmath.LegacyZeroDec(),
+	}
+
+	sequence := k.getTwapSequence(ctx, pool.Id)
+	if sequence > 0 {
+		last := k.getTwapRecord(ctx, pool.Id, sequence-1)
+		if last.Height == ctx.BlockHeight() {
+			return nil
+		}
+
+		spotPrice, err := k.getTwapSpotPrice(ctx, pool)
+		if err != nil {
+			return err
+		}
+		record.PriceCumulative = last.PriceCumulative.Add(spotPrice.Mul(secondsBetween(last.Time, record.Time)))
+	}
+
+	k.appendTwapRecord(ctx, record)
+	return nil
+}
+
+// GetTwap returns the time weighted average price of the counterparty coin in
+// the standard coin of the pool between startTime and endTime
+func (k Keeper) GetTwap(ctx sdk.Context, lptDenom string, startTime, endTime time.Time) (sdkmath.LegacyDec, error) {
+	pool, has := k.GetPoolByLptDenom(ctx, lptDenom)
+	if !has {
+		return sdkmath.LegacyZeroDec(), errorsmod.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", lptDenom)
+	}
+
+	if !startTime.Before(endTime) {
+		return sdkmath.LegacyZeroDec(), errorsmod.Wrapf(types.ErrTwapNotAvailable, "start time %s must be before end time %s", startTime, endTime)
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return sdkmath.LegacyZeroDec(), errorsmod.Wrapf(types.ErrTwapNotAvailable, "end time %s is after the block time %s", endTime, ctx.BlockTime())
+	}
+
+	records := k.GetTwapRecords(ctx, pool.Id)
+	if len(records) == 0 || startTime.Before(records[0].Time) {
+		return sdkmath.LegacyZeroDec(), errorsmod.Wrapf(types.ErrTwapNotAvailable, "no twap record for pool %s before %s", pool.Id, startTime)
+	}
+
+	startCumulative, err := k.getPriceCumulative(ctx, pool, records, startTime)
+	if err != nil {
+		return sdkmath.LegacyZeroDec(), err
+	}
+	endCumulative, err := k.getPriceCumulative(ctx, pool, records, endTime)
+	if err != nil {
+		return sdkmath.LegacyZeroDec(), err
+	}
+
+	return endCumulative.Sub(startCumulative).Quo(secondsBetween(startTime, endTime)), nil
+}
+
+// getPriceCumulative returns the cumulative price of the pool at t, which must
+// not be before the first record
+func (k Keeper) getPriceCumulative(ctx sdk.Context, pool types.Pool, records []types.TwapRecord, t time.Time) (sdkmath.LegacyDec, error) {
+	i := sort.Search(len(records), func(i int) bool {
+		return records[i].Time.After(t)
+	}) - 1
+	record := records[i]
+	elapsed := secondsBetween(record.Time, t)
+
+	if i+1 < len(records) {
+		// the price is constant between two records
+		next := records[i+1]
+		delta := next.PriceCumulative.Sub(record.PriceCumulative)
+		return record.PriceCumulative.Add(delta.Mul(elapsed).Quo(secondsBetween(record.Time, next.Time))), nil
+	}
+
+	spotPrice, err := k.getTwapSpotPrice(ctx, pool)
+	if err != nil {
+		return sdkmath.LegacyZeroDec(), err
+	}
+	return record.PriceCumulative.Add(spotPrice.Mul(elapsed)), nil
+}
+
+// getTwapSpotPrice returns the current price of the counterparty coin in the
+// standard coin of the pool, or zero if the pool is empty
+func (k Keeper) getTwapSpotPrice(ctx sdk.Context, pool types.Pool) (sdkmath.LegacyDec, error) {
+	if pool.PoolType == types.POOL_TYPE_CONCENTRATED {
+		return k.getConcentratedSpotPrice(ctx, pool)
+	}
+
+	balances, err := k.GetPoolBalances(ctx, pool.EscrowAddress)
+	if err != nil {
+		return sdkmath.LegacyZeroDec(), err
+	}
+
+	return GetPoolSpotPrice(pool, balances.AmountOf(pool.CounterpartyDenom), balances.AmountOf(pool.StandardDenom)), nil
+}
+
+// GetTwapRecords returns the twap records kept for the pool, oldest first
+func (k Keeper) GetTwapRecords(ctx sdk.Context, poolId string) []types.TwapRecord {
+	sequence := k.getTwapSequence(ctx, poolId)
+	start := uint64(0)
+	if sequence > types.MaxTwapRecords {
+		start = sequence - types.MaxTwapRecords
+	}
+
+	records := make([]types.TwapRecord, 0, sequence-start)
+	for i := start; i < sequence; i++ {
+		records = append(records, k.getTwapRecord(ctx, poolId, i))
+	}
+	return records
+}
+
+// GetAllTwapRecords returns the twap records kept for all the pools
+func (k Keeper) GetAllTwapRecords(ctx sdk.Context) (records []types.TwapRecord) {
+	for _, pool := range k.GetAllPools(ctx) {
+		records = append(records, k.GetTwapRecords(ctx, pool.Id)...)
+	}
+	return
+}
+
+// appendTwapRecord stores the record as the latest one of its pool, overwriting
+// the oldest one once MaxTwapRecords are kept
+func (k Keeper) appendTwapRecord(ctx sdk.Context, record types.TwapRecord) {
+	sequence := k.getTwapSequence(ctx, record.PoolId)
+	k.setTwapRecord(ctx, record, sequence)
+	k.setTwapSequence(ctx, record.PoolId, sequence+1)
+}
+
+func (k Keeper) setTwapRecord(ctx sdk.Context, record types.TwapRecord, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.GetTwapRecordKey(record.PoolId, sequence), bz)
+}
+
+func (k Keeper) getTwapRecord(ctx sdk.Context, poolId string, sequence uint64) types.TwapRecord {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get(types.GetTwapRecordKey(poolId, sequence))
+	if bz == nil {
+		panic(fmt.Errorf("twap record %d of pool %s not found", sequence, poolId))
+	}
+
+	var record types.TwapRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record
+}
+
+// getTwapSequence gets the number of twap records appended for the pool.
+func (k Keeper) getTwapSequence(ctx sdk.Context, poolId string) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get(types.GetTwapSequenceKey(poolId))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setTwapSequence sets the number of twap records appended for the pool.
+func (k Keeper) setTwapSequence(ctx sdk.Context, poolId string, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	store.Set(types.GetTwapSequenceKey(poolId), sdk.Uint64ToBigEndian(sequence))
+}
+
+// secondsBetween returns the seconds elapsed from start to end, with
+// nanosecond precision
+func secondsBetween(start, end time.Time) sdkmath.LegacyDec {
+	return sdkmath.LegacyNewDecWithPrec(end.Sub(start).Nanoseconds(), 9)
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
+)
+
+func (suite *TestSuite) TestTwap() {
+	startTime := suite.ctx.BlockTime()
+	startHeight := suite.ctx.BlockHeight()
+	sender, poolAddr := createReservePool(suite, denomBTC)
+
+	spotPrice := func(ctx sdk.Context) sdkmath.LegacyDec {
+		balances := suite.app.BankKeeper.GetAllBalances(ctx, poolAddr)
+		return sdkmath.LegacyNewDecFromInt(balances.AmountOf(denomStandard)).QuoInt(balances.AmountOf(denomBTC))
+	}
+	price0 := spotPrice(suite.ctx)
+
+	// swap 10 seconds later
+	suite.ctx = suite.ctx.WithBlockHeight(startHeight + 1).WithBlockTime(startTime.Add(10 * time.Second))
+	input := types.Input{Address: sender.String(), Coin: sdk.NewInt64Coin(denomBTC, 1_000_000)}
+	output := types.Output{Address: sender.String(), Coin: sdk.NewInt64Coin(denomStandard, 0)}
+	_, err := suite.app.CoinswapKeeper.TradeExactInputForOutput(suite.ctx, input, output)
+	suite.Require().NoError(err)
+
+	// only the first activity of the block is recorded
+	_, err = suite.app.CoinswapKeeper.TradeExactInputForOutput(suite.ctx, input, output)
+	suite.Require().NoError(err)
+	price1 := spotPrice(suite.ctx)
+
+	pool, has := suite.app.CoinswapKeeper.GetPoolByLptDenom(suite.ctx, "lpt-1")
+	suite.Require().True(has)
+	records := suite.app.CoinswapKeeper.GetTwapRecords(suite.ctx, pool.Id)
+	suite.Require().Len(records, 2)
+	suite.Require().Equal(price0.MulInt64(10), records[1].PriceCumulative)
+
+	suite.ctx = suite.ctx.WithBlockHeight(startHeight + 2).WithBlockTime(startTime.Add(30 * time.Second))
+
+	testCases := []struct {
+		name    string
+		start   time.Time
+		end     time.Time
+		expTwap sdkmath.LegacyDec
+		expErr  bool
+	}{
+		{"ok - whole history", startTime, startTime.Add(30 * time.Second), price0.MulInt64(10).Add(price1.MulInt64(20)).QuoInt64(30), false},
+		{"ok - between two records", startTime.Add(2 * time.Second), startTime.Add(8 * time.Second), price0, false},
+		{"ok - after the last record", startTime.Add(20 * time.Second), startTime.Add(30 * time.Second), price1, false},
+		{"fail - start before the first record", startTime.Add(-time.Second), startTime.Add(30 * time.Second), sdkmath.LegacyDec{}, true},
+		{"fail - end after the block time", startTime, startTime.Add(31 * time.Second), sdkmath.LegacyDec{}, true},
+		{"fail - start after end", startTime.Add(20 * time.Second), startTime.Add(10 * time.Second), sdkmath.LegacyDec{}, true},
+	}
+
+	for _, tc := range testCases {
+		res, err := suite.app.CoinswapKeeper.Twap(suite.ctx, &types.QueryTwapRequest{
+			LptDenom:  "lpt-1",
+			StartTime: tc.start,
+			EndTime:   tc.end,
+		})
+		if tc.expErr {
+			suite.Require().Error(err, tc.name)
+			continue
+		}
+		suite.Require().NoError(err, tc.name)
+		suite.Require().True(tc.expTwap.Sub(res.Twap).Abs().LTE(sdkmath.LegacyNewDecWithPrec(1, 15)), "%s: expected %s, got %s", tc.name, tc.expTwap, res.Twap)
+	}
+}
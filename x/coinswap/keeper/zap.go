@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
+)
+
+// AddLiquiditySingle adds liquidity to the specified pool with a single token, swapping
+// the fraction of it that balances the deposit with the pool reserves
+func (k Keeper) AddLiquiditySingle(ctx sdk.Context, msg *types.MsgAddLiquiditySingle) (sdk.Coin, error) {
+	pool, exists := k.GetPoolByLptDenom(ctx, msg.LptDenom)
+	if !exists {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", msg.LptDenom)
+	}
+	standardDenom := pool.StandardDenom
+	if pool.PoolType == types.POOL_TYPE_CONCENTRATED {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidPoolType, "liquidity is provided to the concentrated liquidity pool %s with positions", pool.LptDenom)
+	}
+	if msg.TokenIn.Denom != standardDenom && msg.TokenIn.Denom != pool.CounterpartyDenom {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidDenom, "token %s is not in the pool %s", msg.TokenIn.Denom, msg.LptDenom)
+	}
+	if k.bk.GetSupply(ctx, pool.LptDenom).Amount.IsZero() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrConstraintNotMet, "pool %s is empty, use MsgAddLiquidity to provide the initial liquidity", msg.LptDenom)
+	}
+
+	balances, err := k.GetPoolBalances(ctx, pool.EscrowAddress)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	outputDenom := pool.CounterpartyDenom
+	if msg.TokenIn.Denom == pool.CounterpartyDenom {
+		outputDenom = standardDenom
+	}
+	swapAmt := k.getZapInSwapAmount(ctx, pool, msg.TokenIn.Amount, balances.AmountOf(msg.TokenIn.Denom), balances.AmountOf(outputDenom))
+
+	boughtAmt, err := k.TradeExactInputForOutput(ctx,
+		types.Input{Address: msg.Sender, Coin: sdk.NewCoin(msg.TokenIn.Denom, swapAmt)},
+		types.Output{Address: msg.Sender, Coin: sdk.NewCoin(outputDenom, sdkmath.ZeroInt())},
+	)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	standardAmt, tokenAmt := msg.TokenIn.Amount.Sub(swapAmt), boughtAmt
+	if msg.TokenIn.Denom == pool.CounterpartyDenom {
+		standardAmt, tokenAmt = boughtAmt, msg.TokenIn.Amount.Sub(swapAmt)
+	}
+
+	// deposit as much standard coin as the bought or remaining token allows
+	balances, err = k.GetPoolBalances(ctx, pool.EscrowAddress)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	standardReserveAmt := balances.AmountOf(standardDenom)
+	tokenReserveAmt := balances.AmountOf(pool.CounterpartyDenom)
+	if tokenAmt.IsPositive() {
+		standardAmt = sdkmath.MinInt(standardAmt, tokenAmt.SubRaw(1).Mul(standardReserveAmt).Quo(tokenReserveAmt))
+	}
+	if !standardAmt.IsPositive() || !tokenAmt.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrConstraintNotMet, "token %s is too small to add liquidity", msg.TokenIn.String())
+	}
+
+	mintToken, err := k.AddLiquidity(ctx, &types.MsgAddLiquidity{
+		MaxToken:         sdk.NewCoin(pool.CounterpartyDenom, tokenAmt),
+		ExactStandardAmt: standardAmt,
+		MinLiquidity:     msg.MinLiquidity,
+		Deadline:         msg.Deadline,
+		Sender:           msg.Sender,
+		PairDenom:        standardDenom,
+	})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddLiquiditySingle,
+			sdk.NewAttribute(types.AttributeValueSender, msg.Sender),
+			sdk.NewAttribute(types.AttributeValueTokenIn, msg.TokenIn.String()),
+			sdk.NewAttribute(types.AttributeValueAmount, mintToken.String()),
+		),
+	)
+
+	return mintToken, nil
+}
+
+// RemoveLiquiditySingle removes liquidity from the specified pool as a single token,
+// swapping the other withdrawn token for it
+func (k Keeper) RemoveLiquiditySingle(ctx sdk.Context, msg *types.MsgRemoveLiquiditySingle) (sdk.Coin, error) {
+	pool, exists := k.GetPoolByLptDenom(ctx, msg.WithdrawLiquidity.Denom)
+	if !exists {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", msg.WithdrawLiquidity.Denom)
+	}
+	standardDenom := pool.StandardDenom
+	if msg.TokenOutMin.Denom != standardDenom && msg.TokenOutMin.Denom != pool.CounterpartyDenom {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidDenom, "token %s is not in the pool %s", msg.TokenOutMin.Denom, msg.WithdrawLiquidity.Denom)
+	}
+
+	withdrawCoins, err := k.RemoveLiquidity(ctx, &types.MsgRemoveLiquidity{
+		WithdrawLiquidity: msg.WithdrawLiquidity,
+		MinToken:          sdkmath.ZeroInt(),
+		MinStandardAmt:    sdkmath.ZeroInt(),
+		Deadline:          msg.Deadline,
+		Sender:            msg.Sender,
+	})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	inputDenom := pool.CounterpartyDenom
+	if msg.TokenOutMin.Denom == pool.CounterpartyDenom {
+		inputDenom = standardDenom
+	}
+
+	tokenOutAmt := withdrawCoins.AmountOf(msg.TokenOutMin.Denom)
+	if inputAmt := withdrawCoins.AmountOf(inputDenom); inputAmt.IsPositive() {
+		boughtAmt, err := k.TradeExactInputForOutput(ctx,
+			types.Input{Address: msg.Sender, Coin: sdk.NewCoin(inputDenom, inputAmt)},
+			types.Output{Address: msg.Sender, Coin: sdk.NewCoin(msg.TokenOutMin.Denom, sdkmath.ZeroInt())},
+		)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		tokenOutAmt = tokenOutAmt.Add(boughtAmt)
+	}
+
+	tokenOut := sdk.NewCoin(msg.TokenOutMin.Denom, tokenOutAmt)
+	if tokenOut.Amount.LT(msg.TokenOutMin.Amount) {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("token amount not met, user expected: no less than %s, actual: %s", msg.TokenOutMin.String(), tokenOut.String()))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveLiquiditySingle,
+			sdk.NewAttribute(types.AttributeValueSender, msg.Sender),
+			sdk.NewAttribute(types.AttributeValueAmount, msg.WithdrawLiquidity.String()),
+			sdk.NewAttribute(types.AttributeValueTokenOut, tokenOut.String()),
+		),
+	)
+
+	return tokenOut, nil
+}
+
+// getZapInSwapAmount returns the amount of the input coin to sell so that the rest of it
+// and the coins bought are in the ratio of the pool reserves after the swap. The amount
+// is found by bisection, which holds for every pool type
+func (k Keeper) getZapInSwapAmount(ctx sdk.Context, pool types.Pool, inputAmt, inputReserve, outputReserve sdkmath.Int) sdkmath.Int {
+	lo, hi := sdkmath.ZeroInt(), inputAmt
+	for hi.Sub(lo).GT(sdkmath.OneInt()) {
+		mid := lo.Add(hi).QuoRaw(2)
+		boughtAmt := k.GetPoolInputPrice(ctx, pool, mid, inputReserve, outputReserve)
+
+		// (inputAmt - mid) / (inputReserve + mid) >= boughtAmt / (outputReserve - boughtAmt)
+		remaining := inputAmt.Sub(mid).Mul(outputReserve.Sub(boughtAmt))
+		if remaining.GTE(boughtAmt.Mul(inputReserve.Add(mid))) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hi
+}
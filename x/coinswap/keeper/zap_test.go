@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
+)
+
+func (suite *TestSuite) TestAddLiquiditySingle() {
+	sender, _ := createReservePool(suite, denomBTC)
+	deadline := time.Now().Add(time.Minute).Unix()
+
+	params := suite.app.CoinswapKeeper.GetParams(suite.ctx)
+	params.MaxStandardCoinPerPool = sdkmath.NewInt(20_000_000_000)
+	suite.app.CoinswapKeeper.SetParams(suite.ctx, params)
+
+	testCases := []struct {
+		name    string
+		tokenIn sdk.Coin
+	}{
+		{"standard coin", sdk.NewInt64Coin(denomStandard, 10_000_000)},
+		{"counterparty coin", sdk.NewInt64Coin(denomBTC, 10_000_000)},
+	}
+
+	for _, tc := range testCases {
+		cacheCtx, _ := suite.ctx.CacheContext()
+		balancesBefore := suite.app.BankKeeper.GetAllBalances(cacheCtx, sender)
+
+		msg := types.NewMsgAddLiquiditySingle(sender.String(), "lpt-1", tc.tokenIn, sdkmath.OneInt(), deadline)
+		mintToken, err := suite.app.CoinswapKeeper.AddLiquiditySingle(cacheCtx, msg)
+		suite.Require().NoError(err, tc.name)
+
+		// about half of the coin is swapped and all of it is deposited, leaving only dust
+		suite.Require().Equal("lpt-1", mintToken.Denom)
+		suite.Require().True(mintToken.Amount.GT(sdkmath.NewInt(4_990_000)), tc.name)
+		suite.Require().True(mintToken.Amount.LT(sdkmath.NewInt(5_000_000)), tc.name)
+
+		balancesAfter := suite.app.BankKeeper.GetAllBalances(cacheCtx, sender)
+		suite.Require().Equal(balancesBefore.AmountOf("lpt-1").Add(mintToken.Amount), balancesAfter.AmountOf("lpt-1"))
+		for _, denom := range []string{denomStandard, denomBTC} {
+			spent := balancesBefore.AmountOf(denom).Sub(balancesAfter.AmountOf(denom))
+			if denom == tc.tokenIn.Denom {
+				suite.Require().True(tc.tokenIn.Amount.Sub(spent).LTE(sdkmath.NewInt(2)), tc.name)
+			} else {
+				suite.Require().True(spent.Abs().LTE(sdkmath.NewInt(2)), tc.name)
+			}
+		}
+	}
+
+	// min liquidity protection
+	msg := types.NewMsgAddLiquiditySingle(sender.String(), "lpt-1", sdk.NewInt64Coin(denomStandard, 10_000_000), sdkmath.NewInt(5_000_000), deadline)
+	_, err := suite.app.CoinswapKeeper.AddLiquiditySingle(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrConstraintNotMet)
+
+	// token not in the pool
+	msg = types.NewMsgAddLiquiditySingle(sender.String(), "lpt-1", sdk.NewInt64Coin(denomETH, 10_000_000), sdkmath.OneInt(), deadline)
+	_, err = suite.app.CoinswapKeeper.AddLiquiditySingle(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidDenom)
+
+	// pool not exists
+	msg = types.NewMsgAddLiquiditySingle(sender.String(), "lpt-2", sdk.NewInt64Coin(denomStandard, 10_000_000), sdkmath.OneInt(), deadline)
+	_, err = suite.app.CoinswapKeeper.AddLiquiditySingle(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrReservePoolNotExists)
+}
+
+func (suite *TestSuite) TestRemoveLiquiditySingle() {
+	sender, _ := createReservePool(suite, denomBTC)
+	deadline := time.Now().Add(time.Minute).Unix()
+	withdrawLiquidity := sdk.NewInt64Coin("lpt-1", 1_000_000)
+
+	for _, denom := range []string{denomStandard, denomBTC} {
+		cacheCtx, _ := suite.ctx.CacheContext()
+		balancesBefore := suite.app.BankKeeper.GetAllBalances(cacheCtx, sender)
+
+		msg := types.NewMsgRemoveLiquiditySingle(sender.String(), withdrawLiquidity, sdk.NewInt64Coin(denom, 1_990_000), deadline)
+		tokenOut, err := suite.app.CoinswapKeeper.RemoveLiquiditySingle(cacheCtx, msg)
+		suite.Require().NoError(err, denom)
+
+		// the withdrawn coins are worth twice the coin of the pool, minus the slippage
+		suite.Require().Equal(denom, tokenOut.Denom)
+		suite.Require().True(tokenOut.Amount.LT(sdkmath.NewInt(2_000_000)), denom)
+
+		balancesAfter := suite.app.BankKeeper.GetAllBalances(cacheCtx, sender)
+		suite.Require().Equal(balancesBefore.AmountOf(denom).Add(tokenOut.Amount), balancesAfter.AmountOf(denom))
+		suite.Require().Equal(balancesBefore.AmountOf("lpt-1").Sub(withdrawLiquidity.Amount), balancesAfter.AmountOf("lpt-1"))
+	}
+
+	// min token out protection
+	msg := types.NewMsgRemoveLiquiditySingle(sender.String(), withdrawLiquidity, sdk.NewInt64Coin(denomStandard, 2_000_000), deadline)
+	_, err := suite.app.CoinswapKeeper.RemoveLiquiditySingle(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrConstraintNotMet)
+
+	// token not in the pool
+	msg = types.NewMsgRemoveLiquiditySingle(sender.String(), withdrawLiquidity, sdk.NewInt64Coin(denomETH, 1), deadline)
+	_, err = suite.app.CoinswapKeeper.RemoveLiquiditySingle(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidDenom)
+}
@@ -0,0 +1,64 @@
+package v4
+
+import (
+	"fmt"
+
+	gogoprototypes "github.com/cosmos/gogoproto/types"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/Canto-Network/Canto/v8/x/coinswap/types"
+)
+
+// UpdateParams sets the module parameters SwapProtocolFeeShare,
+// PoolStatsEpochIdentifier, PriceDeviationEpochIdentifier, LimitOrderFillBudget
+// and IncentivesEpochIdentifier to their default values, and GaugeCreationFee
+// to the current PoolCreationFee.
+func UpdateParams(ctx sdk.Context, paramstore *paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		ps := paramstore.WithKeyTable(types.ParamKeyTable())
+		paramstore = &ps
+	}
+
+	paramstore.Set(ctx, types.KeySwapProtocolFeeShare, types.DefaultSwapProtocolFeeShare)
+	paramstore.Set(ctx, types.KeyPoolStatsEpochIdentifier, types.DefaultPoolStatsEpochIdentifier)
+	paramstore.Set(ctx, types.KeyPriceDeviationEpochIdentifier, types.DefaultPriceDeviationEpochIdentifier)
+	paramstore.Set(ctx, types.KeyLimitOrderFillBudget, types.DefaultLimitOrderFillBudget)
+	paramstore.Set(ctx, types.KeyIncentivesEpochIdentifier, types.DefaultIncentivesEpochIdentifier)
+
+	gaugeCreationFee := types.DefaultGaugeCreationFee
+	if paramstore.Has(ctx, types.KeyPoolCreationFee) {
+		paramstore.Get(ctx, types.KeyPoolCreationFee, &gaugeCreationFee)
+	}
+	paramstore.Set(ctx, types.KeyGaugeCreationFee, gaugeCreationFee)
+	return nil
+}
+
+// MigratePoolIds re-keys the pools from the id of their counterparty denom to
+// the id of both their denoms, and points their liquidity pool token denom to
+// the new id.
+func MigratePoolIds(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var pools []types.Pool
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(fmt.Sprintf("%s/", types.KeyPool)))
+	for ; iterator.Valid(); iterator.Next() {
+		var pool types.Pool
+		if err := cdc.Unmarshal(iterator.Value(), &pool); err != nil {
+			iterator.Close()
+			return err
+		}
+		pools = append(pools, pool)
+	}
+	iterator.Close()
+
+	for _, pool := range pools {
+		store.Delete(types.GetPoolKey(pool.Id))
+
+		pool.Id = types.GetPoolId(pool.StandardDenom, pool.CounterpartyDenom)
+		store.Set(types.GetPoolKey(pool.Id), cdc.MustMarshal(&pool))
+		store.Set(types.GetLptDenomKey(pool.LptDenom), cdc.MustMarshal(&gogoprototypes.StringValue{Value: pool.Id}))
+	}
+	return nil
+}
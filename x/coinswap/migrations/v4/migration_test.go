@@ -0,0 +1,117 @@
+package v4_test
+
+import (
+	"fmt"
+	"testing"
+
+	gogoprototypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/evmos/ethermint/encoding"
+
+	v4 "github.com/Canto-Network/Canto/v8/x/coinswap/migrations/v4"
+	coinswaptypes "github.com/Canto-Network/Canto/v8/x/coinswap/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	encCfg := encoding.MakeTestEncodingConfig()
+	coinswapKey := storetypes.NewKVStoreKey(coinswaptypes.StoreKey)
+	tCoinswapKey := storetypes.NewTransientStoreKey(fmt.Sprintf("%s_test", coinswaptypes.StoreKey))
+	ctx := testutil.DefaultContext(coinswapKey, tCoinswapKey)
+	paramstore := paramtypes.NewSubspace(
+		encCfg.Codec, encCfg.Amino, coinswapKey, tCoinswapKey, "coinswap",
+	)
+	paramstore = paramstore.WithKeyTable(coinswaptypes.ParamKeyTable())
+	require.True(t, paramstore.HasKeyTable())
+	poolCreationFee := sdk.NewInt64Coin("acanto", 100)
+	paramstore.Set(ctx, coinswaptypes.KeyPoolCreationFee, poolCreationFee)
+
+	// check no params
+	require.False(t, paramstore.Has(ctx, coinswaptypes.KeySwapProtocolFeeShare))
+	require.False(t, paramstore.Has(ctx, coinswaptypes.KeyPoolStatsEpochIdentifier))
+	require.False(t, paramstore.Has(ctx, coinswaptypes.KeyPriceDeviationEpochIdentifier))
+	require.False(t, paramstore.Has(ctx, coinswaptypes.KeyLimitOrderFillBudget))
+	require.False(t, paramstore.Has(ctx, coinswaptypes.KeyGaugeCreationFee))
+
+	// Run migrations
+	err := v4.UpdateParams(ctx, &paramstore)
+	require.NoError(t, err)
+
+	// Make sure the params are set
+	require.True(t, paramstore.Has(ctx, coinswaptypes.KeySwapProtocolFeeShare))
+	require.True(t, paramstore.Has(ctx, coinswaptypes.KeyPoolStatsEpochIdentifier))
+	require.True(t, paramstore.Has(ctx, coinswaptypes.KeyPriceDeviationEpochIdentifier))
+	require.True(t, paramstore.Has(ctx, coinswaptypes.KeyLimitOrderFillBudget))
+	require.True(t, paramstore.Has(ctx, coinswaptypes.KeyIncentivesEpochIdentifier))
+	require.True(t, paramstore.Has(ctx, coinswaptypes.KeyGaugeCreationFee))
+
+	var swapProtocolFeeShare sdkmath.LegacyDec
+	var poolStatsEpochIdentifier string
+	var priceDeviationEpochIdentifier string
+	var limitOrderFillBudget uint32
+	var incentivesEpochIdentifier string
+	var gaugeCreationFee sdk.Coin
+
+	// Make sure the new params are set
+	require.NotPanics(t, func() {
+		paramstore.Get(ctx, coinswaptypes.KeySwapProtocolFeeShare, &swapProtocolFeeShare)
+		paramstore.Get(ctx, coinswaptypes.KeyPoolStatsEpochIdentifier, &poolStatsEpochIdentifier)
+		paramstore.Get(ctx, coinswaptypes.KeyPriceDeviationEpochIdentifier, &priceDeviationEpochIdentifier)
+		paramstore.Get(ctx, coinswaptypes.KeyLimitOrderFillBudget, &limitOrderFillBudget)
+		paramstore.Get(ctx, coinswaptypes.KeyIncentivesEpochIdentifier, &incentivesEpochIdentifier)
+		paramstore.Get(ctx, coinswaptypes.KeyGaugeCreationFee, &gaugeCreationFee)
+	})
+
+	// check the params are updated
+	require.True(t, swapProtocolFeeShare.Equal(coinswaptypes.DefaultSwapProtocolFeeShare))
+	require.Equal(t, coinswaptypes.DefaultPoolStatsEpochIdentifier, poolStatsEpochIdentifier)
+	require.Equal(t, coinswaptypes.DefaultPriceDeviationEpochIdentifier, priceDeviationEpochIdentifier)
+	require.Equal(t, coinswaptypes.DefaultLimitOrderFillBudget, limitOrderFillBudget)
+	require.Equal(t, coinswaptypes.DefaultIncentivesEpochIdentifier, incentivesEpochIdentifier)
+	require.Equal(t, poolCreationFee, gaugeCreationFee)
+}
+
+func TestMigratePoolIds(t *testing.T) {
+	encCfg := encoding.MakeTestEncodingConfig()
+	coinswapKey := storetypes.NewKVStoreKey(coinswaptypes.StoreKey)
+	tCoinswapKey := storetypes.NewTransientStoreKey(fmt.Sprintf("%s_test", coinswaptypes.StoreKey))
+	ctx := testutil.DefaultContext(coinswapKey, tCoinswapKey)
+	store := ctx.KVStore(coinswapKey)
+
+	// store the pools under the ids of their counterparty denom
+	pools := []coinswaptypes.Pool{
+		{StandardDenom: "acanto", CounterpartyDenom: "uatom", LptDenom: coinswaptypes.GetLptDenom(1)},
+		{StandardDenom: "acanto", CounterpartyDenom: "ausdc", LptDenom: coinswaptypes.GetLptDenom(2)},
+	}
+	for i, pool := range pools {
+		pool.Id = fmt.Sprintf("pool-%s", pool.CounterpartyDenom)
+		pool.EscrowAddress = coinswaptypes.GetReservePoolAddr(pool.LptDenom).String()
+		store.Set(coinswaptypes.GetPoolKey(pool.Id), encCfg.Codec.MustMarshal(&pool))
+		store.Set(coinswaptypes.GetLptDenomKey(pool.LptDenom), encCfg.Codec.MustMarshal(&gogoprototypes.StringValue{Value: pool.Id}))
+		pools[i] = pool
+	}
+
+	// Run migrations
+	err := v4.MigratePoolIds(store, encCfg.Codec)
+	require.NoError(t, err)
+
+	for _, pool := range pools {
+		require.False(t, store.Has(coinswaptypes.GetPoolKey(pool.Id)))
+
+		poolId := coinswaptypes.GetPoolId(pool.StandardDenom, pool.CounterpartyDenom)
+		var migrated coinswaptypes.Pool
+		encCfg.Codec.MustUnmarshal(store.Get(coinswaptypes.GetPoolKey(poolId)), &migrated)
+		pool.Id = poolId
+		require.Equal(t, pool, migrated)
+
+		var lptPoolId gogoprototypes.StringValue
+		encCfg.Codec.MustUnmarshal(store.Get(coinswaptypes.GetLptDenomKey(pool.LptDenom)), &lptPoolId)
+		require.Equal(t, poolId, lptPoolId.Value)
+	}
+}
@@ -20,7 +20,7 @@ func TestCoinSwapStore(t *testing.T) {
 	dec := simulation.NewDecodeStore(cdc)
 
 	pool := types.Pool{
-		Id:                types.GetPoolId("denom1"),
+		Id:                types.GetPoolId("denom2", "denom1"),
 		StandardDenom:     "denom2",
 		CounterpartyDenom: "denom1",
 		EscrowAddress:     types.GetReservePoolAddr("lptDenom").String(),
@@ -19,6 +19,7 @@ const (
 	taxRate                = "tax_rate"
 	maxStandardCoinPerPool = "max_standard_coin_per_pool"
 	maxSwapAmount          = "max_swap_amount"
+	swapProtocolFeeShare   = "swap_protocol_fee_share"
 )
 
 func generateRandomFee(r *rand.Rand) sdkmath.LegacyDec {
@@ -45,6 +46,10 @@ func generateRandomMaxSwapAmount(r *rand.Rand) sdk.Coins {
 	)
 }
 
+func generateRandomSwapProtocolFeeShare(r *rand.Rand) sdkmath.LegacyDec {
+	return sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 100)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for coinswap
 func RandomizedGenState(simState *module.SimulationState) {
 	genesis := types.DefaultGenesisState()
@@ -74,6 +79,11 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { genesis.Params.MaxSwapAmount = generateRandomMaxSwapAmount(r) },
 	)
 
+	simState.AppParams.GetOrGenerate(
+		swapProtocolFeeShare, &genesis.Params.SwapProtocolFeeShare, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.SwapProtocolFeeShare = generateRandomSwapProtocolFeeShare(r) },
+	)
+
 	bz, _ := json.MarshalIndent(&genesis, "", " ")
 	fmt.Printf("Selected randomly generated coinswap parameters:\n%s\n", bz)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
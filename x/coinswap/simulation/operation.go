@@ -175,7 +175,11 @@ func SimulateMsgAddLiquidity(k keeper.Keeper, ak types.AccountKeeper, bk types.B
 			), nil, err
 		}
 
-		poolID := types.GetPoolId(maxToken.Denom)
+		var (
+			poolType      = types.POOL_TYPE_CONSTANT_PRODUCT
+			amplification uint64
+		)
+		poolID := types.GetPoolId(standardDenom, maxToken.Denom)
 		pool, has := k.GetPool(ctx, poolID)
 		if !has {
 			poolCreationFee := k.GetParams(ctx).PoolCreationFee
@@ -190,7 +194,11 @@ func SimulateMsgAddLiquidity(k keeper.Keeper, ak types.AccountKeeper, bk types.B
 					"insufficient funds",
 				), nil, err
 			}
-			minLiquidity = exactStandardAmt
+			if randBoolean(r) {
+				poolType = types.POOL_TYPE_STABLESWAP
+				amplification = uint64(simtypes.RandIntBetween(r, 1, 200))
+			}
+			minLiquidity = keeper.GetInitialLiquidity(poolType, amplification, exactStandardAmt, maxToken.Amount)
 		} else {
 			balances, err := k.GetPoolBalances(ctx, pool.EscrowAddress)
 			if err != nil {
@@ -217,6 +225,8 @@ func SimulateMsgAddLiquidity(k keeper.Keeper, ak types.AccountKeeper, bk types.B
 			deadline,
 			account.GetAddress().String(),
 		)
+		msg.PoolType = poolType
+		msg.Amplification = amplification
 
 		var fees sdk.Coins
 		coinsTemp, hasNeg := spendable.SafeSub(
@@ -378,6 +388,10 @@ func SimulateMsgSwapOrder(k keeper.Keeper, ak types.AccountKeeper, bk types.Bank
 			), nil, err
 		}
 
+		if err := k.ValidateSwapPriceDeviation(ctx, pool.LptDenom, inputCoin, outputCoin, !isBuyOrder); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSwapOrder, err.Error()), nil, nil
+		}
+
 		deadline := randDeadline(r)
 		msg := types.NewMsgSwapOrder(
 			types.Input{
@@ -603,13 +617,12 @@ func randBoolean(r *rand.Rand) bool {
 
 // A single swap bill
 func singleSwapBill(inputCoin, outputCoin sdk.Coin, ctx sdk.Context, k keeper.Keeper) (sdk.Coin, sdk.Coin, error) {
-	param := k.GetParams(ctx)
-
 	lptDenom, _ := k.GetLptDenomFromDenoms(ctx, outputCoin.Denom, inputCoin.Denom)
+	pool, _ := k.GetPoolByLptDenom(ctx, lptDenom)
 	reservePool, _ := k.GetPoolBalancesByLptDenom(ctx, lptDenom)
 	outputReserve := reservePool.AmountOf(outputCoin.Denom)
 	inputReserve := reservePool.AmountOf(inputCoin.Denom)
-	soldTokenAmt := keeper.GetOutputPrice(outputCoin.Amount, inputReserve, outputReserve, param.Fee)
+	soldTokenAmt := k.GetPoolOutputPrice(ctx, pool, outputCoin.Amount, inputReserve, outputReserve)
 
 	if soldTokenAmt.IsNegative() {
 		return sdk.Coin{}, sdk.Coin{}, errors.New("wrong token price calcualtion")
@@ -621,13 +634,12 @@ func singleSwapBill(inputCoin, outputCoin sdk.Coin, ctx sdk.Context, k keeper.Ke
 
 // A single swap sell order
 func singleSwapSellOrder(inputCoin, outputCoin sdk.Coin, ctx sdk.Context, k keeper.Keeper) (sdk.Coin, sdk.Coin, error) {
-	param := k.GetParams(ctx)
-
 	lptDenom, _ := k.GetLptDenomFromDenoms(ctx, inputCoin.Denom, outputCoin.Denom)
+	pool, _ := k.GetPoolByLptDenom(ctx, lptDenom)
 	reservePool, _ := k.GetPoolBalancesByLptDenom(ctx, lptDenom)
 	inputReserve := reservePool.AmountOf(inputCoin.Denom)
 	outputReserve := reservePool.AmountOf(outputCoin.Denom)
-	boughtTokenAmt := keeper.GetInputPrice(inputCoin.Amount, inputReserve, outputReserve, param.Fee)
+	boughtTokenAmt := k.GetPoolInputPrice(ctx, pool, inputCoin.Amount, inputReserve, outputReserve)
 
 	outputCoin = sdk.NewCoin(outputCoin.Denom, boughtTokenAmt)
 	return inputCoin, outputCoin, nil
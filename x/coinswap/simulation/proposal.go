@@ -13,9 +13,11 @@ import (
 
 // Simulation operation weights constants
 const (
-	DefaultWeightMsgUpdateParams int = 100
+	DefaultWeightMsgUpdateParams     int = 100
+	DefaultWeightMsgUpdatePoolParams int = 50
 
-	OpWeightMsgUpdateParams = "op_weight_msg_update_params"
+	OpWeightMsgUpdateParams     = "op_weight_msg_update_params"
+	OpWeightMsgUpdatePoolParams = "op_weight_msg_update_pool_params"
 )
 
 // ProposalMsgs defines the module weighted proposals' contents
@@ -26,6 +28,11 @@ func ProposalMsgs() []simtypes.WeightedProposalMsg {
 			DefaultWeightMsgUpdateParams,
 			SimulateMsgUpdateParams,
 		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdatePoolParams,
+			DefaultWeightMsgUpdatePoolParams,
+			SimulateMsgUpdatePoolParams,
+		),
 	}
 }
 
@@ -50,3 +57,20 @@ func SimulateMsgUpdateParams(r *rand.Rand, _ sdk.Context, _ []simtypes.Account)
 		Params:    params,
 	}
 }
+
+// SimulateMsgUpdatePoolParams returns a random MsgUpdatePoolParams, bounding the
+// price moves of one of the first pools created by the simulation
+func SimulateMsgUpdatePoolParams(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+	// use the default gov module account address as authority
+	var authority sdk.AccAddress = address.Module("gov")
+
+	maxBlockPriceDeviation := math.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100)), 3)
+	maxEpochPriceDeviation := maxBlockPriceDeviation.MulInt64(int64(simtypes.RandIntBetween(r, 1, 10)))
+
+	return &types.MsgUpdatePoolParams{
+		Authority:              authority.String(),
+		LptDenom:               types.GetLptDenom(uint64(simtypes.RandIntBetween(r, 1, 4))),
+		MaxBlockPriceDeviation: &maxBlockPriceDeviation,
+		MaxEpochPriceDeviation: &maxEpochPriceDeviation,
+	}
+}
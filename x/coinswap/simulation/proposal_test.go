@@ -24,7 +24,7 @@ func TestProposalMsgs(t *testing.T) {
 
 	// execute ProposalMsgs function
 	weightedProposalMsgs := simulation.ProposalMsgs()
-	require.Equal(t, 1, len(weightedProposalMsgs))
+	require.Equal(t, 2, len(weightedProposalMsgs))
 
 	w0 := weightedProposalMsgs[0]
 
@@ -45,4 +45,18 @@ func TestProposalMsgs(t *testing.T) {
 		sdk.NewCoin(types.UsdtIBCDenom, math.NewIntWithDecimal(22, 6)),
 		sdk.NewCoin(types.EthIBCDenom, math.NewIntWithDecimal(12, 16)),
 	), msgUpdateParams.Params.MaxSwapAmount)
+
+	w1 := weightedProposalMsgs[1]
+
+	// tests w1 interface:
+	require.Equal(t, simulation.OpWeightMsgUpdatePoolParams, w1.AppParamsKey())
+	require.Equal(t, simulation.DefaultWeightMsgUpdatePoolParams, w1.DefaultWeight())
+
+	msg = w1.MsgSimulatorFn()(r, ctx, accounts)
+	msgUpdatePoolParams, ok := msg.(*types.MsgUpdatePoolParams)
+	require.True(t, ok)
+	require.Equal(t, sdk.AccAddress(address.Module("gov")).String(), msgUpdatePoolParams.Authority)
+	require.NoError(t, types.ValidateLptDenom(msgUpdatePoolParams.LptDenom))
+	require.NoError(t, types.ValidatePriceDeviation(msgUpdatePoolParams.MaxBlockPriceDeviation))
+	require.True(t, msgUpdatePoolParams.MaxEpochPriceDeviation.GTE(*msgUpdatePoolParams.MaxBlockPriceDeviation))
 }
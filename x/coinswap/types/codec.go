@@ -24,7 +24,23 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSwapOrder{}, "canto/MsgSwapOrder", nil)
 	cdc.RegisterConcrete(&MsgAddLiquidity{}, "canto/MsgAddLiquidity", nil)
 	cdc.RegisterConcrete(&MsgRemoveLiquidity{}, "canto/MsgRemoveLiquidity", nil)
+	cdc.RegisterConcrete(&MsgSwapExactAmountInRoute{}, "canto/MsgSwapExactAmountInRoute", nil)
+	cdc.RegisterConcrete(&MsgSwapExactAmountOutRoute{}, "canto/MsgSwapExactAmountOutRoute", nil)
+	cdc.RegisterConcrete(&MsgAddLiquiditySingle{}, "canto/MsgAddLiquiditySingle", nil)
+	cdc.RegisterConcrete(&MsgRemoveLiquiditySingle{}, "canto/MsgRemoveLiquiditySingle", nil)
+	cdc.RegisterConcrete(&MsgPlaceLimitOrder{}, "canto/MsgPlaceLimitOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelLimitOrder{}, "canto/MsgCancelLimitOrder", nil)
+	cdc.RegisterConcrete(&MsgCreatePosition{}, "canto/MsgCreatePosition", nil)
+	cdc.RegisterConcrete(&MsgIncreasePosition{}, "canto/MsgIncreasePosition", nil)
+	cdc.RegisterConcrete(&MsgDecreasePosition{}, "canto/MsgDecreasePosition", nil)
+	cdc.RegisterConcrete(&MsgCollectFees{}, "canto/MsgCollectFees", nil)
+	cdc.RegisterConcrete(&MsgCreateGauge{}, "canto/MsgCreateGauge", nil)
+	cdc.RegisterConcrete(&MsgLockLiquidity{}, "canto/MsgLockLiquidity", nil)
+	cdc.RegisterConcrete(&MsgUnlockLiquidity{}, "canto/MsgUnlockLiquidity", nil)
+	cdc.RegisterConcrete(&MsgClaimLockRewards{}, "canto/MsgClaimLockRewards", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "canto/x/coinswap/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgUpdatePoolParams{}, "canto/x/coinswap/MsgUpdatePoolParams", nil)
+	cdc.RegisterConcrete(&MsgSetPoolStatus{}, "canto/x/coinswap/MsgSetPoolStatus", nil)
 	cdc.RegisterConcrete(&Params{}, "canto/x/coinswap/Params", nil)
 
 }
@@ -34,7 +50,23 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSwapOrder{},
 		&MsgAddLiquidity{},
 		&MsgRemoveLiquidity{},
+		&MsgSwapExactAmountInRoute{},
+		&MsgSwapExactAmountOutRoute{},
+		&MsgAddLiquiditySingle{},
+		&MsgRemoveLiquiditySingle{},
+		&MsgPlaceLimitOrder{},
+		&MsgCancelLimitOrder{},
+		&MsgCreatePosition{},
+		&MsgIncreasePosition{},
+		&MsgDecreasePosition{},
+		&MsgCollectFees{},
+		&MsgCreateGauge{},
+		&MsgLockLiquidity{},
+		&MsgUnlockLiquidity{},
+		&MsgClaimLockRewards{},
 		&MsgUpdateParams{},
+		&MsgUpdatePoolParams{},
+		&MsgSetPoolStatus{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
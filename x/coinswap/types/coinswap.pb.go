@@ -12,15 +12,20 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -28,6 +33,75 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PoolType defines the invariant used to price the swaps of a pool
+type PoolType int32
+
+const (
+	// POOL_TYPE_CONSTANT_PRODUCT prices swaps with the x*y=k invariant
+	POOL_TYPE_CONSTANT_PRODUCT PoolType = 0
+	// POOL_TYPE_STABLESWAP prices swaps with the stableswap invariant, for coins
+	// of the same value and precision
+	POOL_TYPE_STABLESWAP PoolType = 1
+	// POOL_TYPE_CONCENTRATED prices swaps with the liquidity of the positions
+	// in range of the current price, positions are not fungible and are not
+	// represented by the liquidity pool coin
+	POOL_TYPE_CONCENTRATED PoolType = 2
+)
+
+var PoolType_name = map[int32]string{
+	0: "POOL_TYPE_CONSTANT_PRODUCT",
+	1: "POOL_TYPE_STABLESWAP",
+	2: "POOL_TYPE_CONCENTRATED",
+}
+
+var PoolType_value = map[string]int32{
+	"POOL_TYPE_CONSTANT_PRODUCT": 0,
+	"POOL_TYPE_STABLESWAP":       1,
+	"POOL_TYPE_CONCENTRATED":     2,
+}
+
+func (x PoolType) String() string {
+	return proto.EnumName(PoolType_name, int32(x))
+}
+
+func (PoolType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b57883b6d1fc5094, []int{0}
+}
+
+// PoolStatus defines the operations allowed on a pool
+type PoolStatus int32
+
+const (
+	// POOL_STATUS_ACTIVE allows all the operations
+	POOL_STATUS_ACTIVE PoolStatus = 0
+	// POOL_STATUS_SWAPS_PAUSED rejects the swaps, liquidity can still be added
+	// and removed
+	POOL_STATUS_SWAPS_PAUSED PoolStatus = 1
+	// POOL_STATUS_WITHDRAW_ONLY rejects the swaps and the deposits, liquidity
+	// can only be removed
+	POOL_STATUS_WITHDRAW_ONLY PoolStatus = 2
+)
+
+var PoolStatus_name = map[int32]string{
+	0: "POOL_STATUS_ACTIVE",
+	1: "POOL_STATUS_SWAPS_PAUSED",
+	2: "POOL_STATUS_WITHDRAW_ONLY",
+}
+
+var PoolStatus_value = map[string]int32{
+	"POOL_STATUS_ACTIVE":        0,
+	"POOL_STATUS_SWAPS_PAUSED":  1,
+	"POOL_STATUS_WITHDRAW_ONLY": 2,
+}
+
+func (x PoolStatus) String() string {
+	return proto.EnumName(PoolStatus_name, int32(x))
+}
+
+func (PoolStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b57883b6d1fc5094, []int{1}
+}
+
 // Input defines the properties of order's input
 type Input struct {
 	Address string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -116,6 +190,19 @@ type Pool struct {
 	EscrowAddress string `protobuf:"bytes,4,opt,name=escrow_address,json=escrowAddress,proto3" json:"escrow_address,omitempty"`
 	// denom of the liquidity pool coin
 	LptDenom string `protobuf:"bytes,5,opt,name=lpt_denom,json=lptDenom,proto3" json:"lpt_denom,omitempty"`
+	// swap fee of the pool, overriding the fee param when set
+	Fee *cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=fee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee,omitempty"`
+	// invariant of the pool
+	PoolType PoolType `protobuf:"varint,7,opt,name=pool_type,json=poolType,proto3,enum=canto.coinswap.v1.PoolType" json:"pool_type,omitempty"`
+	// amplification coefficient of a stableswap pool
+	Amplification uint64 `protobuf:"varint,8,opt,name=amplification,proto3" json:"amplification,omitempty"`
+	// operations allowed on the pool, set by governance
+	Status PoolStatus `protobuf:"varint,9,opt,name=status,proto3,enum=canto.coinswap.v1.PoolStatus" json:"status,omitempty"`
+	// max relative move of the spot price within a block, unbounded when unset
+	MaxBlockPriceDeviation *cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=max_block_price_deviation,json=maxBlockPriceDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_block_price_deviation,omitempty"`
+	// max relative move of the spot price within a price deviation epoch,
+	// unbounded when unset
+	MaxEpochPriceDeviation *cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=max_epoch_price_deviation,json=maxEpochPriceDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_epoch_price_deviation,omitempty"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
@@ -158,6 +245,23 @@ type Params struct {
 	TaxRate                cosmossdk_io_math.LegacyDec              `protobuf:"bytes,3,opt,name=tax_rate,json=taxRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"tax_rate"`
 	MaxStandardCoinPerPool cosmossdk_io_math.Int                    `protobuf:"bytes,4,opt,name=max_standard_coin_per_pool,json=maxStandardCoinPerPool,proto3,customtype=cosmossdk.io/math.Int" json:"max_standard_coin_per_pool"`
 	MaxSwapAmount          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=max_swap_amount,json=maxSwapAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_swap_amount"`
+	// share of the swap fee sent to the fee collector instead of the pool
+	SwapProtocolFeeShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=swap_protocol_fee_share,json=swapProtocolFeeShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"swap_protocol_fee_share"`
+	// identifier of the epoch at the end of which the pool statistics are
+	// rolled up, the statistics are not recorded when empty
+	PoolStatsEpochIdentifier string `protobuf:"bytes,8,opt,name=pool_stats_epoch_identifier,json=poolStatsEpochIdentifier,proto3" json:"pool_stats_epoch_identifier,omitempty"`
+	// identifier of the epoch at the end of which the epoch reference prices of
+	// the pool circuit breakers are reset, the epoch bound is not enforced when
+	// empty
+	PriceDeviationEpochIdentifier string `protobuf:"bytes,9,opt,name=price_deviation_epoch_identifier,json=priceDeviationEpochIdentifier,proto3" json:"price_deviation_epoch_identifier,omitempty"`
+	// maximum number of open limit orders examined at the end of each block
+	LimitOrderFillBudget uint32 `protobuf:"varint,10,opt,name=limit_order_fill_budget,json=limitOrderFillBudget,proto3" json:"limit_order_fill_budget,omitempty"`
+	// identifier of the epoch at the end of which the gauges distribute their
+	// rewards to the locks, the rewards are not distributed when empty
+	IncentivesEpochIdentifier string `protobuf:"bytes,11,opt,name=incentives_epoch_identifier,json=incentivesEpochIdentifier,proto3" json:"incentives_epoch_identifier,omitempty"`
+	// fee paid to create a gauge, split between the fee collector and burning
+	// like the pool creation fee
+	GaugeCreationFee types.Coin `protobuf:"bytes,12,opt,name=gauge_creation_fee,json=gaugeCreationFee,proto3" json:"gauge_creation_fee"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -192,56 +296,685 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// ProtocolFee defines the protocol fees accumulated from the swaps of a pool
+type ProtocolFee struct {
+	PoolId string                                   `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Fees   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *ProtocolFee) Reset()         { *m = ProtocolFee{} }
+func (m *ProtocolFee) String() string { return proto.CompactTextString(m) }
+func (*ProtocolFee) ProtoMessage()    {}
+func (*ProtocolFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b57883b6d1fc5094, []int{4}
+}
+func (m *ProtocolFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProtocolFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProtocolFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProtocolFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtocolFee.Merge(m, src)
+}
+func (m *ProtocolFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProtocolFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtocolFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtocolFee proto.InternalMessageInfo
+
+// TwapRecord defines a snapshot of the cumulative price of a pool, taken at
+// the first swap or liquidity change of a block
+type TwapRecord struct {
+	PoolId string    `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Height int64     `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// sum of the price of the counterparty coin in the standard coin, weighted
+	// by the seconds each price was effective
+	PriceCumulative cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=price_cumulative,json=priceCumulative,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price_cumulative"`
+}
+
+func (m *TwapRecord) Reset()         { *m = TwapRecord{} }
+func (m *TwapRecord) String() string { return proto.CompactTextString(m) }
+func (*TwapRecord) ProtoMessage()    {}
+func (*TwapRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b57883b6d1fc5094, []int{5}
+}
+func (m *TwapRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapRecord.Merge(m, src)
+}
+func (m *TwapRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapRecord proto.InternalMessageInfo
+
+// PoolStats defines the activity of a pool during an epoch
+type PoolStats struct {
+	PoolId string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// number of the epoch, zero for the ongoing epoch
+	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// number of swaps through the pool
+	SwapCount uint64 `protobuf:"varint,3,opt,name=swap_count,json=swapCount,proto3" json:"swap_count,omitempty"`
+	// amount of standard coin sold to or bought from the pool
+	StandardVolume cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=standard_volume,json=standardVolume,proto3,customtype=cosmossdk.io/math.Int" json:"standard_volume"`
+	// amount of counterparty coin sold to or bought from the pool
+	TokenVolume cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=token_volume,json=tokenVolume,proto3,customtype=cosmossdk.io/math.Int" json:"token_volume"`
+	// swap fees charged on the coins sold to the pool, protocol fees included
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	// supply of the liquidity pool coin at the end of the epoch
+	Liquidity cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=liquidity,proto3,customtype=cosmossdk.io/math.Int" json:"liquidity"`
+}
+
+func (m *PoolStats) Reset()         { *m = PoolStats{} }
+func (m *PoolStats) String() string { return proto.CompactTextString(m) }
+func (*PoolStats) ProtoMessage()    {}
+func (*PoolStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b57883b6d1fc5094, []int{6}
+}
+func (m *PoolStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolStats.Merge(m, src)
+}
+func (m *PoolStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolStats proto.InternalMessageInfo
+
+// PriceReference defines the spot prices of a pool that its swaps are bounded
+// against by the circuit breaker
+type PriceReference struct {
+	PoolId string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// height of the block of the block reference price
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// spot price of the counterparty coin in the standard coin before the first
+	// swap of the block
+	BlockPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=block_price,json=blockPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"block_price"`
+	// spot price of the counterparty coin in the standard coin before the first
+	// swap of the epoch
+	EpochPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=epoch_price,json=epochPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"epoch_price"`
+}
+
+func (m *PriceReference) Reset()         { *m = PriceReference{} }
+func (m *PriceReference) String() string { return proto.CompactTextString(m) }
+func (*PriceReference) ProtoMessage()    {}
+func (*PriceReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b57883b6d1fc5094, []int{7}
+}
+func (m *PriceReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceReference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceReference.Merge(m, src)
+}
+func (m *PriceReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceReference proto.InternalMessageInfo
+
+// LimitOrder defines an order resting against a pool until the pool can sell
+// at least min_token_out for token_in
+type LimitOrder struct {
+	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// liquidity pool token denom of the pool the order rests against
+	LptDenom string `protobuf:"bytes,3,opt,name=lpt_denom,json=lptDenom,proto3" json:"lpt_denom,omitempty"`
+	// coin escrowed in the module account and sold when the order is filled
+	TokenIn types.Coin `protobuf:"bytes,4,opt,name=token_in,json=tokenIn,proto3" json:"token_in"`
+	// minimum coin bought when the order is filled
+	MinTokenOut types.Coin `protobuf:"bytes,5,opt,name=min_token_out,json=minTokenOut,proto3" json:"min_token_out"`
+	// unix time after which the order is cancelled and token_in refunded
+	Deadline int64 `protobuf:"varint,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *LimitOrder) Reset()         { *m = LimitOrder{} }
+func (m *LimitOrder) String() string { return proto.CompactTextString(m) }
+func (*LimitOrder) ProtoMessage()    {}
+func (*LimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b57883b6d1fc5094, []int{8}
+}
+func (m *LimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrder.Merge(m, src)
+}
+func (m *LimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrder proto.InternalMessageInfo
+
+// ConcentratedPoolState defines the pricing state of a concentrated liquidity
+// pool
+type ConcentratedPoolState struct {
+	PoolId string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// square root of the price of the counterparty coin in the standard coin
+	SqrtPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=sqrt_price,json=sqrtPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"sqrt_price"`
+	// greatest tick whose price is lower than or equal to the current price
+	CurrentTick int64 `protobuf:"varint,3,opt,name=current_tick,json=currentTick,proto3" json:"current_tick,omitempty"`
+	// liquidity of the positions in range of the current price
+	Liquidity cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=liquidity,proto3,customtype=cosmossdk.io/math.Int" json:"liquidity"`
+	// swap fees of the standard coin earned per unit of liquidity since the
+	// creation of the pool
+	FeeGrowthGlobalStandard cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=fee_growth_global_standard,json=feeGrowthGlobalStandard,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_growth_global_standard"`
+	// swap fees of the counterparty coin earned per unit of liquidity since the
+	// creation of the pool
+	FeeGrowthGlobalToken cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=fee_growth_global_token,json=feeGrowthGlobalToken,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_growth_global_token"`
+}
+
+func (m *ConcentratedPoolState) Reset()         { *m = ConcentratedPoolState{} }
+func (m *ConcentratedPoolState) String() string { return proto.CompactTextString(m) }
+func (*ConcentratedPoolState) ProtoMessage()    {}
+func (*ConcentratedPoolState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b57883b6d1fc5094, []int{9}
+}
+func (m *ConcentratedPoolState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConcentratedPoolState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConcentratedPoolState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConcentratedPoolState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConcentratedPoolState.Merge(m, src)
+}
+func (m *ConcentratedPoolState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConcentratedPoolState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConcentratedPoolState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConcentratedPoolState proto.InternalMessageInfo
+
+// Tick defines a price boundary of the positions of a concentrated liquidity
+// pool
+type Tick struct {
+	PoolId string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// index of the tick, the price of the tick is 1.0001^index
+	Index int64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// liquidity of the positions bounded by the tick
+	LiquidityGross cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=liquidity_gross,json=liquidityGross,proto3,customtype=cosmossdk.io/math.Int" json:"liquidity_gross"`
+	// liquidity added to the pool when the price crosses the tick upwards,
+	// removed when it crosses downwards
+	LiquidityNet cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=liquidity_net,json=liquidityNet,proto3,customtype=cosmossdk.io/math.Int" json:"liquidity_net"`
+	// fee growth of the standard coin on the other side of the tick from the
+	// current price
+	FeeGrowthOutsideStandard cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=fee_growth_outside_standard,json=feeGrowthOutsideStandard,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_growth_outside_standard"`
+	// fee growth of the counterparty coin on the other side of the tick from
+	// the current price
+	FeeGrowthOutsideToken cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=fee_growth_outside_token,json=feeGrowthOutsideToken,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_growth_outside_token"`
+}
+
+func (m *Tick) Reset()         { *m = Tick{} }
+func (m *Tick) String() string { return proto.CompactTextString(m) }
+func (*Tick) ProtoMessage()    {}
+func (*Tick) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b57883b6d1fc5094, []int{10}
+}
+func (m *Tick) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Tick) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Tick.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Tick) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tick.Merge(m, src)
+}
+func (m *Tick) XXX_Size() int {
+	return m.Size()
+}
+func (m *Tick) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tick.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tick proto.InternalMessageInfo
+
+// Position defines the liquidity provided to a concentrated liquidity pool
+// between two ticks
+type Position struct {
+	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// liquidity pool token denom of the pool of the position
+	LptDenom  string                `protobuf:"bytes,3,opt,name=lpt_denom,json=lptDenom,proto3" json:"lpt_denom,omitempty"`
+	LowerTick int64                 `protobuf:"varint,4,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty"`
+	UpperTick int64                 `protobuf:"varint,5,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty"`
+	Liquidity cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=liquidity,proto3,customtype=cosmossdk.io/math.Int" json:"liquidity"`
+	// fee growth of the standard coin inside the range of the position when
+	// its fees were last accrued
+	FeeGrowthInsideStandardLast cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=fee_growth_inside_standard_last,json=feeGrowthInsideStandardLast,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_growth_inside_standard_last"`
+	// fee growth of the counterparty coin inside the range of the position
+	// when its fees were last accrued
+	FeeGrowthInsideTokenLast cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=fee_growth_inside_token_last,json=feeGrowthInsideTokenLast,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_growth_inside_token_last"`
+	// fees accrued and not collected yet
+	TokensOwed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=tokens_owed,json=tokensOwed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_owed"`
+}
+
+func (m *Position) Reset()         { *m = Position{} }
+func (m *Position) String() string { return proto.CompactTextString(m) }
+func (*Position) ProtoMessage()    {}
+func (*Position) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b57883b6d1fc5094, []int{11}
+}
+func (m *Position) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Position) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Position.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Position) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Position.Merge(m, src)
+}
+func (m *Position) XXX_Size() int {
+	return m.Size()
+}
+func (m *Position) XXX_DiscardUnknown() {
+	xxx_messageInfo_Position.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Position proto.InternalMessageInfo
+
+// Gauge defines reward coins distributed to the locks of a liquidity pool coin
+// over a number of epochs
+type Gauge struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// address of the account that funded the gauge
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// liquidity pool coin denom of the locks rewarded by the gauge
+	LptDenom string `protobuf:"bytes,3,opt,name=lpt_denom,json=lptDenom,proto3" json:"lpt_denom,omitempty"`
+	// reward coins escrowed in the module account by the gauge
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// number of epochs the rewards are distributed over
+	NumEpochs uint64 `protobuf:"varint,5,opt,name=num_epochs,json=numEpochs,proto3" json:"num_epochs,omitempty"`
+	// number of epochs ended since the creation of the gauge, including the
+	// epochs during which nothing was locked
+	FilledEpochs uint64 `protobuf:"varint,6,opt,name=filled_epochs,json=filledEpochs,proto3" json:"filled_epochs,omitempty"`
+	// reward coins distributed to the locks so far
+	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins"`
+}
+
+func (m *Gauge) Reset()         { *m = Gauge{} }
+func (m *Gauge) String() string { return proto.CompactTextString(m) }
+func (*Gauge) ProtoMessage()    {}
+func (*Gauge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b57883b6d1fc5094, []int{12}
+}
+func (m *Gauge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Gauge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Gauge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Gauge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Gauge.Merge(m, src)
+}
+func (m *Gauge) XXX_Size() int {
+	return m.Size()
+}
+func (m *Gauge) XXX_DiscardUnknown() {
+	xxx_messageInfo_Gauge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Gauge proto.InternalMessageInfo
+
+// Lock defines liquidity pool coins escrowed in the module account until the
+// end of the lock duration, earning the rewards of the gauges of their denom
+type Lock struct {
+	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// liquidity pool coins locked
+	Coin types.Coin `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin"`
+	// duration the coins are locked for
+	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration"`
+	// time after which the coins can be unlocked
+	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// rewards per locked coin of the denom when the rewards of the lock were
+	// last accrued
+	RewardPerShareLast github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=reward_per_share_last,json=rewardPerShareLast,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward_per_share_last"`
+	// rewards accrued and not claimed yet
+	RewardsOwed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=rewards_owed,json=rewardsOwed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards_owed"`
+}
+
+func (m *Lock) Reset()         { *m = Lock{} }
+func (m *Lock) String() string { return proto.CompactTextString(m) }
+func (*Lock) ProtoMessage()    {}
+func (*Lock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b57883b6d1fc5094, []int{13}
+}
+func (m *Lock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Lock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Lock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Lock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Lock.Merge(m, src)
+}
+func (m *Lock) XXX_Size() int {
+	return m.Size()
+}
+func (m *Lock) XXX_DiscardUnknown() {
+	xxx_messageInfo_Lock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Lock proto.InternalMessageInfo
+
+// LockRewards defines the rewards distributed to the locks of a liquidity pool
+// coin denom
+type LockRewards struct {
+	LptDenom string `protobuf:"bytes,1,opt,name=lpt_denom,json=lptDenom,proto3" json:"lpt_denom,omitempty"`
+	// amount of the liquidity pool coin locked
+	TotalLocked cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_locked,json=totalLocked,proto3,customtype=cosmossdk.io/math.Int" json:"total_locked"`
+	// rewards distributed per locked coin since the first lock of the denom
+	RewardPerShare github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=reward_per_share,json=rewardPerShare,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward_per_share"`
+}
+
+func (m *LockRewards) Reset()         { *m = LockRewards{} }
+func (m *LockRewards) String() string { return proto.CompactTextString(m) }
+func (*LockRewards) ProtoMessage()    {}
+func (*LockRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b57883b6d1fc5094, []int{14}
+}
+func (m *LockRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockRewards.Merge(m, src)
+}
+func (m *LockRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockRewards proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("canto.coinswap.v1.PoolType", PoolType_name, PoolType_value)
+	proto.RegisterEnum("canto.coinswap.v1.PoolStatus", PoolStatus_name, PoolStatus_value)
 	proto.RegisterType((*Input)(nil), "canto.coinswap.v1.Input")
 	proto.RegisterType((*Output)(nil), "canto.coinswap.v1.Output")
 	proto.RegisterType((*Pool)(nil), "canto.coinswap.v1.Pool")
 	proto.RegisterType((*Params)(nil), "canto.coinswap.v1.Params")
+	proto.RegisterType((*ProtocolFee)(nil), "canto.coinswap.v1.ProtocolFee")
+	proto.RegisterType((*TwapRecord)(nil), "canto.coinswap.v1.TwapRecord")
+	proto.RegisterType((*PoolStats)(nil), "canto.coinswap.v1.PoolStats")
+	proto.RegisterType((*PriceReference)(nil), "canto.coinswap.v1.PriceReference")
+	proto.RegisterType((*LimitOrder)(nil), "canto.coinswap.v1.LimitOrder")
+	proto.RegisterType((*ConcentratedPoolState)(nil), "canto.coinswap.v1.ConcentratedPoolState")
+	proto.RegisterType((*Tick)(nil), "canto.coinswap.v1.Tick")
+	proto.RegisterType((*Position)(nil), "canto.coinswap.v1.Position")
+	proto.RegisterType((*Gauge)(nil), "canto.coinswap.v1.Gauge")
+	proto.RegisterType((*Lock)(nil), "canto.coinswap.v1.Lock")
+	proto.RegisterType((*LockRewards)(nil), "canto.coinswap.v1.LockRewards")
 }
 
 func init() { proto.RegisterFile("canto/coinswap/v1/coinswap.proto", fileDescriptor_b57883b6d1fc5094) }
 
 var fileDescriptor_b57883b6d1fc5094 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xcd, 0x6b, 0x13, 0x41,
-	0x14, 0xdf, 0x6d, 0xd3, 0xb4, 0x19, 0x6d, 0x4b, 0x16, 0x3f, 0xd2, 0x16, 0x36, 0x21, 0x58, 0x28,
-	0x85, 0xec, 0xd2, 0x16, 0x44, 0xbc, 0x35, 0x09, 0xc5, 0x82, 0x68, 0x8c, 0xa0, 0xe0, 0xc1, 0xe5,
-	0x65, 0x77, 0x4c, 0x97, 0x64, 0x77, 0x96, 0x99, 0xc9, 0xd7, 0x9f, 0x50, 0x4f, 0x1e, 0xc5, 0x53,
-	0x8f, 0xe2, 0x29, 0x07, 0xff, 0x88, 0x1c, 0x8b, 0x27, 0xf1, 0x50, 0x35, 0x39, 0xc4, 0x3f, 0x43,
-	0xe6, 0x23, 0x31, 0x20, 0x28, 0x08, 0x5e, 0x76, 0x67, 0xde, 0xbc, 0xf7, 0xfb, 0x78, 0x33, 0x0f,
-	0x15, 0x7c, 0x88, 0x39, 0x71, 0x7d, 0x12, 0xc6, 0xac, 0x07, 0x89, 0xdb, 0x3d, 0x98, 0xaf, 0x9d,
-	0x84, 0x12, 0x4e, 0xac, 0xac, 0xcc, 0x70, 0xe6, 0xd1, 0xee, 0xc1, 0xb6, 0xed, 0x13, 0x16, 0x11,
-	0xe6, 0x36, 0x80, 0x61, 0xb7, 0x7b, 0xd0, 0xc0, 0x1c, 0x54, 0x99, 0x2a, 0xd9, 0xbe, 0xd1, 0x24,
-	0x4d, 0x22, 0x97, 0xae, 0x58, 0xe9, 0xe8, 0x96, 0xaa, 0xf2, 0xd4, 0x81, 0xda, 0xe8, 0xa3, 0x2c,
-	0x44, 0x61, 0x4c, 0x5c, 0xf9, 0x55, 0xa1, 0xe2, 0x33, 0xb4, 0x72, 0x1a, 0x27, 0x1d, 0x6e, 0xe5,
-	0xd0, 0x2a, 0x04, 0x01, 0xc5, 0x8c, 0xe5, 0xcc, 0x82, 0xb9, 0x97, 0xa9, 0xcf, 0xb6, 0xd6, 0x11,
-	0x4a, 0x09, 0xd2, 0xdc, 0x52, 0xc1, 0xdc, 0xbb, 0x76, 0xb8, 0xe5, 0x68, 0x48, 0xa1, 0xca, 0xd1,
-	0xaa, 0x9c, 0x0a, 0x09, 0xe3, 0x72, 0x6a, 0x74, 0x95, 0x37, 0xea, 0x32, 0xb9, 0xf8, 0x1c, 0xa5,
-	0x1f, 0x77, 0xf8, 0x7f, 0x00, 0x1e, 0x9a, 0x28, 0x55, 0x23, 0xa4, 0x6d, 0x6d, 0xa0, 0xa5, 0x30,
-	0xd0, 0x90, 0x4b, 0x61, 0x60, 0xed, 0xa2, 0x0d, 0xc6, 0x21, 0x0e, 0x80, 0x06, 0x5e, 0x80, 0x63,
-	0x12, 0x49, 0xdc, 0x4c, 0x7d, 0x7d, 0x16, 0xad, 0x8a, 0xa0, 0x55, 0x42, 0x96, 0x4f, 0x3a, 0x31,
-	0xc7, 0x34, 0x01, 0xca, 0x07, 0x3a, 0x75, 0x59, 0xa6, 0x66, 0x17, 0x4f, 0x54, 0xfa, 0x2e, 0xda,
-	0xc0, 0xcc, 0xa7, 0xa4, 0xe7, 0xcd, 0x4c, 0xa4, 0x14, 0xaa, 0x8a, 0x1e, 0x6b, 0x2b, 0x3b, 0x28,
-	0xd3, 0x4e, 0xb8, 0x06, 0x5b, 0x91, 0x19, 0x6b, 0xed, 0x84, 0x4b, 0x8c, 0xe2, 0x79, 0x0a, 0xa5,
-	0x6b, 0x40, 0x21, 0x62, 0xd6, 0x03, 0xb4, 0xfc, 0x0a, 0x63, 0xa5, 0xba, 0x7c, 0x57, 0xd8, 0xfa,
-	0x72, 0x95, 0xdf, 0x51, 0xc6, 0x59, 0xd0, 0x72, 0x42, 0xe2, 0x46, 0xc0, 0xcf, 0x9c, 0x87, 0xb8,
-	0x09, 0xfe, 0xa0, 0x8a, 0xfd, 0x4f, 0x1f, 0x4b, 0x48, 0xf7, 0xa5, 0x8a, 0xfd, 0xf7, 0xd3, 0xe1,
-	0xbe, 0x59, 0x17, 0x10, 0x56, 0x0d, 0x65, 0x13, 0x42, 0xda, 0x9e, 0x4f, 0x31, 0xf0, 0x90, 0xc4,
-	0x9e, 0xc0, 0xfd, 0x6b, 0x27, 0x33, 0x82, 0x52, 0xa1, 0x6c, 0x8a, 0xf2, 0x8a, 0xae, 0x3e, 0xc1,
-	0xd8, 0x7a, 0x82, 0xd6, 0x38, 0xf4, 0x3d, 0x0a, 0x1c, 0xab, 0x7e, 0xfc, 0xb3, 0xc0, 0x55, 0x0e,
-	0xfd, 0x3a, 0x70, 0x6c, 0xbd, 0x44, 0xdb, 0x11, 0xf4, 0xbd, 0xf9, 0xbd, 0x88, 0x1b, 0xf4, 0x12,
-	0x4c, 0x3d, 0xc1, 0xad, 0x3a, 0x59, 0x2e, 0x6a, 0x92, 0x9b, 0xbf, 0x93, 0x9c, 0xc6, 0x5c, 0x01,
-	0xde, 0x8a, 0xa0, 0xff, 0x54, 0x83, 0x08, 0x1f, 0x35, 0x4c, 0xe5, 0x1b, 0x38, 0x37, 0xd1, 0xa6,
-	0x24, 0xe8, 0x41, 0xe2, 0x41, 0x24, 0x6e, 0x2f, 0x97, 0x2e, 0x2c, 0xff, 0xb9, 0x07, 0x27, 0x82,
-	0xf0, 0xc3, 0xd7, 0xfc, 0x5e, 0x33, 0xe4, 0x67, 0x9d, 0x86, 0xe3, 0x93, 0x48, 0x8f, 0x89, 0xfe,
-	0x95, 0x58, 0xd0, 0x72, 0xf9, 0x20, 0xc1, 0x4c, 0x16, 0xb0, 0x77, 0xd3, 0xe1, 0xfe, 0xf5, 0xb6,
-	0x34, 0x2c, 0x1d, 0x30, 0x25, 0x6a, 0x5d, 0x88, 0xea, 0x41, 0x72, 0x2c, 0x79, 0xef, 0xdf, 0x79,
-	0x7b, 0x91, 0x37, 0x7e, 0x5c, 0xe4, 0xcd, 0xd7, 0xd3, 0xe1, 0xfe, 0x6d, 0x35, 0xef, 0xfd, 0x5f,
-	0x13, 0xaf, 0x1e, 0x40, 0xb9, 0x36, 0xfa, 0x6e, 0x1b, 0xa3, 0xb1, 0x6d, 0x5e, 0x8e, 0x6d, 0xf3,
-	0xdb, 0xd8, 0x36, 0xdf, 0x4c, 0x6c, 0xe3, 0x72, 0x62, 0x1b, 0x9f, 0x27, 0xb6, 0xf1, 0xe2, 0x70,
-	0x41, 0x52, 0x45, 0x20, 0x94, 0x1e, 0x61, 0xde, 0x23, 0xb4, 0xa5, 0x76, 0x6e, 0xf7, 0xde, 0x22,
-	0xa4, 0x94, 0xd8, 0x48, 0xcb, 0x41, 0x3e, 0xfa, 0x19, 0x00, 0x00, 0xff, 0xff, 0x98, 0x33, 0xcf,
-	0x3c, 0x63, 0x04, 0x00, 0x00,
+	// 1985 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0xad, 0xef, 0x27, 0xdb, 0x71, 0x06, 0x4e, 0xcc, 0xc8, 0xb1, 0xec, 0x55, 0x77, 0x81,
+	0x20, 0x8b, 0x48, 0x4d, 0x82, 0x2d, 0x82, 0x05, 0xda, 0x42, 0x96, 0x9c, 0xac, 0x00, 0xc3, 0x52,
+	0x29, 0x25, 0xc1, 0x16, 0x45, 0x09, 0x8a, 0x1c, 0xcb, 0xac, 0x49, 0x0e, 0x43, 0x0e, 0x2d, 0x07,
+	0xbd, 0x16, 0xe8, 0xc7, 0x69, 0x8f, 0x8b, 0x9e, 0x16, 0xe8, 0xa5, 0xe8, 0x29, 0x87, 0x1e, 0x0a,
+	0xf4, 0x1f, 0x08, 0xda, 0xcb, 0xa2, 0x40, 0x81, 0x62, 0x0f, 0xd9, 0x36, 0x39, 0x64, 0x8b, 0xf6,
+	0xde, 0x6b, 0x31, 0x6f, 0x28, 0x5a, 0x92, 0x93, 0x6c, 0x64, 0x3b, 0x17, 0x5b, 0xf3, 0x66, 0xde,
+	0xef, 0x7d, 0xcf, 0x7b, 0x43, 0xd8, 0x34, 0x0d, 0x8f, 0xb3, 0x9a, 0xc9, 0x6c, 0x2f, 0x1c, 0x1a,
+	0x7e, 0xed, 0xf0, 0x66, 0xf2, 0xbb, 0xea, 0x07, 0x8c, 0x33, 0x72, 0x11, 0x4f, 0x54, 0x13, 0xea,
+	0xe1, 0xcd, 0x52, 0xd9, 0x64, 0xa1, 0xcb, 0xc2, 0x5a, 0xdf, 0x08, 0x69, 0xed, 0xf0, 0x66, 0x9f,
+	0x72, 0x43, 0xb2, 0x49, 0x96, 0xd2, 0xca, 0x80, 0x0d, 0x18, 0xfe, 0xac, 0x89, 0x5f, 0x31, 0xf5,
+	0x8a, 0xe4, 0xd2, 0xe5, 0x86, 0x5c, 0xc4, 0x5b, 0x17, 0x0d, 0xd7, 0xf6, 0x58, 0x0d, 0xff, 0xc6,
+	0xa4, 0x8d, 0x01, 0x63, 0x03, 0x87, 0xd6, 0x70, 0xd5, 0x8f, 0xf6, 0x6a, 0xdc, 0x76, 0x69, 0xc8,
+	0x0d, 0x37, 0xd6, 0xab, 0x54, 0x9e, 0x3e, 0x60, 0x45, 0x81, 0xc1, 0x6d, 0x16, 0x2b, 0x51, 0x79,
+	0x00, 0x99, 0x96, 0xe7, 0x47, 0x9c, 0xa8, 0x90, 0x33, 0x2c, 0x2b, 0xa0, 0x61, 0xa8, 0x2a, 0x9b,
+	0xca, 0xb5, 0x82, 0x36, 0x5a, 0x92, 0xdb, 0x90, 0x16, 0x5a, 0xab, 0xf3, 0x9b, 0xca, 0xb5, 0xe2,
+	0xad, 0x2b, 0xd5, 0x58, 0x27, 0x61, 0x56, 0x35, 0x36, 0xab, 0xda, 0x60, 0xb6, 0xb7, 0x95, 0x7e,
+	0xfa, 0x6c, 0x63, 0x4e, 0xc3, 0xc3, 0x95, 0x87, 0x90, 0x6d, 0x47, 0xfc, 0x1d, 0x00, 0x7f, 0x93,
+	0x86, 0x74, 0x87, 0x31, 0x87, 0x2c, 0xc1, 0xbc, 0x6d, 0xc5, 0x90, 0xf3, 0xb6, 0x45, 0x3e, 0x80,
+	0xa5, 0x90, 0x1b, 0x9e, 0x65, 0x04, 0x96, 0x6e, 0x51, 0x8f, 0xb9, 0x88, 0x5b, 0xd0, 0x16, 0x47,
+	0xd4, 0xa6, 0x20, 0x92, 0x1b, 0x40, 0x4c, 0x16, 0x79, 0x9c, 0x06, 0xbe, 0x11, 0xf0, 0xc7, 0xf1,
+	0xd1, 0x14, 0x1e, 0xbd, 0x38, 0xbe, 0x23, 0x8f, 0x7f, 0x00, 0x4b, 0x34, 0x34, 0x03, 0x36, 0xd4,
+	0x47, 0x46, 0xa4, 0x25, 0xaa, 0xa4, 0xd6, 0x63, 0x53, 0xd6, 0xa0, 0xe0, 0xf8, 0x3c, 0x06, 0xcb,
+	0xe0, 0x89, 0xbc, 0xe3, 0x73, 0x89, 0xd1, 0x80, 0xd4, 0x1e, 0xa5, 0x6a, 0x56, 0x90, 0xb7, 0x6e,
+	0x3e, 0x7d, 0xb6, 0xa1, 0x7c, 0xf5, 0x6c, 0x63, 0x4d, 0x5a, 0x1b, 0x5a, 0x07, 0x55, 0x9b, 0xd5,
+	0x5c, 0x83, 0xef, 0x57, 0x77, 0xe8, 0xc0, 0x30, 0x1f, 0x37, 0xa9, 0xf9, 0xb7, 0x3f, 0xde, 0x80,
+	0xd8, 0x19, 0x4d, 0x6a, 0x6a, 0x82, 0x9b, 0xdc, 0x81, 0x82, 0xcf, 0x98, 0xa3, 0xf3, 0xc7, 0x3e,
+	0x55, 0x73, 0x9b, 0xca, 0xb5, 0xa5, 0x5b, 0x6b, 0xd5, 0x13, 0x49, 0x57, 0x15, 0xae, 0xe9, 0x3d,
+	0xf6, 0xa9, 0x96, 0xf7, 0xe3, 0x5f, 0xe4, 0x7d, 0x58, 0x34, 0x5c, 0xdf, 0xb1, 0xf7, 0x6c, 0x13,
+	0x23, 0xaf, 0xe6, 0x37, 0x95, 0x6b, 0x69, 0x6d, 0x92, 0x48, 0x3e, 0x82, 0x6c, 0xc8, 0x0d, 0x1e,
+	0x85, 0x6a, 0x01, 0xc1, 0xd7, 0x5f, 0x03, 0xde, 0xc5, 0x43, 0x5a, 0x7c, 0x98, 0x38, 0x70, 0xc5,
+	0x35, 0x8e, 0xf4, 0xbe, 0xc3, 0xcc, 0x03, 0xdd, 0x0f, 0x6c, 0x93, 0xea, 0x16, 0x3d, 0xb4, 0xa5,
+	0x20, 0x38, 0xad, 0xc5, 0x97, 0x5d, 0xe3, 0x68, 0x4b, 0x40, 0x76, 0x04, 0x62, 0x73, 0x04, 0x38,
+	0x92, 0x46, 0x7d, 0x66, 0xee, 0x9f, 0x90, 0x56, 0x3c, 0x8b, 0xb4, 0x6d, 0x01, 0x39, 0x29, 0xad,
+	0xf2, 0xd7, 0x1c, 0x64, 0x3b, 0x46, 0x60, 0xb8, 0x21, 0xf9, 0x44, 0x86, 0x10, 0xb3, 0x6d, 0xeb,
+	0x7b, 0x22, 0x1d, 0x67, 0x12, 0xf1, 0xfb, 0x97, 0x4f, 0xae, 0x2b, 0x32, 0x8e, 0x1d, 0xb8, 0x88,
+	0x71, 0x34, 0x03, 0x8a, 0x52, 0x74, 0x81, 0xfb, 0xad, 0x15, 0x50, 0x10, 0x22, 0x25, 0xca, 0x05,
+	0xc1, 0xde, 0x88, 0xb9, 0xef, 0x52, 0x4a, 0x7e, 0x04, 0x79, 0x6e, 0x1c, 0xe9, 0x81, 0xc1, 0xa9,
+	0x9a, 0x3a, 0x93, 0x82, 0x39, 0x6e, 0x1c, 0x69, 0x06, 0xa7, 0xe4, 0xa7, 0x50, 0x12, 0x7e, 0x4e,
+	0xea, 0x49, 0x24, 0x81, 0xee, 0xd3, 0x40, 0x17, 0xb2, 0x65, 0x05, 0x6c, 0x55, 0x62, 0x21, 0x97,
+	0x4e, 0x0a, 0x69, 0x79, 0x5c, 0x02, 0x0a, 0xcf, 0x76, 0x63, 0x10, 0x61, 0x47, 0x87, 0x06, 0x58,
+	0xbb, 0xbf, 0x56, 0xe0, 0x02, 0x0a, 0x18, 0x1a, 0xbe, 0x6e, 0xb8, 0xa2, 0xea, 0xd4, 0xec, 0x66,
+	0xea, 0xcd, 0x3e, 0xb8, 0x2b, 0x04, 0xfe, 0xe1, 0xeb, 0x8d, 0x6b, 0x03, 0x9b, 0xef, 0x47, 0xfd,
+	0xaa, 0xc9, 0xdc, 0xf8, 0x7e, 0x8c, 0xff, 0xdd, 0x08, 0xad, 0x83, 0x9a, 0xa8, 0x8f, 0x10, 0x19,
+	0xc2, 0xdf, 0xbe, 0x7c, 0x72, 0x7d, 0xc1, 0x41, 0x83, 0xd1, 0x82, 0x50, 0x2a, 0xb5, 0x28, 0x94,
+	0x1a, 0x1a, 0x7e, 0x1d, 0xe5, 0x12, 0x17, 0x56, 0x51, 0x0d, 0xbc, 0x0f, 0x4d, 0xe6, 0x88, 0x80,
+	0xe8, 0xe1, 0xbe, 0x11, 0xc8, 0x32, 0x3b, 0xbd, 0x37, 0x57, 0x04, 0x6c, 0x27, 0x46, 0xbd, 0x4b,
+	0x69, 0x57, 0x60, 0x92, 0xef, 0xc3, 0x1a, 0xc6, 0x5f, 0xd4, 0x4f, 0x18, 0x67, 0xb2, 0x6d, 0x51,
+	0x8f, 0xdb, 0x7b, 0x36, 0x0d, 0xb0, 0x36, 0x0b, 0x9a, 0xea, 0xc7, 0x95, 0x16, 0x62, 0x5e, 0xb6,
+	0x92, 0x7d, 0x72, 0x0f, 0x36, 0xa7, 0xf2, 0xfe, 0x24, 0x46, 0x01, 0x31, 0xd6, 0xfd, 0x89, 0x6c,
+	0x9e, 0x06, 0xfa, 0x08, 0x56, 0x1d, 0xdb, 0xb5, 0xb9, 0xce, 0x02, 0x8b, 0x06, 0xfa, 0x9e, 0xed,
+	0x38, 0x7a, 0x3f, 0xb2, 0x06, 0x94, 0x63, 0xd9, 0x2e, 0x6a, 0x2b, 0xb8, 0xdd, 0x16, 0xbb, 0x77,
+	0x6d, 0xc7, 0xd9, 0xc2, 0x3d, 0xf2, 0x03, 0x58, 0xb3, 0x3d, 0x53, 0xa0, 0x1c, 0xd2, 0x57, 0xa8,
+	0x8f, 0x35, 0xa8, 0x5d, 0x39, 0x3e, 0x32, 0x2d, 0x56, 0x03, 0x32, 0x30, 0xa2, 0x01, 0x9d, 0xcc,
+	0xff, 0x85, 0x19, 0xf2, 0x7f, 0x19, 0xf9, 0xc7, 0x0a, 0xe0, 0xe3, 0xf7, 0x3f, 0xff, 0x62, 0x63,
+	0xee, 0x9b, 0x2f, 0x36, 0x94, 0xdf, 0xbc, 0x7c, 0x72, 0x7d, 0x55, 0xb6, 0xea, 0xa3, 0xe3, 0x66,
+	0x2d, 0x4b, 0xb8, 0xf2, 0x4b, 0x05, 0x8a, 0x63, 0xd1, 0x20, 0xab, 0x90, 0xc3, 0x40, 0x24, 0x4d,
+	0x24, 0x2b, 0x96, 0x2d, 0x8b, 0xe8, 0x90, 0xde, 0xa3, 0x34, 0x54, 0xe7, 0xbf, 0x2d, 0x21, 0xbf,
+	0x3b, 0x6b, 0x42, 0x6a, 0x08, 0x5c, 0xf9, 0xbb, 0x02, 0xd0, 0x1b, 0x1a, 0xbe, 0x46, 0x4d, 0x16,
+	0x58, 0xaf, 0x57, 0xe4, 0x32, 0x64, 0xf7, 0xa9, 0x3d, 0xd8, 0xe7, 0x78, 0x3f, 0xa4, 0xb4, 0x78,
+	0x45, 0xee, 0x40, 0x5a, 0xb4, 0x79, 0x2c, 0xf6, 0xe2, 0xad, 0x52, 0x55, 0xb6, 0xf8, 0xea, 0xa8,
+	0xc5, 0x57, 0x7b, 0xa3, 0x19, 0x60, 0x2b, 0x2f, 0x34, 0xfc, 0xec, 0xeb, 0x0d, 0x45, 0x43, 0x0e,
+	0xf2, 0x13, 0x58, 0x96, 0xd9, 0x63, 0x46, 0x6e, 0xe4, 0x18, 0x22, 0x42, 0x6a, 0x3a, 0xb9, 0x36,
+	0x67, 0x4b, 0x72, 0xed, 0x02, 0x42, 0x35, 0x12, 0xa4, 0xca, 0x9f, 0x52, 0x50, 0x18, 0xb5, 0x88,
+	0xf0, 0xf5, 0x66, 0xbd, 0x07, 0x0b, 0x32, 0x6f, 0xbc, 0xc8, 0xed, 0xd3, 0x20, 0x36, 0xae, 0x88,
+	0xb4, 0x5d, 0x24, 0x91, 0x75, 0x00, 0xac, 0x49, 0xec, 0xc7, 0x68, 0x67, 0x5a, 0x2b, 0x08, 0x4a,
+	0x03, 0x4b, 0xb6, 0x07, 0x17, 0x92, 0xab, 0xe9, 0x90, 0x39, 0x91, 0x3b, 0xb2, 0xe2, 0xc3, 0x37,
+	0xde, 0x49, 0x63, 0xfa, 0xb7, 0x3c, 0xae, 0x25, 0xe3, 0xc2, 0x03, 0x84, 0x20, 0xbb, 0xb0, 0xc0,
+	0xd9, 0x01, 0xf5, 0x46, 0x90, 0x99, 0xd9, 0x21, 0x8b, 0x08, 0x10, 0xe3, 0x8d, 0xf2, 0x28, 0xfb,
+	0x8e, 0xf2, 0x88, 0xb4, 0xa0, 0xe0, 0xd8, 0x8f, 0x22, 0xdb, 0xb2, 0xf9, 0x63, 0x35, 0x37, 0xbb,
+	0xb6, 0xc7, 0xdc, 0x95, 0x7f, 0x2b, 0xb0, 0x84, 0xdd, 0x4f, 0xa3, 0x7b, 0x34, 0xa0, 0x9e, 0x49,
+	0x67, 0x4f, 0x4b, 0x0d, 0x8a, 0x63, 0x63, 0x80, 0x9a, 0x3a, 0x6d, 0x5e, 0x41, 0x3f, 0xe9, 0xfc,
+	0x02, 0x73, 0xac, 0xd9, 0x9f, 0x3e, 0x57, 0x81, 0x26, 0xfd, 0xbd, 0xf2, 0x5f, 0x05, 0x60, 0x27,
+	0xb9, 0xdb, 0xc6, 0xe6, 0xc8, 0x34, 0xce, 0x91, 0x2b, 0x90, 0x61, 0x43, 0x2f, 0xce, 0xcb, 0x82,
+	0x26, 0x17, 0x93, 0x03, 0x5e, 0x6a, 0x6a, 0xc0, 0xfb, 0x18, 0xf2, 0x32, 0x73, 0x6c, 0x0f, 0x55,
+	0x7c, 0x8b, 0x61, 0x36, 0x87, 0x0c, 0x2d, 0x8f, 0x34, 0x60, 0xd1, 0xb5, 0x3d, 0x5d, 0xf2, 0xb3,
+	0x88, 0xab, 0x99, 0xb7, 0x03, 0x28, 0xba, 0xb6, 0xd7, 0x13, 0x4c, 0xed, 0x88, 0x93, 0x12, 0xe4,
+	0x2d, 0x6a, 0x58, 0x8e, 0xed, 0xc9, 0x31, 0x33, 0xa5, 0x25, 0xeb, 0xca, 0x5f, 0x52, 0x70, 0xa9,
+	0xc1, 0xf0, 0x42, 0x16, 0x23, 0x82, 0x35, 0xaa, 0xd0, 0x37, 0x44, 0xb8, 0x03, 0x10, 0x3e, 0x0a,
+	0x78, 0xec, 0xf4, 0xf9, 0xd3, 0x3a, 0xbd, 0x20, 0x40, 0x64, 0x1c, 0xdf, 0x83, 0x05, 0x33, 0x0a,
+	0x02, 0xea, 0x71, 0x9d, 0xdb, 0xe6, 0x01, 0x7a, 0x30, 0xa5, 0x15, 0x63, 0x5a, 0xcf, 0x36, 0x0f,
+	0x26, 0xb3, 0x39, 0x7d, 0x96, 0x6c, 0x26, 0x1e, 0x94, 0x44, 0x13, 0x1f, 0x04, 0x6c, 0xc8, 0xf7,
+	0xf5, 0x81, 0xc3, 0xfa, 0x86, 0x93, 0x0c, 0x33, 0x6a, 0xe6, 0xb4, 0xf6, 0xac, 0xee, 0x51, 0x7a,
+	0x0f, 0x31, 0xef, 0x21, 0xe4, 0x68, 0xb2, 0x21, 0xfb, 0xb0, 0x7a, 0x52, 0x1e, 0x46, 0x54, 0xcd,
+	0x9e, 0x56, 0xd8, 0xca, 0x94, 0x30, 0x8c, 0x75, 0xe5, 0xcf, 0x29, 0x48, 0xa3, 0xb7, 0x5e, 0x1b,
+	0xbb, 0x15, 0xc8, 0xd8, 0x9e, 0x45, 0x8f, 0xe2, 0xe2, 0x94, 0x0b, 0x71, 0x63, 0x26, 0xee, 0x11,
+	0x7a, 0x86, 0xa1, 0x9a, 0x9a, 0xdd, 0xc5, 0x4b, 0x09, 0xc6, 0x3d, 0x01, 0x41, 0x3a, 0xb0, 0x78,
+	0x8c, 0xea, 0x51, 0x7e, 0x9a, 0xb0, 0x2d, 0x24, 0x08, 0xbb, 0x94, 0x13, 0x1f, 0xd6, 0xc6, 0x3c,
+	0xc9, 0x22, 0x1e, 0xda, 0x16, 0x3d, 0x87, 0xd0, 0xa9, 0x89, 0x37, 0xdb, 0x12, 0x33, 0x89, 0xdd,
+	0xcf, 0x40, 0x7d, 0x85, 0xc4, 0x33, 0x06, 0xef, 0xd2, 0xb4, 0x38, 0x19, 0xbd, 0x27, 0x69, 0xc8,
+	0x77, 0x58, 0x68, 0xe3, 0x5b, 0xe6, 0x1c, 0xee, 0x9d, 0x75, 0x00, 0x87, 0x0d, 0x69, 0x20, 0x6b,
+	0x2a, 0x8d, 0x01, 0x2f, 0x20, 0x05, 0x73, 0x64, 0x1d, 0x20, 0xf2, 0xfd, 0xd1, 0x76, 0x46, 0x6e,
+	0x23, 0xe5, 0x64, 0xc1, 0x65, 0xcf, 0x54, 0x70, 0x43, 0xd8, 0x18, 0x73, 0xa2, 0xed, 0x4d, 0x44,
+	0x4d, 0x77, 0x8c, 0x90, 0xab, 0xb9, 0xd3, 0xfa, 0x72, 0x2d, 0xf1, 0x65, 0xcb, 0x1b, 0x8f, 0xdc,
+	0x8e, 0x11, 0x72, 0xf2, 0x08, 0xae, 0x9e, 0x14, 0x2c, 0xef, 0x52, 0x94, 0x9a, 0x3f, 0x7b, 0xc2,
+	0xb4, 0xbc, 0x24, 0x80, 0x28, 0xd2, 0x01, 0xd9, 0xe5, 0x43, 0x9d, 0x0d, 0xa9, 0xa5, 0x16, 0xce,
+	0xbf, 0xbb, 0x83, 0xc4, 0x6f, 0x0f, 0xa9, 0x55, 0xf9, 0xcf, 0x3c, 0x64, 0xee, 0x89, 0x81, 0xf7,
+	0x3c, 0xf2, 0xc5, 0x80, 0x0c, 0x4e, 0xc5, 0x6a, 0xfa, 0xfc, 0x95, 0x96, 0xc8, 0x22, 0xe7, 0xbc,
+	0xc8, 0x95, 0x0f, 0x83, 0x10, 0x73, 0x2e, 0xad, 0x15, 0xbc, 0xc8, 0xc5, 0x77, 0x40, 0x48, 0xbe,
+	0x03, 0x8b, 0xe2, 0xa5, 0x41, 0xad, 0xd1, 0x89, 0x2c, 0x9e, 0x58, 0x90, 0xc4, 0xf8, 0xd0, 0x11,
+	0x5c, 0xb4, 0xec, 0x90, 0x07, 0x76, 0x3f, 0xe2, 0x54, 0x3e, 0x3e, 0x43, 0x35, 0x77, 0xfe, 0x2a,
+	0x2f, 0x8f, 0x49, 0x41, 0x4a, 0xe5, 0xab, 0x14, 0xa4, 0x77, 0x98, 0x79, 0xf0, 0x96, 0xce, 0x1e,
+	0x7d, 0xc0, 0x4a, 0xcd, 0xf0, 0x01, 0x8b, 0xfc, 0x10, 0xf2, 0xa3, 0x6f, 0x70, 0xc9, 0xb0, 0x30,
+	0x3d, 0xc1, 0x37, 0xe3, 0x03, 0x72, 0x80, 0xff, 0x5c, 0x0c, 0xf0, 0x09, 0x93, 0x00, 0xa0, 0x9e,
+	0xa5, 0xe3, 0x13, 0x20, 0x33, 0xc3, 0x13, 0x20, 0x47, 0x3d, 0x4b, 0xd0, 0xc9, 0x2f, 0x14, 0xb8,
+	0x14, 0xd0, 0xa1, 0x28, 0x4d, 0x71, 0x3b, 0xe0, 0x5b, 0x57, 0x96, 0x8b, 0x1c, 0x55, 0xaf, 0xbe,
+	0xd2, 0x90, 0x26, 0x35, 0xd1, 0x96, 0xdb, 0xb1, 0x9f, 0x3f, 0x7c, 0x0b, 0x3f, 0xc7, 0x3c, 0xa1,
+	0x46, 0xa4, 0xbc, 0x0e, 0x0d, 0xf0, 0x15, 0x8c, 0x85, 0xe4, 0xc1, 0x82, 0xa4, 0xc6, 0x95, 0xf4,
+	0x0e, 0x22, 0x5c, 0x8c, 0x05, 0x60, 0x29, 0xfd, 0x4f, 0x81, 0xa2, 0x08, 0xae, 0x26, 0x69, 0x93,
+	0xa5, 0xa2, 0x4c, 0x95, 0x0a, 0x3e, 0x06, 0xb8, 0xe1, 0xe8, 0x62, 0x16, 0xa5, 0x96, 0x3a, 0x3f,
+	0xfb, 0xfd, 0x58, 0x44, 0x80, 0x1d, 0xe4, 0x27, 0x3f, 0x87, 0xe5, 0x69, 0x97, 0xab, 0xa9, 0x77,
+	0xe5, 0xed, 0xa5, 0x49, 0x6f, 0x5f, 0xdf, 0x13, 0x6d, 0x27, 0xfe, 0x1a, 0x58, 0x86, 0x52, 0xa7,
+	0xdd, 0xde, 0xd1, 0x7b, 0x9f, 0x76, 0xb6, 0xf5, 0x46, 0x7b, 0xb7, 0xdb, 0xab, 0xef, 0xf6, 0xf4,
+	0x8e, 0xd6, 0x6e, 0xde, 0x6f, 0xf4, 0x96, 0xe7, 0x88, 0x0a, 0x2b, 0xc7, 0xfb, 0xdd, 0x5e, 0x7d,
+	0x6b, 0x67, 0xbb, 0xfb, 0xb0, 0xde, 0x59, 0x56, 0x48, 0x09, 0x2e, 0x4f, 0x70, 0x36, 0xb6, 0x77,
+	0x7b, 0x5a, 0xbd, 0xb7, 0xdd, 0x5c, 0x9e, 0x2f, 0xa5, 0x7f, 0xf5, 0xbb, 0xf2, 0xdc, 0xf5, 0x01,
+	0xc0, 0xf1, 0x27, 0x42, 0x72, 0x19, 0x08, 0x9e, 0xef, 0xf6, 0xea, 0xbd, 0xfb, 0x5d, 0xbd, 0xde,
+	0xe8, 0xb5, 0x1e, 0x6c, 0x2f, 0xcf, 0x91, 0xab, 0xa0, 0x8e, 0xd3, 0x05, 0x7a, 0x57, 0xef, 0xd4,
+	0xef, 0x77, 0xb7, 0x9b, 0xcb, 0x0a, 0x59, 0x87, 0x2b, 0xe3, 0xbb, 0x0f, 0x5b, 0xbd, 0x4f, 0x9a,
+	0x5a, 0xfd, 0xa1, 0xde, 0xde, 0xdd, 0xf9, 0x74, 0x24, 0x68, 0xab, 0xf3, 0xf4, 0x5f, 0xe5, 0xb9,
+	0xa7, 0xcf, 0xcb, 0xca, 0x97, 0xcf, 0xcb, 0xca, 0x3f, 0x9f, 0x97, 0x95, 0xcf, 0x5e, 0x94, 0xe7,
+	0xbe, 0x7c, 0x51, 0x9e, 0xfb, 0xc7, 0x8b, 0xf2, 0xdc, 0x8f, 0x6f, 0x8d, 0xf9, 0xaa, 0x21, 0xbe,
+	0x06, 0xdc, 0xd8, 0xa5, 0x7c, 0xc8, 0x82, 0x03, 0xb9, 0xaa, 0x1d, 0xde, 0x19, 0xff, 0x3c, 0x80,
+	0xbe, 0xeb, 0x67, 0xb1, 0x74, 0x6e, 0xff, 0x7f, 0x00, 0x18, 0x5e, 0xbd, 0xcc, 0xea, 0x17, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -283,7 +1016,25 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
-	return true
+	if !this.SwapProtocolFeeShare.Equal(that1.SwapProtocolFeeShare) {
+		return false
+	}
+	if this.PoolStatsEpochIdentifier != that1.PoolStatsEpochIdentifier {
+		return false
+	}
+	if this.PriceDeviationEpochIdentifier != that1.PriceDeviationEpochIdentifier {
+		return false
+	}
+	if this.LimitOrderFillBudget != that1.LimitOrderFillBudget {
+		return false
+	}
+	if this.IncentivesEpochIdentifier != that1.IncentivesEpochIdentifier {
+		return false
+	}
+	if !this.GaugeCreationFee.Equal(&that1.GaugeCreationFee) {
+		return false
+	}
+	return true
 }
 func (m *Input) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -385,6 +1136,57 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxEpochPriceDeviation != nil {
+		{
+			size := m.MaxEpochPriceDeviation.Size()
+			i -= size
+			if _, err := m.MaxEpochPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintCoinswap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.MaxBlockPriceDeviation != nil {
+		{
+			size := m.MaxBlockPriceDeviation.Size()
+			i -= size
+			if _, err := m.MaxBlockPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintCoinswap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Status != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Amplification != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PoolType != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.PoolType))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Fee != nil {
+		{
+			size := m.Fee.Size()
+			i -= size
+			if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintCoinswap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.LptDenom) > 0 {
 		i -= len(m.LptDenom)
 		copy(dAtA[i:], m.LptDenom)
@@ -443,6 +1245,52 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.GaugeCreationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.IncentivesEpochIdentifier) > 0 {
+		i -= len(m.IncentivesEpochIdentifier)
+		copy(dAtA[i:], m.IncentivesEpochIdentifier)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.IncentivesEpochIdentifier)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.LimitOrderFillBudget != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.LimitOrderFillBudget))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.PriceDeviationEpochIdentifier) > 0 {
+		i -= len(m.PriceDeviationEpochIdentifier)
+		copy(dAtA[i:], m.PriceDeviationEpochIdentifier)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.PriceDeviationEpochIdentifier)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.PoolStatsEpochIdentifier) > 0 {
+		i -= len(m.PoolStatsEpochIdentifier)
+		copy(dAtA[i:], m.PoolStatsEpochIdentifier)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.PoolStatsEpochIdentifier)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size := m.SwapProtocolFeeShare.Size()
+		i -= size
+		if _, err := m.SwapProtocolFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if len(m.MaxSwapAmount) > 0 {
 		for iNdEx := len(m.MaxSwapAmount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -500,112 +1348,3934 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintCoinswap(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCoinswap(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ProtocolFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Input) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ProtocolFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtocolFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovCoinswap(uint64(l))
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoinswap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = m.Coin.Size()
-	n += 1 + l + sovCoinswap(uint64(l))
-	return n
+	if len(m.PoolId) > 0 {
+		i -= len(m.PoolId)
+		copy(dAtA[i:], m.PoolId)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.PoolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Output) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovCoinswap(uint64(l))
+	{
+		size := m.PriceCumulative.Size()
+		i -= size
+		if _, err := m.PriceCumulative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
 	}
-	l = m.Coin.Size()
-	n += 1 + l + sovCoinswap(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x22
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintCoinswap(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PoolId) > 0 {
+		i -= len(m.PoolId)
+		copy(dAtA[i:], m.PoolId)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.PoolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovCoinswap(uint64(l))
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
 	}
-	l = len(m.StandardDenom)
-	if l > 0 {
-		n += 1 + l + sovCoinswap(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoinswap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = len(m.CounterpartyDenom)
-	if l > 0 {
-		n += 1 + l + sovCoinswap(uint64(l))
+	{
+		size := m.TokenVolume.Size()
+		i -= size
+		if _, err := m.TokenVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
 	}
-	l = len(m.EscrowAddress)
-	if l > 0 {
-		n += 1 + l + sovCoinswap(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.StandardVolume.Size()
+		i -= size
+		if _, err := m.StandardVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
 	}
-	l = len(m.LptDenom)
-	if l > 0 {
-		n += 1 + l + sovCoinswap(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if m.SwapCount != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.SwapCount))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.EpochNumber != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PoolId) > 0 {
+		i -= len(m.PoolId)
+		copy(dAtA[i:], m.PoolId)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.PoolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PriceReference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceReference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Fee.Size()
-	n += 1 + l + sovCoinswap(uint64(l))
-	l = m.PoolCreationFee.Size()
-	n += 1 + l + sovCoinswap(uint64(l))
-	l = m.TaxRate.Size()
-	n += 1 + l + sovCoinswap(uint64(l))
-	l = m.MaxStandardCoinPerPool.Size()
-	n += 1 + l + sovCoinswap(uint64(l))
-	if len(m.MaxSwapAmount) > 0 {
-		for _, e := range m.MaxSwapAmount {
-			l = e.Size()
-			n += 1 + l + sovCoinswap(uint64(l))
+	{
+		size := m.EpochPrice.Size()
+		i -= size
+		if _, err := m.EpochPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func sovCoinswap(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCoinswap(x uint64) (n int) {
-	return sovCoinswap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Input) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BlockPrice.Size()
+		i -= size
+		if _, err := m.BlockPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PoolId) > 0 {
+		i -= len(m.PoolId)
+		copy(dAtA[i:], m.PoolId)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.PoolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.MinTokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.LptDenom) > 0 {
+		i -= len(m.LptDenom)
+		copy(dAtA[i:], m.LptDenom)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.LptDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConcentratedPoolState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConcentratedPoolState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConcentratedPoolState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeGrowthGlobalToken.Size()
+		i -= size
+		if _, err := m.FeeGrowthGlobalToken.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.FeeGrowthGlobalStandard.Size()
+		i -= size
+		if _, err := m.FeeGrowthGlobalStandard.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.CurrentTick != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.CurrentTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.SqrtPrice.Size()
+		i -= size
+		if _, err := m.SqrtPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PoolId) > 0 {
+		i -= len(m.PoolId)
+		copy(dAtA[i:], m.PoolId)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.PoolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Tick) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Tick) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tick) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeGrowthOutsideToken.Size()
+		i -= size
+		if _, err := m.FeeGrowthOutsideToken.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.FeeGrowthOutsideStandard.Size()
+		i -= size
+		if _, err := m.FeeGrowthOutsideStandard.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LiquidityNet.Size()
+		i -= size
+		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LiquidityGross.Size()
+		i -= size
+		if _, err := m.LiquidityGross.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Index != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PoolId) > 0 {
+		i -= len(m.PoolId)
+		copy(dAtA[i:], m.PoolId)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.PoolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Position) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Position) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokensOwed) > 0 {
+		for iNdEx := len(m.TokensOwed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensOwed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoinswap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size := m.FeeGrowthInsideTokenLast.Size()
+		i -= size
+		if _, err := m.FeeGrowthInsideTokenLast.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.FeeGrowthInsideStandardLast.Size()
+		i -= size
+		if _, err := m.FeeGrowthInsideStandardLast.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.UpperTick != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.LptDenom) > 0 {
+		i -= len(m.LptDenom)
+		copy(dAtA[i:], m.LptDenom)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.LptDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Gauge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Gauge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DistributedCoins) > 0 {
+		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoinswap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.FilledEpochs != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.FilledEpochs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NumEpochs != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.NumEpochs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoinswap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LptDenom) > 0 {
+		i -= len(m.LptDenom)
+		copy(dAtA[i:], m.LptDenom)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.LptDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Lock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Lock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Lock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardsOwed) > 0 {
+		for iNdEx := len(m.RewardsOwed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsOwed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoinswap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RewardPerShareLast) > 0 {
+		for iNdEx := len(m.RewardPerShareLast) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPerShareLast[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoinswap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintCoinswap(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x2a
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintCoinswap(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardPerShare) > 0 {
+		for iNdEx := len(m.RewardPerShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoinswap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.TotalLocked.Size()
+		i -= size
+		if _, err := m.TotalLocked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.LptDenom) > 0 {
+		i -= len(m.LptDenom)
+		copy(dAtA[i:], m.LptDenom)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.LptDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCoinswap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCoinswap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Input) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	return n
+}
+
+func (m *Output) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = len(m.StandardDenom)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = len(m.CounterpartyDenom)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = len(m.EscrowAddress)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = len(m.LptDenom)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	if m.Fee != nil {
+		l = m.Fee.Size()
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	if m.PoolType != 0 {
+		n += 1 + sovCoinswap(uint64(m.PoolType))
+	}
+	if m.Amplification != 0 {
+		n += 1 + sovCoinswap(uint64(m.Amplification))
+	}
+	if m.Status != 0 {
+		n += 1 + sovCoinswap(uint64(m.Status))
+	}
+	if m.MaxBlockPriceDeviation != nil {
+		l = m.MaxBlockPriceDeviation.Size()
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	if m.MaxEpochPriceDeviation != nil {
+		l = m.MaxEpochPriceDeviation.Size()
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = m.PoolCreationFee.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = m.TaxRate.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = m.MaxStandardCoinPerPool.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	if len(m.MaxSwapAmount) > 0 {
+		for _, e := range m.MaxSwapAmount {
+			l = e.Size()
+			n += 1 + l + sovCoinswap(uint64(l))
+		}
+	}
+	l = m.SwapProtocolFeeShare.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = len(m.PoolStatsEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = len(m.PriceDeviationEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	if m.LimitOrderFillBudget != 0 {
+		n += 1 + sovCoinswap(uint64(m.LimitOrderFillBudget))
+	}
+	l = len(m.IncentivesEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = m.GaugeCreationFee.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	return n
+}
+
+func (m *ProtocolFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolId)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovCoinswap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TwapRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolId)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCoinswap(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = m.PriceCumulative.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	return n
+}
+
+func (m *PoolStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolId)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovCoinswap(uint64(m.EpochNumber))
+	}
+	if m.SwapCount != 0 {
+		n += 1 + sovCoinswap(uint64(m.SwapCount))
+	}
+	l = m.StandardVolume.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = m.TokenVolume.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovCoinswap(uint64(l))
+		}
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	return n
+}
+
+func (m *PriceReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolId)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCoinswap(uint64(m.Height))
+	}
+	l = m.BlockPrice.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = m.EpochPrice.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	return n
+}
+
+func (m *LimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovCoinswap(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = len(m.LptDenom)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = m.MinTokenOut.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	if m.Deadline != 0 {
+		n += 1 + sovCoinswap(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *ConcentratedPoolState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolId)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = m.SqrtPrice.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	if m.CurrentTick != 0 {
+		n += 1 + sovCoinswap(uint64(m.CurrentTick))
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = m.FeeGrowthGlobalStandard.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = m.FeeGrowthGlobalToken.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	return n
+}
+
+func (m *Tick) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolId)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovCoinswap(uint64(m.Index))
+	}
+	l = m.LiquidityGross.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = m.FeeGrowthOutsideStandard.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = m.FeeGrowthOutsideToken.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	return n
+}
+
+func (m *Position) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovCoinswap(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = len(m.LptDenom)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovCoinswap(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovCoinswap(uint64(m.UpperTick))
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = m.FeeGrowthInsideStandardLast.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = m.FeeGrowthInsideTokenLast.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	if len(m.TokensOwed) > 0 {
+		for _, e := range m.TokensOwed {
+			l = e.Size()
+			n += 1 + l + sovCoinswap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Gauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovCoinswap(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = len(m.LptDenom)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovCoinswap(uint64(l))
+		}
+	}
+	if m.NumEpochs != 0 {
+		n += 1 + sovCoinswap(uint64(m.NumEpochs))
+	}
+	if m.FilledEpochs != 0 {
+		n += 1 + sovCoinswap(uint64(m.FilledEpochs))
+	}
+	if len(m.DistributedCoins) > 0 {
+		for _, e := range m.DistributedCoins {
+			l = e.Size()
+			n += 1 + l + sovCoinswap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Lock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovCoinswap(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovCoinswap(uint64(l))
+	if len(m.RewardPerShareLast) > 0 {
+		for _, e := range m.RewardPerShareLast {
+			l = e.Size()
+			n += 1 + l + sovCoinswap(uint64(l))
+		}
+	}
+	if len(m.RewardsOwed) > 0 {
+		for _, e := range m.RewardsOwed {
+			l = e.Size()
+			n += 1 + l + sovCoinswap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LptDenom)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = m.TotalLocked.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	if len(m.RewardPerShare) > 0 {
+		for _, e := range m.RewardPerShare {
+			l = e.Size()
+			n += 1 + l + sovCoinswap(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovCoinswap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCoinswap(x uint64) (n int) {
+	return sovCoinswap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Input) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoinswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoinswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Output) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoinswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Output: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoinswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoinswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandardDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StandardDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LptDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LptDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Fee = &v
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+			}
+			m.PoolType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolType |= PoolType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PoolStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxBlockPriceDeviation = &v
+			if err := m.MaxBlockPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEpochPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxEpochPriceDeviation = &v
+			if err := m.MaxEpochPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoinswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoinswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolCreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStandardCoinPerPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxStandardCoinPerPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSwapAmount = append(m.MaxSwapAmount, types.Coin{})
+			if err := m.MaxSwapAmount[len(m.MaxSwapAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapProtocolFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapProtocolFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolStatsEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolStatsEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDeviationEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDeviationEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderFillBudget", wireType)
+			}
+			m.LimitOrderFillBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitOrderFillBudget |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivesEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentivesEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GaugeCreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoinswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtocolFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoinswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtocolFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtocolFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoinswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TwapRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoinswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceCumulative", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceCumulative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoinswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoinswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapCount", wireType)
+			}
+			m.SwapCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandardVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StandardVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoinswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoinswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoinswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoinswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LptDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LptDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinTokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoinswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConcentratedPoolState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoinswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConcentratedPoolState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConcentratedPoolState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqrtPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			}
+			m.CurrentTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthGlobalStandard", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthGlobalStandard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthGlobalToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthGlobalToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoinswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tick) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoinswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tick: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tick: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityGross", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityGross.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthOutsideStandard", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthOutsideStandard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthOutsideToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthOutsideToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoinswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Position) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoinswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LptDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LptDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthInsideStandardLast", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthInsideStandardLast.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthInsideTokenLast", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthInsideTokenLast.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOwed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensOwed = append(m.TokensOwed, types.Coin{})
+			if err := m.TokensOwed[len(m.TokensOwed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoinswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Gauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowCoinswap
 			}
@@ -622,15 +5292,66 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LptDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -658,11 +5379,83 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.LptDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochs", wireType)
+			}
+			m.NumEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledEpochs", wireType)
+			}
+			m.FilledEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FilledEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -689,7 +5482,8 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DistributedCoins = append(m.DistributedCoins, types.Coin{})
+			if err := m.DistributedCoins[len(m.DistributedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -714,7 +5508,7 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Output) Unmarshal(dAtA []byte) error {
+func (m *Lock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -737,17 +5531,17 @@ func (m *Output) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Output: wiretype end group for non-group")
+			return fmt.Errorf("proto: Lock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Lock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCoinswap
@@ -757,29 +5551,16 @@ func (m *Output) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCoinswap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCoinswap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCoinswap
@@ -789,80 +5570,29 @@ func (m *Output) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCoinswap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCoinswap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCoinswap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCoinswap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Pool) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCoinswap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCoinswap
@@ -872,29 +5602,30 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCoinswap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCoinswap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StandardDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCoinswap
@@ -904,29 +5635,30 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCoinswap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCoinswap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StandardDenom = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCoinswap
@@ -936,29 +5668,30 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCoinswap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCoinswap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyDenom = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerShareLast", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCoinswap
@@ -968,29 +5701,31 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCoinswap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCoinswap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			m.RewardPerShareLast = append(m.RewardPerShareLast, types.DecCoin{})
+			if err := m.RewardPerShareLast[len(m.RewardPerShareLast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LptDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsOwed", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCoinswap
@@ -1000,23 +5735,25 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCoinswap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCoinswap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LptDenom = string(dAtA[iNdEx:postIndex])
+			m.RewardsOwed = append(m.RewardsOwed, types.Coin{})
+			if err := m.RewardsOwed[len(m.RewardsOwed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1039,7 +5776,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *LockRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1062,15 +5799,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: LockRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LockRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LptDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1098,80 +5835,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LptDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCoinswap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCoinswap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCoinswap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PoolCreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCoinswap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCoinswap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCoinswap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxStandardCoinPerPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLocked", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1199,13 +5867,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxStandardCoinPerPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalLocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerShare", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1232,8 +5900,8 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxSwapAmount = append(m.MaxSwapAmount, types.Coin{})
-			if err := m.MaxSwapAmount[len(m.MaxSwapAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardPerShare = append(m.RewardPerShare, types.DecCoin{})
+			if err := m.RewardPerShare[len(m.RewardPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15,4 +15,14 @@ var (
 	ErrConstraintNotMet        = errorsmod.Register(ModuleName, 8, "constraint not met")
 	ErrInsufficientFunds       = errorsmod.Register(ModuleName, 9, "insufficient funds")
 	ErrMaxedStandardDenom      = errorsmod.Register(ModuleName, 10, "standard denom amount exceed max list")
+	ErrTwapNotAvailable        = errorsmod.Register(ModuleName, 11, "twap not available")
+	ErrPoolPaused              = errorsmod.Register(ModuleName, 12, "pool is paused")
+	ErrPriceDeviationExceeded  = errorsmod.Register(ModuleName, 13, "price deviation exceeded")
+	ErrLimitOrderNotFound      = errorsmod.Register(ModuleName, 14, "limit order not found")
+	ErrPositionNotFound        = errorsmod.Register(ModuleName, 15, "position not found")
+	ErrInvalidTick             = errorsmod.Register(ModuleName, 16, "invalid tick")
+	ErrInvalidPoolType         = errorsmod.Register(ModuleName, 17, "operation not supported by the pool type")
+	ErrGaugeNotFound           = errorsmod.Register(ModuleName, 18, "gauge not found")
+	ErrLockNotFound            = errorsmod.Register(ModuleName, 19, "lock not found")
+	ErrLockNotMatured          = errorsmod.Register(ModuleName, 20, "lock not matured")
 )
@@ -3,9 +3,28 @@ package types
 
 // coinswap module event types
 const (
-	EventTypeSwap            = "swap"
-	EventTypeAddLiquidity    = "add_liquidity"
-	EventTypeRemoveLiquidity = "remove_liquidity"
+	EventTypeSwap                  = "swap"
+	EventTypeSwapRoute             = "swap_route"
+	EventTypeAddLiquidity          = "add_liquidity"
+	EventTypeRemoveLiquidity       = "remove_liquidity"
+	EventTypeAddLiquiditySingle    = "add_liquidity_single"
+	EventTypeRemoveLiquiditySingle = "remove_liquidity_single"
+	EventTypeProtocolFee           = "protocol_fee"
+	EventTypeSetPoolStatus         = "set_pool_status"
+	EventTypePlaceLimitOrder       = "place_limit_order"
+	EventTypeCancelLimitOrder      = "cancel_limit_order"
+	EventTypeFillLimitOrder        = "fill_limit_order"
+	EventTypeExpireLimitOrder      = "expire_limit_order"
+	EventTypeCreatePosition        = "create_position"
+	EventTypeIncreasePosition      = "increase_position"
+	EventTypeDecreasePosition      = "decrease_position"
+	EventTypeCollectFees           = "collect_fees"
+	EventTypeCreateGauge           = "create_gauge"
+	EventTypeDistributeGauge       = "distribute_gauge"
+	EventTypeFinishGauge           = "finish_gauge"
+	EventTypeLockLiquidity         = "lock_liquidity"
+	EventTypeUnlockLiquidity       = "unlock_liquidity"
+	EventTypeClaimLockRewards      = "claim_lock_rewards"
 
 	AttributeValueCategory = ModuleName
 
@@ -14,4 +33,19 @@ const (
 	AttributeValueRecipient  = "recipient"
 	AttributeValueIsBuyOrder = "is_buy_order"
 	AttributeValueTokenPair  = "token_pair"
+	AttributeValueTokenIn    = "token_in"
+	AttributeValueTokenOut   = "token_out"
+	AttributeValueRoute      = "route"
+	AttributeValuePoolId     = "pool_id"
+	AttributeValueStatus     = "status"
+	AttributeValueOrderId    = "order_id"
+	AttributeValueOwner      = "owner"
+	AttributeValuePositionId = "position_id"
+	AttributeValueLiquidity  = "liquidity"
+	AttributeValueGaugeId    = "gauge_id"
+	AttributeValueLockId     = "lock_id"
+	AttributeValueNumEpochs  = "num_epochs"
+	AttributeValueEndTime    = "end_time"
+	AttributeValueRewards    = "rewards"
+	AttributeValueRefund     = "refund"
 )
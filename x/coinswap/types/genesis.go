@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -17,9 +18,13 @@ func NewGenesisState(params Params, denom string) *GenesisState {
 // DefaultGenesisState creates a default GenesisState object
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:        DefaultParams(),
-		StandardDenom: sdk.DefaultBondDenom,
-		Sequence:      1,
+		Params:             DefaultParams(),
+		StandardDenom:      sdk.DefaultBondDenom,
+		Sequence:           1,
+		LimitOrderSequence: 1,
+		PositionSequence:   1,
+		GaugeSequence:      1,
+		LockSequence:       1,
 	}
 }
 
@@ -31,6 +36,8 @@ func ValidateGenesis(data GenesisState) error {
 
 	var poolIds = make(map[string]bool, len(data.Pool))
 	var lptDenoms = make(map[string]bool, len(data.Pool))
+	var concentratedPoolIds = make(map[string]bool)
+	var concentratedLptDenoms = make(map[string]bool)
 	var maxSequence = uint64(0)
 	for _, pool := range data.Pool {
 		if poolIds[pool.Id] {
@@ -62,13 +69,326 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 
+		//validate the pool id, keyed by both denoms of the pool
+		expectedPoolId := GetPoolId(pool.StandardDenom, pool.CounterpartyDenom)
+		if pool.Id != expectedPoolId {
+			return fmt.Errorf("invalid pool id %s, expected %s", pool.Id, expectedPoolId)
+		}
+
 		//validate the address
 		if _, err := sdk.AccAddressFromBech32(pool.EscrowAddress); err != nil {
 			return err
 		}
+
+		//validate the pool fee
+		if err := ValidatePoolFee(pool.Fee); err != nil {
+			return err
+		}
+
+		//validate the pool type
+		if err := ValidatePoolType(pool.PoolType, pool.Amplification); err != nil {
+			return err
+		}
+		if pool.PoolType == POOL_TYPE_CONCENTRATED {
+			concentratedPoolIds[pool.Id] = true
+			concentratedLptDenoms[pool.LptDenom] = true
+		}
+
+		//validate the pool status
+		if err := ValidatePoolStatus(pool.Status); err != nil {
+			return err
+		}
+
+		//validate the pool circuit breaker
+		if err := ValidatePriceDeviation(pool.MaxBlockPriceDeviation); err != nil {
+			return err
+		}
+		if err := ValidatePriceDeviation(pool.MaxEpochPriceDeviation); err != nil {
+			return err
+		}
+	}
+	var protocolFeePoolIds = make(map[string]bool, len(data.ProtocolFees))
+	for _, protocolFee := range data.ProtocolFees {
+		if !poolIds[protocolFee.PoolId] {
+			return fmt.Errorf("protocol fees for unknown pool: %s", protocolFee.PoolId)
+		}
+		if protocolFeePoolIds[protocolFee.PoolId] {
+			return fmt.Errorf("duplicate protocol fees for pool: %s", protocolFee.PoolId)
+		}
+		protocolFeePoolIds[protocolFee.PoolId] = true
+
+		if err := protocolFee.Fees.Validate(); err != nil {
+			return err
+		}
+	}
+
+	var twapRecords = make(map[string][]TwapRecord, len(data.Pool))
+	for _, record := range data.TwapRecords {
+		if !poolIds[record.PoolId] {
+			return fmt.Errorf("twap record for unknown pool: %s", record.PoolId)
+		}
+		if record.PriceCumulative.IsNil() || record.PriceCumulative.IsNegative() {
+			return fmt.Errorf("invalid twap price cumulative for pool %s: %s", record.PoolId, record.PriceCumulative)
+		}
+
+		records := twapRecords[record.PoolId]
+		if len(records) > 0 {
+			last := records[len(records)-1]
+			if record.Height <= last.Height || record.Time.Before(last.Time) {
+				return fmt.Errorf("twap records of pool %s are not sorted", record.PoolId)
+			}
+		}
+		if len(records) == MaxTwapRecords {
+			return fmt.Errorf("too many twap records for pool %s", record.PoolId)
+		}
+		twapRecords[record.PoolId] = append(records, record)
+	}
+
+	var poolStats = make(map[string]bool, len(data.PoolStats))
+	for _, stats := range data.PoolStats {
+		if stats.EpochNumber <= 0 {
+			return fmt.Errorf("invalid epoch number %d for the stats of pool %s", stats.EpochNumber, stats.PoolId)
+		}
+		key := string(GetPoolStatsKey(stats.PoolId, stats.EpochNumber))
+		if poolStats[key] {
+			return fmt.Errorf("duplicate stats for pool %s at epoch %d", stats.PoolId, stats.EpochNumber)
+		}
+		poolStats[key] = true
+
+		if err := stats.Validate(poolIds); err != nil {
+			return err
+		}
+	}
+
+	var currentPoolStats = make(map[string]bool, len(data.CurrentPoolStats))
+	for _, stats := range data.CurrentPoolStats {
+		if stats.EpochNumber != 0 {
+			return fmt.Errorf("invalid epoch number %d for the current stats of pool %s", stats.EpochNumber, stats.PoolId)
+		}
+		if currentPoolStats[stats.PoolId] {
+			return fmt.Errorf("duplicate current stats for pool %s", stats.PoolId)
+		}
+		currentPoolStats[stats.PoolId] = true
+
+		if err := stats.Validate(poolIds); err != nil {
+			return err
+		}
+	}
+
+	var priceReferences = make(map[string]bool, len(data.PriceReferences))
+	for _, reference := range data.PriceReferences {
+		if !poolIds[reference.PoolId] {
+			return fmt.Errorf("price reference for unknown pool: %s", reference.PoolId)
+		}
+		if priceReferences[reference.PoolId] {
+			return fmt.Errorf("duplicate price reference for pool: %s", reference.PoolId)
+		}
+		priceReferences[reference.PoolId] = true
+
+		if reference.BlockPrice.IsNil() || reference.BlockPrice.IsNegative() ||
+			reference.EpochPrice.IsNil() || reference.EpochPrice.IsNegative() {
+			return fmt.Errorf("invalid price reference for pool %s", reference.PoolId)
+		}
+	}
+
+	var limitOrderIds = make(map[uint64]bool, len(data.LimitOrders))
+	for _, order := range data.LimitOrders {
+		if limitOrderIds[order.Id] {
+			return fmt.Errorf("duplicate limit order: %d", order.Id)
+		}
+		limitOrderIds[order.Id] = true
+
+		if order.Id == 0 || order.Id >= data.LimitOrderSequence {
+			return fmt.Errorf("invalid limit order id: %d", order.Id)
+		}
+		if !lptDenoms[order.LptDenom] {
+			return fmt.Errorf("limit order %d for unknown pool: %s", order.Id, order.LptDenom)
+		}
+		if _, err := sdk.AccAddressFromBech32(order.Owner); err != nil {
+			return err
+		}
+		if err := ValidateLimitOrderCoins(order.TokenIn, order.MinTokenOut); err != nil {
+			return err
+		}
+	}
+
+	var concentratedPoolStates = make(map[string]bool, len(data.ConcentratedPoolStates))
+	for _, state := range data.ConcentratedPoolStates {
+		if !concentratedPoolIds[state.PoolId] {
+			return fmt.Errorf("concentrated pool state for unknown concentrated pool: %s", state.PoolId)
+		}
+		if concentratedPoolStates[state.PoolId] {
+			return fmt.Errorf("duplicate concentrated pool state for pool: %s", state.PoolId)
+		}
+		concentratedPoolStates[state.PoolId] = true
+
+		if state.SqrtPrice.IsNil() || !state.SqrtPrice.IsPositive() ||
+			state.CurrentTick < MinTick-1 || state.CurrentTick > MaxTick ||
+			state.Liquidity.IsNil() || state.Liquidity.IsNegative() ||
+			state.FeeGrowthGlobalStandard.IsNil() || state.FeeGrowthGlobalStandard.IsNegative() ||
+			state.FeeGrowthGlobalToken.IsNil() || state.FeeGrowthGlobalToken.IsNegative() {
+			return fmt.Errorf("invalid concentrated pool state for pool %s", state.PoolId)
+		}
+	}
+	if len(concentratedPoolStates) != len(concentratedPoolIds) {
+		return fmt.Errorf("missing concentrated pool states")
 	}
+
+	var ticks = make(map[string]bool, len(data.Ticks))
+	for _, tick := range data.Ticks {
+		if !concentratedPoolIds[tick.PoolId] {
+			return fmt.Errorf("tick for unknown concentrated pool: %s", tick.PoolId)
+		}
+		key := string(GetTickKey(tick.PoolId, tick.Index))
+		if ticks[key] {
+			return fmt.Errorf("duplicate tick %d for pool %s", tick.Index, tick.PoolId)
+		}
+		ticks[key] = true
+
+		if tick.Index < MinTick || tick.Index > MaxTick ||
+			tick.LiquidityGross.IsNil() || !tick.LiquidityGross.IsPositive() ||
+			tick.LiquidityNet.IsNil() || tick.LiquidityNet.Abs().GT(tick.LiquidityGross) ||
+			tick.FeeGrowthOutsideStandard.IsNil() || tick.FeeGrowthOutsideStandard.IsNegative() ||
+			tick.FeeGrowthOutsideToken.IsNil() || tick.FeeGrowthOutsideToken.IsNegative() {
+			return fmt.Errorf("invalid tick %d for pool %s", tick.Index, tick.PoolId)
+		}
+	}
+
+	var positionIds = make(map[uint64]bool, len(data.Positions))
+	for _, position := range data.Positions {
+		if positionIds[position.Id] {
+			return fmt.Errorf("duplicate position: %d", position.Id)
+		}
+		positionIds[position.Id] = true
+
+		if position.Id == 0 || position.Id >= data.PositionSequence {
+			return fmt.Errorf("invalid position id: %d", position.Id)
+		}
+		if !concentratedLptDenoms[position.LptDenom] {
+			return fmt.Errorf("position %d for unknown concentrated pool: %s", position.Id, position.LptDenom)
+		}
+		if _, err := sdk.AccAddressFromBech32(position.Owner); err != nil {
+			return err
+		}
+		if err := ValidateTickRange(position.LowerTick, position.UpperTick); err != nil {
+			return err
+		}
+		if position.Liquidity.IsNil() || position.Liquidity.IsNegative() ||
+			position.FeeGrowthInsideStandardLast.IsNil() || position.FeeGrowthInsideTokenLast.IsNil() {
+			return fmt.Errorf("invalid position %d", position.Id)
+		}
+		if err := position.TokensOwed.Validate(); err != nil {
+			return err
+		}
+	}
+
+	var gaugeIds = make(map[uint64]bool, len(data.Gauges))
+	for _, gauge := range data.Gauges {
+		if gaugeIds[gauge.Id] {
+			return fmt.Errorf("duplicate gauge: %d", gauge.Id)
+		}
+		gaugeIds[gauge.Id] = true
+
+		if gauge.Id == 0 || gauge.Id >= data.GaugeSequence {
+			return fmt.Errorf("invalid gauge id: %d", gauge.Id)
+		}
+		if !lptDenoms[gauge.LptDenom] || concentratedLptDenoms[gauge.LptDenom] {
+			return fmt.Errorf("gauge %d for unknown pool: %s", gauge.Id, gauge.LptDenom)
+		}
+		if _, err := sdk.AccAddressFromBech32(gauge.Owner); err != nil {
+			return err
+		}
+		if err := ValidateGaugeCoins(gauge.Coins, gauge.NumEpochs); err != nil {
+			return err
+		}
+		if err := gauge.DistributedCoins.Validate(); err != nil {
+			return err
+		}
+		if gauge.FilledEpochs >= gauge.NumEpochs || !gauge.DistributedCoins.IsAllLTE(gauge.Coins) {
+			return fmt.Errorf("invalid gauge %d", gauge.Id)
+		}
+	}
+
+	var lockIds = make(map[uint64]bool, len(data.Locks))
+	var totalLocked = make(map[string]sdkmath.Int)
+	for _, lock := range data.Locks {
+		if lockIds[lock.Id] {
+			return fmt.Errorf("duplicate lock: %d", lock.Id)
+		}
+		lockIds[lock.Id] = true
+
+		if lock.Id == 0 || lock.Id >= data.LockSequence {
+			return fmt.Errorf("invalid lock id: %d", lock.Id)
+		}
+		if _, err := sdk.AccAddressFromBech32(lock.Owner); err != nil {
+			return err
+		}
+		if err := ValidateLockCoin(lock.Coin); err != nil {
+			return err
+		}
+		if !lptDenoms[lock.Coin.Denom] || concentratedLptDenoms[lock.Coin.Denom] {
+			return fmt.Errorf("lock %d for unknown pool: %s", lock.Id, lock.Coin.Denom)
+		}
+		if err := ValidateLockDuration(lock.Duration); err != nil {
+			return err
+		}
+		if err := lock.RewardPerShareLast.Validate(); err != nil {
+			return err
+		}
+		if err := lock.RewardsOwed.Validate(); err != nil {
+			return err
+		}
+
+		if locked, ok := totalLocked[lock.Coin.Denom]; ok {
+			totalLocked[lock.Coin.Denom] = locked.Add(lock.Coin.Amount)
+		} else {
+			totalLocked[lock.Coin.Denom] = lock.Coin.Amount
+		}
+	}
+
+	var lockRewards = make(map[string]bool, len(data.LockRewards))
+	for _, rewards := range data.LockRewards {
+		if !lptDenoms[rewards.LptDenom] || concentratedLptDenoms[rewards.LptDenom] {
+			return fmt.Errorf("lock rewards for unknown pool: %s", rewards.LptDenom)
+		}
+		if lockRewards[rewards.LptDenom] {
+			return fmt.Errorf("duplicate lock rewards for pool: %s", rewards.LptDenom)
+		}
+		lockRewards[rewards.LptDenom] = true
+
+		locked, ok := totalLocked[rewards.LptDenom]
+		if !ok {
+			locked = sdkmath.ZeroInt()
+		}
+		if rewards.TotalLocked.IsNil() || !rewards.TotalLocked.Equal(locked) {
+			return fmt.Errorf("invalid total locked for pool %s: %s", rewards.LptDenom, rewards.TotalLocked)
+		}
+		if err := rewards.RewardPerShare.Validate(); err != nil {
+			return err
+		}
+	}
+	for denom := range totalLocked {
+		if !lockRewards[denom] {
+			return fmt.Errorf("missing lock rewards for pool: %s", denom)
+		}
+	}
+
 	if maxSequence+1 != data.Sequence {
 		return fmt.Errorf("invalid sequence: %d", data.Sequence)
 	}
 	return data.Params.Validate()
 }
+
+// Validate returns an error if the pool statistics are invalid or belong to a pool
+// not in poolIds
+func (s PoolStats) Validate(poolIds map[string]bool) error {
+	if !poolIds[s.PoolId] {
+		return fmt.Errorf("stats for unknown pool: %s", s.PoolId)
+	}
+	if s.StandardVolume.IsNil() || s.StandardVolume.IsNegative() ||
+		s.TokenVolume.IsNil() || s.TokenVolume.IsNegative() ||
+		s.Liquidity.IsNil() || s.Liquidity.IsNegative() {
+		return fmt.Errorf("invalid stats for pool %s", s.PoolId)
+	}
+	return s.Fees.Validate()
+}
@@ -25,10 +25,40 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the coinswap module's genesis state
 type GenesisState struct {
-	Params        Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	StandardDenom string `protobuf:"bytes,2,opt,name=standard_denom,json=standardDenom,proto3" json:"standard_denom,omitempty" yaml:"standard_denom"`
-	Pool          []Pool `protobuf:"bytes,3,rep,name=pool,proto3" json:"pool"`
-	Sequence      uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Params        Params        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	StandardDenom string        `protobuf:"bytes,2,opt,name=standard_denom,json=standardDenom,proto3" json:"standard_denom,omitempty" yaml:"standard_denom"`
+	Pool          []Pool        `protobuf:"bytes,3,rep,name=pool,proto3" json:"pool"`
+	Sequence      uint64        `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	ProtocolFees  []ProtocolFee `protobuf:"bytes,5,rep,name=protocol_fees,json=protocolFees,proto3" json:"protocol_fees"`
+	TwapRecords   []TwapRecord  `protobuf:"bytes,6,rep,name=twap_records,json=twapRecords,proto3" json:"twap_records"`
+	// statistics of the ended epochs
+	PoolStats []PoolStats `protobuf:"bytes,7,rep,name=pool_stats,json=poolStats,proto3" json:"pool_stats"`
+	// statistics of the ongoing epoch
+	CurrentPoolStats []PoolStats `protobuf:"bytes,8,rep,name=current_pool_stats,json=currentPoolStats,proto3" json:"current_pool_stats"`
+	// reference prices of the pool circuit breakers
+	PriceReferences []PriceReference `protobuf:"bytes,9,rep,name=price_references,json=priceReferences,proto3" json:"price_references"`
+	// open limit orders
+	LimitOrders []LimitOrder `protobuf:"bytes,10,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders"`
+	// id of the next limit order
+	LimitOrderSequence uint64 `protobuf:"varint,11,opt,name=limit_order_sequence,json=limitOrderSequence,proto3" json:"limit_order_sequence,omitempty"`
+	// pricing states of the concentrated liquidity pools
+	ConcentratedPoolStates []ConcentratedPoolState `protobuf:"bytes,12,rep,name=concentrated_pool_states,json=concentratedPoolStates,proto3" json:"concentrated_pool_states"`
+	// initialized ticks of the concentrated liquidity pools
+	Ticks []Tick `protobuf:"bytes,13,rep,name=ticks,proto3" json:"ticks"`
+	// positions of the concentrated liquidity pools
+	Positions []Position `protobuf:"bytes,14,rep,name=positions,proto3" json:"positions"`
+	// id of the next position
+	PositionSequence uint64 `protobuf:"varint,15,opt,name=position_sequence,json=positionSequence,proto3" json:"position_sequence,omitempty"`
+	// reward gauges of the liquidity pool coins
+	Gauges []Gauge `protobuf:"bytes,16,rep,name=gauges,proto3" json:"gauges"`
+	// id of the next gauge
+	GaugeSequence uint64 `protobuf:"varint,17,opt,name=gauge_sequence,json=gaugeSequence,proto3" json:"gauge_sequence,omitempty"`
+	// locks of the liquidity pool coins
+	Locks []Lock `protobuf:"bytes,18,rep,name=locks,proto3" json:"locks"`
+	// id of the next lock
+	LockSequence uint64 `protobuf:"varint,19,opt,name=lock_sequence,json=lockSequence,proto3" json:"lock_sequence,omitempty"`
+	// rewards distributed to the locks of each liquidity pool coin denom
+	LockRewards []LockRewards `protobuf:"bytes,20,rep,name=lock_rewards,json=lockRewards,proto3" json:"lock_rewards"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -92,6 +122,118 @@ func (m *GenesisState) GetSequence() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetProtocolFees() []ProtocolFee {
+	if m != nil {
+		return m.ProtocolFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTwapRecords() []TwapRecord {
+	if m != nil {
+		return m.TwapRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolStats() []PoolStats {
+	if m != nil {
+		return m.PoolStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCurrentPoolStats() []PoolStats {
+	if m != nil {
+		return m.CurrentPoolStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceReferences() []PriceReference {
+	if m != nil {
+		return m.PriceReferences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLimitOrders() []LimitOrder {
+	if m != nil {
+		return m.LimitOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLimitOrderSequence() uint64 {
+	if m != nil {
+		return m.LimitOrderSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetConcentratedPoolStates() []ConcentratedPoolState {
+	if m != nil {
+		return m.ConcentratedPoolStates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTicks() []Tick {
+	if m != nil {
+		return m.Ticks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPositions() []Position {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPositionSequence() uint64 {
+	if m != nil {
+		return m.PositionSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetGauges() []Gauge {
+	if m != nil {
+		return m.Gauges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGaugeSequence() uint64 {
+	if m != nil {
+		return m.GaugeSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLocks() []Lock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockSequence() uint64 {
+	if m != nil {
+		return m.LockSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLockRewards() []LockRewards {
+	if m != nil {
+		return m.LockRewards
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "canto.coinswap.v1.GenesisState")
 }
@@ -99,26 +241,48 @@ func init() {
 func init() { proto.RegisterFile("canto/coinswap/v1/genesis.proto", fileDescriptor_c9ad4016b090c47e) }
 
 var fileDescriptor_c9ad4016b090c47e = []byte{
-	// 299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x4e, 0xcc, 0x2b,
-	0xc9, 0xd7, 0x4f, 0xce, 0xcf, 0xcc, 0x2b, 0x2e, 0x4f, 0x2c, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x4f,
-	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x2b, 0xd0,
-	0x83, 0x29, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xea, 0x83, 0x58,
-	0x10, 0x85, 0x52, 0x0a, 0x98, 0x26, 0xc1, 0x35, 0x81, 0x55, 0x28, 0xdd, 0x60, 0xe4, 0xe2, 0x71,
-	0x87, 0x18, 0x1e, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xce, 0xc5, 0x56, 0x90, 0x58, 0x94, 0x98,
-	0x5b, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa9, 0x87, 0x61, 0x99, 0x5e, 0x00, 0x58,
-	0x81, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0xe5, 0x42, 0x0e, 0x5c, 0x7c, 0xc5, 0x25,
-	0x89, 0x79, 0x29, 0x89, 0x45, 0x29, 0xf1, 0x29, 0xa9, 0x79, 0xf9, 0xb9, 0x12, 0x4c, 0x0a, 0x8c,
-	0x1a, 0x9c, 0x4e, 0x92, 0x9f, 0xee, 0xc9, 0x8b, 0x56, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0xa1, 0xca,
-	0x2b, 0x05, 0xf1, 0xc2, 0x04, 0x5c, 0x40, 0x7c, 0x21, 0x43, 0x2e, 0x96, 0x82, 0xfc, 0xfc, 0x1c,
-	0x09, 0x66, 0x05, 0x66, 0x0d, 0x6e, 0x23, 0x71, 0x6c, 0x16, 0xe7, 0xe7, 0xe7, 0x40, 0xad, 0x05,
-	0x2b, 0x15, 0x92, 0xe2, 0xe2, 0x28, 0x4e, 0x2d, 0x2c, 0x4d, 0xcd, 0x4b, 0x4e, 0x95, 0x60, 0x51,
-	0x60, 0xd4, 0x60, 0x09, 0x82, 0xf3, 0x9d, 0x7c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e,
-	0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58,
-	0x8e, 0x21, 0xca, 0x28, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xdf, 0x19,
-	0x64, 0x89, 0xae, 0x5f, 0x6a, 0x49, 0x79, 0x7e, 0x51, 0x36, 0x84, 0xa7, 0x5f, 0x66, 0xa1, 0x5f,
-	0x81, 0x08, 0xb4, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x78, 0x19, 0x03, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x2d, 0xff, 0x74, 0xb3, 0x9d, 0x01, 0x00, 0x00,
+	// 650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x4f, 0x13, 0x41,
+	0x18, 0xc6, 0xbb, 0x52, 0x2a, 0x9d, 0xb6, 0x50, 0x46, 0xd4, 0x01, 0x75, 0xa9, 0x18, 0x93, 0x26,
+	0xc6, 0xae, 0x40, 0xa2, 0xc6, 0x8b, 0x0a, 0x06, 0x62, 0x42, 0x94, 0x2c, 0x9c, 0xbc, 0x6c, 0x86,
+	0xe9, 0xcb, 0xb2, 0x61, 0xbb, 0xb3, 0xce, 0x4c, 0xa9, 0x7c, 0x0b, 0x3f, 0x16, 0x47, 0x8e, 0x5e,
+	0x24, 0x86, 0x7e, 0x03, 0x3f, 0x81, 0x99, 0xd9, 0x7f, 0x25, 0x6c, 0x13, 0x6f, 0x33, 0xcf, 0x3c,
+	0xf3, 0x7b, 0x67, 0x9e, 0x77, 0x67, 0xd1, 0x2a, 0xa3, 0x91, 0xe2, 0x0e, 0xe3, 0x41, 0x24, 0x47,
+	0x34, 0x76, 0xce, 0xd6, 0x1d, 0x1f, 0x22, 0x90, 0x81, 0xec, 0xc5, 0x82, 0x2b, 0x8e, 0x17, 0x8d,
+	0xa1, 0x97, 0x19, 0x7a, 0x67, 0xeb, 0x2b, 0x4b, 0x3e, 0xf7, 0xb9, 0x59, 0x75, 0xf4, 0x28, 0x31,
+	0xae, 0x74, 0x6e, 0x93, 0xf2, 0x4d, 0xc6, 0xb1, 0xf6, 0xbb, 0x8e, 0x9a, 0xbb, 0x09, 0xfc, 0x40,
+	0x51, 0x05, 0xf8, 0x0d, 0xaa, 0xc5, 0x54, 0xd0, 0x81, 0x24, 0x56, 0xc7, 0xea, 0x36, 0x36, 0x96,
+	0x7b, 0xb7, 0x8a, 0xf5, 0xf6, 0x8d, 0x61, 0xab, 0x7a, 0x71, 0xb5, 0x5a, 0x71, 0x53, 0x3b, 0xfe,
+	0x80, 0xe6, 0xa5, 0xa2, 0x51, 0x9f, 0x8a, 0xbe, 0xd7, 0x87, 0x88, 0x0f, 0xc8, 0x9d, 0x8e, 0xd5,
+	0xad, 0x6f, 0x2d, 0xff, 0xbd, 0x5a, 0xbd, 0x7f, 0x4e, 0x07, 0xe1, 0xbb, 0xb5, 0x9b, 0xeb, 0x6b,
+	0x6e, 0x2b, 0x13, 0x3e, 0xe9, 0x39, 0x5e, 0x47, 0xd5, 0x98, 0xf3, 0x90, 0xcc, 0x74, 0x66, 0xba,
+	0x8d, 0x8d, 0x87, 0x65, 0x85, 0x39, 0x0f, 0xd3, 0xb2, 0xc6, 0x8a, 0x57, 0xd0, 0x9c, 0x84, 0xef,
+	0x43, 0x88, 0x18, 0x90, 0x6a, 0xc7, 0xea, 0x56, 0xdd, 0x7c, 0x8e, 0x3f, 0xa3, 0x96, 0xb9, 0x23,
+	0xe3, 0xa1, 0x77, 0x0c, 0x20, 0xc9, 0xac, 0xe1, 0xda, 0x65, 0xdc, 0xd4, 0xb7, 0x03, 0x90, 0xe2,
+	0x9b, 0x71, 0x21, 0x49, 0xbc, 0x83, 0x9a, 0x6a, 0x44, 0x63, 0x4f, 0x00, 0xe3, 0xa2, 0x2f, 0x49,
+	0xcd, 0x90, 0x9e, 0x94, 0x90, 0x0e, 0x47, 0x34, 0x76, 0x8d, 0x2b, 0x05, 0x35, 0x54, 0xae, 0x48,
+	0xfc, 0x11, 0x21, 0x7d, 0x6c, 0x4f, 0x2a, 0xaa, 0x24, 0xb9, 0x6b, 0x28, 0x8f, 0xa7, 0xdc, 0x53,
+	0xb7, 0x23, 0xcb, 0xb8, 0x1e, 0x67, 0x02, 0xde, 0x47, 0x98, 0x0d, 0x85, 0x80, 0x48, 0x79, 0x13,
+	0xa8, 0xb9, 0xff, 0x46, 0xb5, 0xd3, 0xdd, 0xb9, 0x8e, 0x5d, 0xd4, 0x8e, 0x45, 0xc0, 0xc0, 0x13,
+	0x70, 0x0c, 0x42, 0x47, 0x27, 0x49, 0xdd, 0xf0, 0x9e, 0x96, 0x46, 0x15, 0x30, 0x70, 0x33, 0x67,
+	0x0a, 0x5d, 0x88, 0x6f, 0xa8, 0x26, 0xb0, 0x30, 0x18, 0x04, 0xca, 0xe3, 0xa2, 0x0f, 0x42, 0x12,
+	0x34, 0x35, 0xb0, 0x3d, 0x6d, 0xfb, 0xaa, 0x5d, 0x59, 0x60, 0x61, 0xae, 0x48, 0xfc, 0x0a, 0x2d,
+	0x4d, 0x70, 0xbc, 0xbc, 0xd7, 0x0d, 0xd3, 0x6b, 0x5c, 0x58, 0x0f, 0xb2, 0xae, 0x9f, 0x20, 0xc2,
+	0x78, 0xc4, 0x20, 0x52, 0x82, 0x2a, 0xe8, 0x17, 0x21, 0x81, 0x24, 0x4d, 0x73, 0x8a, 0x6e, 0xc9,
+	0x29, 0xb6, 0x27, 0xb6, 0x64, 0xc9, 0x64, 0x97, 0x7b, 0xc0, 0xca, 0x16, 0x25, 0xde, 0x44, 0xb3,
+	0x2a, 0x60, 0xa7, 0x92, 0xb4, 0xa6, 0x7e, 0xaf, 0x87, 0x01, 0x3b, 0x4d, 0x29, 0x89, 0x17, 0xbf,
+	0x47, 0xf5, 0x98, 0xcb, 0x40, 0x05, 0x3c, 0x92, 0x64, 0xde, 0x6c, 0x7c, 0x54, 0xda, 0xb5, 0xc4,
+	0x53, 0xf4, 0x3f, 0xdd, 0x83, 0x5f, 0xa0, 0xc5, 0x6c, 0x52, 0xc4, 0xb1, 0x60, 0xe2, 0x68, 0x67,
+	0x0b, 0x79, 0x18, 0xaf, 0x51, 0xcd, 0xa7, 0x43, 0x1f, 0x24, 0x69, 0x9b, 0x52, 0xa4, 0xa4, 0xd4,
+	0xae, 0x36, 0x64, 0x6f, 0x39, 0x71, 0xe3, 0xe7, 0x68, 0xde, 0x8c, 0x8a, 0x0a, 0x8b, 0xa6, 0x42,
+	0xcb, 0xa8, 0x39, 0x7e, 0x13, 0xcd, 0x86, 0x5c, 0x27, 0x80, 0xa7, 0x26, 0xb0, 0xc7, 0x8b, 0x04,
+	0x8c, 0x17, 0x3f, 0x43, 0x2d, 0x3d, 0x28, 0xd0, 0xf7, 0x0c, 0xba, 0xa9, 0xc5, 0x9c, 0xbc, 0x8b,
+	0xcc, 0xdc, 0x13, 0x30, 0xa2, 0xfa, 0xc1, 0x2d, 0x4d, 0x7d, 0xba, 0xba, 0x80, 0x9b, 0xb8, 0xf2,
+	0x0f, 0x68, 0x42, 0xda, 0xbb, 0xb8, 0xb6, 0xad, 0xcb, 0x6b, 0xdb, 0xfa, 0x73, 0x6d, 0x5b, 0x3f,
+	0xc7, 0x76, 0xe5, 0x72, 0x6c, 0x57, 0x7e, 0x8d, 0xed, 0xca, 0xb7, 0x0d, 0x3f, 0x50, 0x27, 0xc3,
+	0xa3, 0x1e, 0xe3, 0x03, 0x67, 0x5b, 0x63, 0x5f, 0x7e, 0x01, 0x35, 0xe2, 0xe2, 0x34, 0x99, 0x39,
+	0x67, 0x6f, 0x9d, 0x1f, 0xc5, 0x9f, 0x53, 0x9d, 0xc7, 0x20, 0x8f, 0x6a, 0xe6, 0xaf, 0xb0, 0xf9,
+	0x6f, 0x00, 0x60, 0xa3, 0x99, 0xcc, 0xa2, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -141,6 +305,204 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LockRewards) > 0 {
+		for iNdEx := len(m.LockRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.LockSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LockSequence))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.GaugeSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GaugeSequence))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.Gauges) > 0 {
+		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.PositionSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PositionSequence))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Ticks) > 0 {
+		for iNdEx := len(m.Ticks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ticks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ConcentratedPoolStates) > 0 {
+		for iNdEx := len(m.ConcentratedPoolStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConcentratedPoolStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.LimitOrderSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LimitOrderSequence))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PriceReferences) > 0 {
+		for iNdEx := len(m.PriceReferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceReferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.CurrentPoolStats) > 0 {
+		for iNdEx := len(m.CurrentPoolStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentPoolStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PoolStats) > 0 {
+		for iNdEx := len(m.PoolStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.TwapRecords) > 0 {
+		for iNdEx := len(m.TwapRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TwapRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ProtocolFees) > 0 {
+		for iNdEx := len(m.ProtocolFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.Sequence != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
 		i--
@@ -153,106 +515,565 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.StandardDenom) > 0 {
+		i -= len(m.StandardDenom)
+		copy(dAtA[i:], m.StandardDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StandardDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.StandardDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Pool) > 0 {
+		for _, e := range m.Pool {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	if len(m.ProtocolFees) > 0 {
+		for _, e := range m.ProtocolFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TwapRecords) > 0 {
+		for _, e := range m.TwapRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolStats) > 0 {
+		for _, e := range m.PoolStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CurrentPoolStats) > 0 {
+		for _, e := range m.CurrentPoolStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceReferences) > 0 {
+		for _, e := range m.PriceReferences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LimitOrderSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.LimitOrderSequence))
+	}
+	if len(m.ConcentratedPoolStates) > 0 {
+		for _, e := range m.ConcentratedPoolStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Ticks) > 0 {
+		for _, e := range m.Ticks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PositionSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.PositionSequence))
+	}
+	if len(m.Gauges) > 0 {
+		for _, e := range m.Gauges {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.GaugeSequence != 0 {
+		n += 2 + sovGenesis(uint64(m.GaugeSequence))
+	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LockSequence != 0 {
+		n += 2 + sovGenesis(uint64(m.LockSequence))
+	}
+	if len(m.LockRewards) > 0 {
+		for _, e := range m.LockRewards {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandardDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StandardDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = append(m.Pool, Pool{})
+			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFees = append(m.ProtocolFees, ProtocolFee{})
+			if err := m.ProtocolFees[len(m.ProtocolFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TwapRecords = append(m.TwapRecords, TwapRecord{})
+			if err := m.TwapRecords[len(m.TwapRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolStats = append(m.PoolStats, PoolStats{})
+			if err := m.PoolStats[len(m.PoolStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPoolStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentPoolStats = append(m.CurrentPoolStats, PoolStats{})
+			if err := m.CurrentPoolStats[len(m.CurrentPoolStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceReferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceReferences = append(m.PriceReferences, PriceReference{})
+			if err := m.PriceReferences[len(m.PriceReferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrders = append(m.LimitOrders, LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderSequence", wireType)
+			}
+			m.LimitOrderSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitOrderSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.StandardDenom) > 0 {
-		i -= len(m.StandardDenom)
-		copy(dAtA[i:], m.StandardDenom)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StandardDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = len(m.StandardDenom)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.Pool) > 0 {
-		for _, e := range m.Pool {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.Sequence != 0 {
-		n += 1 + sovGenesis(uint64(m.Sequence))
-	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedPoolStates", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ConcentratedPoolStates = append(m.ConcentratedPoolStates, ConcentratedPoolState{})
+			if err := m.ConcentratedPoolStates[len(m.ConcentratedPoolStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -279,15 +1100,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Ticks = append(m.Ticks, Tick{})
+			if err := m.Ticks[len(m.Ticks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StandardDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -297,27 +1119,48 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StandardDenom = string(dAtA[iNdEx:postIndex])
+			m.Positions = append(m.Positions, Position{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionSequence", wireType)
+			}
+			m.PositionSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -344,16 +1187,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pool = append(m.Pool, Pool{})
-			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Gauges = append(m.Gauges, Gauge{})
+			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 17:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeSequence", wireType)
 			}
-			m.Sequence = 0
+			m.GaugeSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -363,11 +1206,98 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				m.GaugeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, Lock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockSequence", wireType)
+			}
+			m.LockSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockRewards = append(m.LockRewards, LockRewards{})
+			if err := m.LockRewards[len(m.LockRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -26,6 +26,129 @@ const (
 	// KeyPoolLptDenom is the key used to store the pool information  in
 	// the keeper.
 	KeyPoolLptDenom = "lptDenom"
+
+	// KeyProtocolFee is the key used to store the protocol fees accumulated
+	// by a pool in the keeper.
+	KeyProtocolFee = "protocolFee"
+
+	// KeyTwapRecord is the key used to store the twap records of a pool in
+	// the keeper.
+	KeyTwapRecord = "twapRecord"
+
+	// KeyTwapSequence is the key used to store the number of twap records
+	// appended for a pool in the keeper.
+	KeyTwapSequence = "twapSequence"
+
+	// KeyPoolStats is the key used to store the statistics of the ended
+	// epochs of a pool in the keeper.
+	KeyPoolStats = "stats"
+
+	// KeyCurrentPoolStats is the key used to store the statistics of the
+	// ongoing epoch of a pool in the keeper.
+	KeyCurrentPoolStats = "currentStats"
+
+	// KeyPriceReference is the key used to store the reference prices of the
+	// circuit breaker of a pool in the keeper.
+	KeyPriceReference = "priceReference"
+
+	// KeyLimitOrder is the key used to store the open limit orders in the
+	// keeper.
+	KeyLimitOrder = "limitOrder"
+
+	// KeyLimitOrderByOwner is the key used to index the open limit orders by
+	// owner in the keeper.
+	KeyLimitOrderByOwner = "limitOrderByOwner"
+
+	// KeyLimitOrderByPool is the key used to index the open limit orders by
+	// pool in the keeper.
+	KeyLimitOrderByPool = "limitOrderByPool"
+
+	// KeyNextLimitOrderSequence is the key used to store the id of the next
+	// limit order in the keeper.
+	KeyNextLimitOrderSequence = "nextLimitOrderSequence"
+
+	// KeyLimitOrderCursor is the key used to store the id of the limit order
+	// the end blocker resumes filling from in the keeper.
+	KeyLimitOrderCursor = "limitOrderCursor"
+
+	// KeyConcentratedPoolState is the key used to store the pricing state of a
+	// concentrated liquidity pool in the keeper.
+	KeyConcentratedPoolState = "clPoolState"
+
+	// KeyTick is the key used to store the initialized ticks of a
+	// concentrated liquidity pool in the keeper.
+	KeyTick = "tick"
+
+	// KeyPosition is the key used to store the concentrated liquidity
+	// positions in the keeper.
+	KeyPosition = "position"
+
+	// KeyPositionByOwner is the key used to index the concentrated liquidity
+	// positions by owner in the keeper.
+	KeyPositionByOwner = "positionByOwner"
+
+	// KeyPositionByPool is the key used to index the concentrated liquidity
+	// positions by pool in the keeper.
+	KeyPositionByPool = "positionByPool"
+
+	// KeyNextPositionSequence is the key used to store the id of the next
+	// position in the keeper.
+	KeyNextPositionSequence = "nextPositionSequence"
+
+	// KeyGauge is the key used to store the reward gauges in the keeper.
+	KeyGauge = "gauge"
+
+	// KeyGaugeByPool is the key used to index the reward gauges by liquidity
+	// pool coin denom in the keeper.
+	KeyGaugeByPool = "gaugeByPool"
+
+	// KeyNextGaugeSequence is the key used to store the id of the next gauge
+	// in the keeper.
+	KeyNextGaugeSequence = "nextGaugeSequence"
+
+	// KeyLock is the key used to store the locks of liquidity pool coins in
+	// the keeper.
+	KeyLock = "lock"
+
+	// KeyLockByOwner is the key used to index the locks by owner in the
+	// keeper.
+	KeyLockByOwner = "lockByOwner"
+
+	// KeyLockByPool is the key used to index the locks by liquidity pool coin
+	// denom in the keeper.
+	KeyLockByPool = "lockByPool"
+
+	// KeyNextLockSequence is the key used to store the id of the next lock in
+	// the keeper.
+	KeyNextLockSequence = "nextLockSequence"
+
+	// KeyLockRewards is the key used to store the rewards distributed to the
+	// locks of a liquidity pool coin denom in the keeper.
+	KeyLockRewards = "lockRewards"
+
+	// KeyKPerShare is the key used to store the highest constant product per
+	// squared liquidity pool coin reached by a pool in the keeper.
+	KeyKPerShare = "kPerShare"
+
+	// MaxTwapRecords is the number of twap records kept per pool, older
+	// records are overwritten.
+	MaxTwapRecords = 1000
+
+	// MaxDustReserve is the greatest reserve a pool may hold once its whole
+	// liquidity pool coin supply has been burned.
+	MaxDustReserve = 1000
+
+	// MaxAmplification is the maximum amplification coefficient of a
+	// stableswap pool.
+	MaxAmplification = 10000
+
+	// MinTick is the lowest tick of a concentrated liquidity pool, pricing
+	// the counterparty coin at about 4.2e-18 standard coin.
+	MinTick int64 = -400000
+
+	// MaxTick is the highest tick of a concentrated liquidity pool, pricing
+	// the counterparty coin at about 2.4e17 standard coin.
+	MaxTick int64 = 400000
 )
 
 // GetPoolKey return the stored pool key for the given pooId.
@@ -37,3 +160,156 @@ func GetPoolKey(pooId string) []byte {
 func GetLptDenomKey(lptDenom string) []byte {
 	return []byte(fmt.Sprintf("%s/%s", KeyPoolLptDenom, lptDenom))
 }
+
+// GetProtocolFeeKey return the stored protocol fee key for the given poolId.
+func GetProtocolFeeKey(poolId string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyProtocolFee, poolId))
+}
+
+// GetTwapRecordKey return the stored twap record key for the given poolId and sequence.
+func GetTwapRecordKey(poolId string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%d", KeyTwapRecord, poolId, sequence%MaxTwapRecords))
+}
+
+// GetTwapSequenceKey return the stored twap sequence key for the given poolId.
+func GetTwapSequenceKey(poolId string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyTwapSequence, poolId))
+}
+
+// GetPoolStatsKey return the stored pool statistics key for the given poolId and epoch number.
+func GetPoolStatsKey(poolId string, epochNumber int64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%020d", KeyPoolStats, poolId, epochNumber))
+}
+
+// GetPoolStatsPrefix return the stored pool statistics prefix for the given poolId.
+func GetPoolStatsPrefix(poolId string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyPoolStats, poolId))
+}
+
+// GetCurrentPoolStatsKey return the stored current pool statistics key for the given poolId.
+func GetCurrentPoolStatsKey(poolId string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyCurrentPoolStats, poolId))
+}
+
+// GetPriceReferenceKey return the stored price reference key for the given poolId.
+func GetPriceReferenceKey(poolId string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyPriceReference, poolId))
+}
+
+// GetLimitOrderKey return the stored limit order key for the given order id.
+func GetLimitOrderKey(orderId uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%020d", KeyLimitOrder, orderId))
+}
+
+// GetLimitOrderByOwnerKey return the stored limit order index key for the given owner and order id.
+func GetLimitOrderByOwnerKey(owner string, orderId uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%020d", KeyLimitOrderByOwner, owner, orderId))
+}
+
+// GetLimitOrderByOwnerPrefix return the stored limit order index prefix for the given owner.
+func GetLimitOrderByOwnerPrefix(owner string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyLimitOrderByOwner, owner))
+}
+
+// GetLimitOrderByPoolKey return the stored limit order index key for the given lptDenom and order id.
+func GetLimitOrderByPoolKey(lptDenom string, orderId uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%020d", KeyLimitOrderByPool, lptDenom, orderId))
+}
+
+// GetLimitOrderByPoolPrefix return the stored limit order index prefix for the given lptDenom.
+func GetLimitOrderByPoolPrefix(lptDenom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyLimitOrderByPool, lptDenom))
+}
+
+// GetConcentratedPoolStateKey return the stored concentrated pool state key for the given poolId.
+func GetConcentratedPoolStateKey(poolId string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyConcentratedPoolState, poolId))
+}
+
+// GetTickKey return the stored tick key for the given poolId and tick index.
+// The sign bit of the index is flipped so that the keys sort by index.
+func GetTickKey(poolId string, index int64) []byte {
+	return append(GetTickPrefix(poolId), []byte(fmt.Sprintf("%020d", uint64(index)^(1<<63)))...)
+}
+
+// GetTickPrefix return the stored tick prefix for the given poolId. The poolId is
+// prefixed by its length, so that the ticks of a pool whose id starts with the id
+// of another pool, as ibc denoms do, are never iterated as the ticks of the latter.
+func GetTickPrefix(poolId string) []byte {
+	return []byte(fmt.Sprintf("%s/%d/%s/", KeyTick, len(poolId), poolId))
+}
+
+// GetPositionKey return the stored position key for the given position id.
+func GetPositionKey(positionId uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%020d", KeyPosition, positionId))
+}
+
+// GetPositionByOwnerKey return the stored position index key for the given owner and position id.
+func GetPositionByOwnerKey(owner string, positionId uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%020d", KeyPositionByOwner, owner, positionId))
+}
+
+// GetPositionByOwnerPrefix return the stored position index prefix for the given owner.
+func GetPositionByOwnerPrefix(owner string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyPositionByOwner, owner))
+}
+
+// GetPositionByPoolKey return the stored position index key for the given lptDenom and position id.
+func GetPositionByPoolKey(lptDenom string, positionId uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%020d", KeyPositionByPool, lptDenom, positionId))
+}
+
+// GetPositionByPoolPrefix return the stored position index prefix for the given lptDenom.
+func GetPositionByPoolPrefix(lptDenom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyPositionByPool, lptDenom))
+}
+
+// GetGaugeKey return the stored gauge key for the given gauge id.
+func GetGaugeKey(gaugeId uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%020d", KeyGauge, gaugeId))
+}
+
+// GetGaugeByPoolKey return the stored gauge index key for the given lptDenom and gauge id.
+func GetGaugeByPoolKey(lptDenom string, gaugeId uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%020d", KeyGaugeByPool, lptDenom, gaugeId))
+}
+
+// GetGaugeByPoolPrefix return the stored gauge index prefix for the given lptDenom.
+func GetGaugeByPoolPrefix(lptDenom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyGaugeByPool, lptDenom))
+}
+
+// GetLockKey return the stored lock key for the given lock id.
+func GetLockKey(lockId uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%020d", KeyLock, lockId))
+}
+
+// GetLockByOwnerKey return the stored lock index key for the given owner and lock id.
+func GetLockByOwnerKey(owner string, lockId uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%020d", KeyLockByOwner, owner, lockId))
+}
+
+// GetLockByOwnerPrefix return the stored lock index prefix for the given owner.
+func GetLockByOwnerPrefix(owner string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyLockByOwner, owner))
+}
+
+// GetLockByPoolKey return the stored lock index key for the given lptDenom and lock id.
+func GetLockByPoolKey(lptDenom string, lockId uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%020d", KeyLockByPool, lptDenom, lockId))
+}
+
+// GetLockByPoolPrefix return the stored lock index prefix for the given lptDenom.
+func GetLockByPoolPrefix(lptDenom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyLockByPool, lptDenom))
+}
+
+// GetLockRewardsKey return the stored lock rewards key for the given lptDenom.
+func GetLockRewardsKey(lptDenom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyLockRewards, lptDenom))
+}
+
+// GetKPerShareKey return the stored constant product per share key for the given lptDenom.
+func GetKPerShareKey(lptDenom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyKPerShare, lptDenom))
+}
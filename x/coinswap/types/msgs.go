@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/tx/signing"
@@ -14,6 +15,20 @@ var (
 	_ sdk.Msg = &MsgSwapOrder{}
 	_ sdk.Msg = &MsgAddLiquidity{}
 	_ sdk.Msg = &MsgRemoveLiquidity{}
+	_ sdk.Msg = &MsgSwapExactAmountInRoute{}
+	_ sdk.Msg = &MsgSwapExactAmountOutRoute{}
+	_ sdk.Msg = &MsgAddLiquiditySingle{}
+	_ sdk.Msg = &MsgRemoveLiquiditySingle{}
+	_ sdk.Msg = &MsgPlaceLimitOrder{}
+	_ sdk.Msg = &MsgCancelLimitOrder{}
+	_ sdk.Msg = &MsgCreatePosition{}
+	_ sdk.Msg = &MsgIncreasePosition{}
+	_ sdk.Msg = &MsgDecreasePosition{}
+	_ sdk.Msg = &MsgCollectFees{}
+	_ sdk.Msg = &MsgCreateGauge{}
+	_ sdk.Msg = &MsgLockLiquidity{}
+	_ sdk.Msg = &MsgUnlockLiquidity{}
+	_ sdk.Msg = &MsgClaimLockRewards{}
 )
 
 const (
@@ -82,6 +97,220 @@ func NewMsgRemoveLiquidity(
 	}
 }
 
+// NewMsgSwapExactAmountInRoute creates a new MsgSwapExactAmountInRoute object
+func NewMsgSwapExactAmountInRoute(
+	sender string,
+	tokenIn sdk.Coin,
+	tokenOutMin sdk.Coin,
+	recipient string,
+	deadline int64,
+) *MsgSwapExactAmountInRoute {
+	return &MsgSwapExactAmountInRoute{
+		Sender:      sender,
+		TokenIn:     tokenIn,
+		TokenOutMin: tokenOutMin,
+		Recipient:   recipient,
+		Deadline:    deadline,
+	}
+}
+
+// NewMsgSwapExactAmountOutRoute creates a new MsgSwapExactAmountOutRoute object
+func NewMsgSwapExactAmountOutRoute(
+	sender string,
+	tokenInMax sdk.Coin,
+	tokenOut sdk.Coin,
+	recipient string,
+	deadline int64,
+) *MsgSwapExactAmountOutRoute {
+	return &MsgSwapExactAmountOutRoute{
+		Sender:     sender,
+		TokenInMax: tokenInMax,
+		TokenOut:   tokenOut,
+		Recipient:  recipient,
+		Deadline:   deadline,
+	}
+}
+
+// NewMsgAddLiquiditySingle creates a new MsgAddLiquiditySingle object
+func NewMsgAddLiquiditySingle(
+	sender string,
+	lptDenom string,
+	tokenIn sdk.Coin,
+	minLiquidity sdkmath.Int,
+	deadline int64,
+) *MsgAddLiquiditySingle {
+	return &MsgAddLiquiditySingle{
+		Sender:       sender,
+		LptDenom:     lptDenom,
+		TokenIn:      tokenIn,
+		MinLiquidity: minLiquidity,
+		Deadline:     deadline,
+	}
+}
+
+// NewMsgRemoveLiquiditySingle creates a new MsgRemoveLiquiditySingle object
+func NewMsgRemoveLiquiditySingle(
+	sender string,
+	withdrawLiquidity sdk.Coin,
+	tokenOutMin sdk.Coin,
+	deadline int64,
+) *MsgRemoveLiquiditySingle {
+	return &MsgRemoveLiquiditySingle{
+		Sender:            sender,
+		WithdrawLiquidity: withdrawLiquidity,
+		TokenOutMin:       tokenOutMin,
+		Deadline:          deadline,
+	}
+}
+
+// NewMsgPlaceLimitOrder creates a new MsgPlaceLimitOrder object
+func NewMsgPlaceLimitOrder(
+	sender string,
+	tokenIn sdk.Coin,
+	minTokenOut sdk.Coin,
+	deadline int64,
+) *MsgPlaceLimitOrder {
+	return &MsgPlaceLimitOrder{
+		Sender:      sender,
+		TokenIn:     tokenIn,
+		MinTokenOut: minTokenOut,
+		Deadline:    deadline,
+	}
+}
+
+// NewMsgCancelLimitOrder creates a new MsgCancelLimitOrder object
+func NewMsgCancelLimitOrder(
+	sender string,
+	orderId uint64,
+) *MsgCancelLimitOrder {
+	return &MsgCancelLimitOrder{
+		Sender:  sender,
+		OrderId: orderId,
+	}
+}
+
+// NewMsgCreatePosition creates a new MsgCreatePosition object
+func NewMsgCreatePosition(
+	sender string,
+	maxToken sdk.Coin,
+	maxStandardAmt sdkmath.Int,
+	lowerTick int64,
+	upperTick int64,
+	minLiquidity sdkmath.Int,
+	initialPrice *sdkmath.LegacyDec,
+	deadline int64,
+) *MsgCreatePosition {
+	return &MsgCreatePosition{
+		Sender:         sender,
+		MaxToken:       maxToken,
+		MaxStandardAmt: maxStandardAmt,
+		LowerTick:      lowerTick,
+		UpperTick:      upperTick,
+		MinLiquidity:   minLiquidity,
+		InitialPrice:   initialPrice,
+		Deadline:       deadline,
+	}
+}
+
+// NewMsgIncreasePosition creates a new MsgIncreasePosition object
+func NewMsgIncreasePosition(
+	sender string,
+	positionId uint64,
+	maxTokenAmt sdkmath.Int,
+	maxStandardAmt sdkmath.Int,
+	minLiquidity sdkmath.Int,
+	deadline int64,
+) *MsgIncreasePosition {
+	return &MsgIncreasePosition{
+		Sender:         sender,
+		PositionId:     positionId,
+		MaxTokenAmt:    maxTokenAmt,
+		MaxStandardAmt: maxStandardAmt,
+		MinLiquidity:   minLiquidity,
+		Deadline:       deadline,
+	}
+}
+
+// NewMsgDecreasePosition creates a new MsgDecreasePosition object
+func NewMsgDecreasePosition(
+	sender string,
+	positionId uint64,
+	liquidity sdkmath.Int,
+	minTokenAmt sdkmath.Int,
+	minStandardAmt sdkmath.Int,
+	deadline int64,
+) *MsgDecreasePosition {
+	return &MsgDecreasePosition{
+		Sender:         sender,
+		PositionId:     positionId,
+		Liquidity:      liquidity,
+		MinTokenAmt:    minTokenAmt,
+		MinStandardAmt: minStandardAmt,
+		Deadline:       deadline,
+	}
+}
+
+// NewMsgCollectFees creates a new MsgCollectFees object
+func NewMsgCollectFees(
+	sender string,
+	positionId uint64,
+) *MsgCollectFees {
+	return &MsgCollectFees{
+		Sender:     sender,
+		PositionId: positionId,
+	}
+}
+
+// NewMsgCreateGauge creates a new MsgCreateGauge object
+func NewMsgCreateGauge(
+	sender string,
+	lptDenom string,
+	coins sdk.Coins,
+	numEpochs uint64,
+) *MsgCreateGauge {
+	return &MsgCreateGauge{
+		Sender:    sender,
+		LptDenom:  lptDenom,
+		Coins:     coins,
+		NumEpochs: numEpochs,
+	}
+}
+
+// NewMsgLockLiquidity creates a new MsgLockLiquidity object
+func NewMsgLockLiquidity(
+	sender string,
+	coin sdk.Coin,
+	duration time.Duration,
+) *MsgLockLiquidity {
+	return &MsgLockLiquidity{
+		Sender:   sender,
+		Coin:     coin,
+		Duration: duration,
+	}
+}
+
+// NewMsgUnlockLiquidity creates a new MsgUnlockLiquidity object
+func NewMsgUnlockLiquidity(
+	sender string,
+	lockId uint64,
+) *MsgUnlockLiquidity {
+	return &MsgUnlockLiquidity{
+		Sender: sender,
+		LockId: lockId,
+	}
+}
+
+// NewMsgClaimLockRewards creates a new MsgClaimLockRewards object
+func NewMsgClaimLockRewards(
+	sender string,
+	lockId uint64,
+) *MsgClaimLockRewards {
+	return &MsgClaimLockRewards{
+		Sender: sender,
+		LockId: lockId,
+	}
+}
+
 func CreateGetSignersFromMsgSwapOrderV2(options *signing.Options) func(msg protov2.Message) ([][]byte, error) {
 	return func(msg protov2.Message) ([][]byte, error) {
 		msgv2, ok := msg.(*coinswapv1.MsgSwapOrder)
@@ -8,6 +8,8 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	epochstypes "github.com/Canto-Network/Canto/v8/x/epochs/types"
 )
 
 const (
@@ -18,12 +20,18 @@ const (
 
 // Parameter store keys
 var (
-	KeyFee                    = []byte("Fee")                    // fee key
-	KeyPoolCreationFee        = []byte("PoolCreationFee")        // fee key
-	KeyTaxRate                = []byte("TaxRate")                // fee key
-	KeyStandardDenom          = []byte("StandardDenom")          // standard token denom key
-	KeyMaxStandardCoinPerPool = []byte("MaxStandardCoinPerPool") // max standard coin amount per pool
-	KeyMaxSwapAmount          = []byte("MaxSwapAmount")          // whitelisted denoms
+	KeyFee                           = []byte("Fee")                           // fee key
+	KeyPoolCreationFee               = []byte("PoolCreationFee")               // fee key
+	KeyTaxRate                       = []byte("TaxRate")                       // fee key
+	KeyStandardDenom                 = []byte("StandardDenom")                 // standard token denom key
+	KeyMaxStandardCoinPerPool        = []byte("MaxStandardCoinPerPool")        // max standard coin amount per pool
+	KeyMaxSwapAmount                 = []byte("MaxSwapAmount")                 // whitelisted denoms
+	KeySwapProtocolFeeShare          = []byte("SwapProtocolFeeShare")          // protocol share of the swap fee
+	KeyPoolStatsEpochIdentifier      = []byte("PoolStatsEpochIdentifier")      // epoch of the pool statistics
+	KeyPriceDeviationEpochIdentifier = []byte("PriceDeviationEpochIdentifier") // epoch of the circuit breaker reference prices
+	KeyLimitOrderFillBudget          = []byte("LimitOrderFillBudget")          // limit orders examined per block
+	KeyIncentivesEpochIdentifier     = []byte("IncentivesEpochIdentifier")     // epoch of the gauge distributions
+	KeyGaugeCreationFee              = []byte("GaugeCreationFee")              // fee key
 
 	DefaultFee                    = sdkmath.LegacyNewDecWithPrec(0, 0)
 	DefaultPoolCreationFee        = sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)
@@ -34,16 +42,28 @@ var (
 		sdk.NewCoin(UsdtIBCDenom, sdkmath.NewIntWithDecimal(10, 6)),
 		sdk.NewCoin(EthIBCDenom, sdkmath.NewIntWithDecimal(1, 16)),
 	)
+	DefaultSwapProtocolFeeShare          = sdkmath.LegacyZeroDec()
+	DefaultPoolStatsEpochIdentifier      = epochstypes.DayEpochID
+	DefaultPriceDeviationEpochIdentifier = epochstypes.DayEpochID
+	DefaultLimitOrderFillBudget          = uint32(100)
+	DefaultIncentivesEpochIdentifier     = epochstypes.DayEpochID
+	DefaultGaugeCreationFee              = sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)
 )
 
 // NewParams is the coinswap params constructor
-func NewParams(fee, taxRate sdkmath.LegacyDec, poolCreationFee sdk.Coin, maxStandardCoinPerPool sdkmath.Int, maxSwapAmount sdk.Coins) Params {
+func NewParams(fee, taxRate sdkmath.LegacyDec, poolCreationFee sdk.Coin, maxStandardCoinPerPool sdkmath.Int, maxSwapAmount sdk.Coins, swapProtocolFeeShare sdkmath.LegacyDec, poolStatsEpochIdentifier, priceDeviationEpochIdentifier string, limitOrderFillBudget uint32, incentivesEpochIdentifier string, gaugeCreationFee sdk.Coin) Params {
 	return Params{
-		Fee:                    fee,
-		TaxRate:                taxRate,
-		PoolCreationFee:        poolCreationFee,
-		MaxStandardCoinPerPool: maxStandardCoinPerPool,
-		MaxSwapAmount:          maxSwapAmount,
+		Fee:                           fee,
+		TaxRate:                       taxRate,
+		PoolCreationFee:               poolCreationFee,
+		MaxStandardCoinPerPool:        maxStandardCoinPerPool,
+		MaxSwapAmount:                 maxSwapAmount,
+		SwapProtocolFeeShare:          swapProtocolFeeShare,
+		PoolStatsEpochIdentifier:      poolStatsEpochIdentifier,
+		PriceDeviationEpochIdentifier: priceDeviationEpochIdentifier,
+		LimitOrderFillBudget:          limitOrderFillBudget,
+		IncentivesEpochIdentifier:     incentivesEpochIdentifier,
+		GaugeCreationFee:              gaugeCreationFee,
 	}
 }
 
@@ -60,17 +80,29 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyTaxRate, &p.TaxRate, validateTaxRate),
 		paramtypes.NewParamSetPair(KeyMaxStandardCoinPerPool, &p.MaxStandardCoinPerPool, validateMaxStandardCoinPerPool),
 		paramtypes.NewParamSetPair(KeyMaxSwapAmount, &p.MaxSwapAmount, validateMaxSwapAmount),
+		paramtypes.NewParamSetPair(KeySwapProtocolFeeShare, &p.SwapProtocolFeeShare, validateSwapProtocolFeeShare),
+		paramtypes.NewParamSetPair(KeyPoolStatsEpochIdentifier, &p.PoolStatsEpochIdentifier, validateEpochIdentifier),
+		paramtypes.NewParamSetPair(KeyPriceDeviationEpochIdentifier, &p.PriceDeviationEpochIdentifier, validateEpochIdentifier),
+		paramtypes.NewParamSetPair(KeyLimitOrderFillBudget, &p.LimitOrderFillBudget, validateLimitOrderFillBudget),
+		paramtypes.NewParamSetPair(KeyIncentivesEpochIdentifier, &p.IncentivesEpochIdentifier, validateEpochIdentifier),
+		paramtypes.NewParamSetPair(KeyGaugeCreationFee, &p.GaugeCreationFee, validateGaugeCreationFee),
 	}
 }
 
 // DefaultParams returns the default coinswap module parameters
 func DefaultParams() Params {
 	return Params{
-		Fee:                    DefaultFee,
-		PoolCreationFee:        DefaultPoolCreationFee,
-		TaxRate:                DefaultTaxRate,
-		MaxStandardCoinPerPool: DefaultMaxStandardCoinPerPool,
-		MaxSwapAmount:          DefaultMaxSwapAmount,
+		Fee:                           DefaultFee,
+		PoolCreationFee:               DefaultPoolCreationFee,
+		TaxRate:                       DefaultTaxRate,
+		MaxStandardCoinPerPool:        DefaultMaxStandardCoinPerPool,
+		MaxSwapAmount:                 DefaultMaxSwapAmount,
+		SwapProtocolFeeShare:          DefaultSwapProtocolFeeShare,
+		PoolStatsEpochIdentifier:      DefaultPoolStatsEpochIdentifier,
+		PriceDeviationEpochIdentifier: DefaultPriceDeviationEpochIdentifier,
+		LimitOrderFillBudget:          DefaultLimitOrderFillBudget,
+		IncentivesEpochIdentifier:     DefaultIncentivesEpochIdentifier,
+		GaugeCreationFee:              DefaultGaugeCreationFee,
 	}
 }
 
@@ -85,7 +117,13 @@ func (p Params) Validate() error {
 	if p.Fee.IsNegative() || !p.Fee.LT(sdkmath.LegacyOneDec()) {
 		return fmt.Errorf("fee must be positive and less than 1: %s", p.Fee.String())
 	}
-	return nil
+	if err := validateSwapProtocolFeeShare(p.SwapProtocolFeeShare); err != nil {
+		return err
+	}
+	if err := validateEpochIdentifier(p.PoolStatsEpochIdentifier); err != nil {
+		return err
+	}
+	return validateEpochIdentifier(p.PriceDeviationEpochIdentifier)
 }
 
 func validateFee(i interface{}) error {
@@ -113,6 +151,22 @@ func validatePoolCreationFee(i interface{}) error {
 	return nil
 }
 
+func validateGaugeCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an unset fee, as in the genesis files predating it, is not charged
+	if v.Amount.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("gaugeCreationFee must be positive: %s", v.String())
+	}
+	return nil
+}
+
 func validateTaxRate(i interface{}) error {
 	v, ok := i.(sdkmath.LegacyDec)
 	if !ok {
@@ -160,3 +214,37 @@ func validateMaxSwapAmount(i interface{}) error {
 
 	return nil
 }
+
+func validateSwapProtocolFeeShare(i interface{}) error {
+	v, ok := i.(sdkmath.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("swap protocol fee share must be between 0 and 1: %s", v.String())
+	}
+	return nil
+}
+
+func validateEpochIdentifier(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an empty identifier disables the feature bound to the epoch
+	if v == "" {
+		return nil
+	}
+	return epochstypes.ValidateEpochIdentifierString(v)
+}
+
+func validateLimitOrderFillBudget(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// a zero budget stops filling the limit orders
+	return nil
+}
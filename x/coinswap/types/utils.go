@@ -20,9 +20,15 @@ func GetTokenPairByDenom(inputDenom, outputDenom string) string {
 	return fmt.Sprintf("%s-%s", outputDenom, inputDenom)
 }
 
-// GetPoolId returns the pool coin denom by specified sequence.
-func GetPoolId(counterpartyDenom string) string {
-	return fmt.Sprintf("pool-%s", counterpartyDenom)
+// GetPoolId returns the id of the pool of two denoms. The denoms are sorted so
+// that the id does not depend on their order nor on the standard denom, and
+// joined with a character which is not allowed in denoms so that two pairs of
+// denoms never share an id.
+func GetPoolId(denom1, denom2 string) string {
+	if denom2 < denom1 {
+		denom1, denom2 = denom2, denom1
+	}
+	return fmt.Sprintf("pool-%s+%s", denom1, denom2)
 }
 
 // GetLptDenom returns the pool coin denom by specified sequence.
@@ -37,3 +43,13 @@ func ParseLptDenom(lptDenom string) (uint64, error) {
 	}
 	return strconv.ParseUint(result[1], 10, 64)
 }
+
+// SwapsEnabled returns true if the pool status allows swaps
+func (s PoolStatus) SwapsEnabled() bool {
+	return s == POOL_STATUS_ACTIVE
+}
+
+// DepositsEnabled returns true if the pool status allows adding liquidity
+func (s PoolStatus) DepositsEnabled() bool {
+	return s == POOL_STATUS_ACTIVE || s == POOL_STATUS_SWAPS_PAUSED
+}
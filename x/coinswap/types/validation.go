@@ -3,6 +3,7 @@ package types
 import (
 	fmt "fmt"
 	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -42,6 +43,70 @@ func ValidateOutput(output Output) error {
 	return nil
 }
 
+// ValidateSwapRoute verifies whether the given routed swap is legal, the recipient is optional
+func ValidateSwapRoute(sender, recipient string, tokenIn, tokenOut sdk.Coin) error {
+	if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+		}
+	}
+
+	if !(tokenIn.IsValid() && tokenIn.IsPositive()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid input (%s)", tokenIn.String())
+	}
+
+	if !tokenOut.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid output (%s)", tokenOut.String())
+	}
+
+	if strings.HasPrefix(tokenIn.Denom, LptTokenPrefix) || strings.HasPrefix(tokenOut.Denom, LptTokenPrefix) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid swap denom, should not begin with (%s)", LptTokenPrefix)
+	}
+
+	if tokenIn.Denom == tokenOut.Denom {
+		return errorsmod.Wrap(ErrEqualDenom, "invalid swap")
+	}
+	return nil
+}
+
+// ValidateSingleToken verifies whether the token deposited or withdrawn by a single-sided
+// liquidity msg is legal, the amount of a withdrawn token being a minimum that can be zero
+func ValidateSingleToken(token sdk.Coin, isDeposit bool) error {
+	if !token.IsValid() || (isDeposit && !token.IsPositive()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid token (%s)", token.String())
+	}
+
+	if strings.HasPrefix(token.Denom, LptTokenPrefix) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "token must be non-liquidity token")
+	}
+	return nil
+}
+
+// ValidateLimitOrderCoins verifies whether the coin sold and the minimum coin bought by
+// a limit order are legal
+func ValidateLimitOrderCoins(tokenIn, minTokenOut sdk.Coin) error {
+	if !(tokenIn.IsValid() && tokenIn.IsPositive()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid input (%s)", tokenIn.String())
+	}
+
+	if !(minTokenOut.IsValid() && minTokenOut.IsPositive()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid output (%s)", minTokenOut.String())
+	}
+
+	if strings.HasPrefix(tokenIn.Denom, LptTokenPrefix) || strings.HasPrefix(minTokenOut.Denom, LptTokenPrefix) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid order denom, should not begin with (%s)", LptTokenPrefix)
+	}
+
+	if tokenIn.Denom == minTokenOut.Denom {
+		return errorsmod.Wrap(ErrEqualDenom, "invalid limit order")
+	}
+	return nil
+}
+
 // ValidateDeadline verifies whether the given deadline is legal
 func ValidateDeadline(deadline int64) error {
 	if deadline <= 0 {
@@ -62,6 +127,24 @@ func ValidateMaxToken(maxToken sdk.Coin) error {
 	return nil
 }
 
+// ValidatePairDenom verifies whether the denom paired with the maximum token is legal,
+// an empty denom standing for the standard denom
+func ValidatePairDenom(pairDenom string, maxToken sdk.Coin) error {
+	if pairDenom == "" {
+		return nil
+	}
+	if err := sdk.ValidateDenom(pairDenom); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if strings.HasPrefix(pairDenom, LptTokenPrefix) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "pair denom must be non-liquidity token")
+	}
+	if pairDenom == maxToken.Denom {
+		return errorsmod.Wrap(ErrEqualDenom, fmt.Sprintf("pair denom must be different from the max token denom %s", maxToken.Denom))
+	}
+	return nil
+}
+
 // ValidateExactStandardAmt verifies whether the standard token amount is legal
 func ValidateExactStandardAmt(standardAmt sdkmath.Int) error {
 	if !standardAmt.IsPositive() {
@@ -86,6 +169,14 @@ func ValidateMinToken(minToken sdkmath.Int) error {
 	return nil
 }
 
+// ValidateMaxAmount verifies whether the maximum amount of a coin deposited into a position is legal
+func ValidateMaxAmount(maxAmt sdkmath.Int) error {
+	if maxAmt.IsNegative() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("maximum amount %s can not be negative", maxAmt.String()))
+	}
+	return nil
+}
+
 // ValidateWithdrawLiquidity verifies whether the given liquidity is legal
 func ValidateWithdrawLiquidity(liquidity sdk.Coin) error {
 	if !liquidity.IsValid() || !liquidity.IsPositive() {
@@ -113,3 +204,117 @@ func ValidateLptDenom(lptDenom string) error {
 	}
 	return nil
 }
+
+// ValidatePoolFee returns nil if the pool fee override is unset or between 0 and 1
+func ValidatePoolFee(fee *sdkmath.LegacyDec) error {
+	if fee == nil {
+		return nil
+	}
+	if err := validateFee(*fee); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// ValidatePriceDeviation returns nil if the max price deviation of a pool circuit
+// breaker is unset or positive
+func ValidatePriceDeviation(deviation *sdkmath.LegacyDec) error {
+	if deviation == nil {
+		return nil
+	}
+	if deviation.IsNil() || !deviation.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "max price deviation must be positive: %s", deviation)
+	}
+	return nil
+}
+
+// ValidatePoolType returns nil if the pool type is known and the amplification
+// is set only for, and within the bounds of, stableswap pools
+func ValidatePoolType(poolType PoolType, amplification uint64) error {
+	switch poolType {
+	case POOL_TYPE_CONSTANT_PRODUCT:
+		if amplification != 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "amplification %d must be zero for constant product pools", amplification)
+		}
+	case POOL_TYPE_STABLESWAP:
+		if amplification == 0 || amplification > MaxAmplification {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "amplification %d must be between 1 and %d for stableswap pools", amplification, MaxAmplification)
+		}
+	case POOL_TYPE_CONCENTRATED:
+		if amplification != 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "amplification %d must be zero for concentrated liquidity pools", amplification)
+		}
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid pool type %s", poolType)
+	}
+	return nil
+}
+
+// ValidateTickRange returns nil if the lower tick is below the upper tick and both
+// are within the tick bounds of the concentrated liquidity pools
+func ValidateTickRange(lowerTick, upperTick int64) error {
+	if lowerTick < MinTick || upperTick > MaxTick {
+		return errorsmod.Wrapf(ErrInvalidTick, "ticks [%d, %d] must be between %d and %d", lowerTick, upperTick, MinTick, MaxTick)
+	}
+	if lowerTick >= upperTick {
+		return errorsmod.Wrapf(ErrInvalidTick, "lower tick %d must be lower than upper tick %d", lowerTick, upperTick)
+	}
+	return nil
+}
+
+// ValidateInitialPrice returns nil if the initial price of a concentrated liquidity
+// pool is unset or positive
+func ValidateInitialPrice(price *sdkmath.LegacyDec) error {
+	if price == nil {
+		return nil
+	}
+	if price.IsNil() || !price.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "initial price must be positive: %s", price)
+	}
+	return nil
+}
+
+// ValidatePositionLiquidity verifies whether the liquidity removed from a position is legal
+func ValidatePositionLiquidity(liquidity sdkmath.Int) error {
+	if liquidity.IsNil() || !liquidity.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "liquidity must be positive")
+	}
+	return nil
+}
+
+// ValidateGaugeCoins returns nil if the reward coins of a gauge are valid and
+// distributed over at least one epoch
+func ValidateGaugeCoins(coins sdk.Coins, numEpochs uint64) error {
+	if !coins.IsValid() || coins.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid gauge coins (%s)", coins.String())
+	}
+	if numEpochs == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "gauge must be distributed over at least one epoch")
+	}
+	return nil
+}
+
+// ValidateLockCoin returns nil if the coin of a lock is a positive amount of a
+// liquidity pool coin
+func ValidateLockCoin(coin sdk.Coin) error {
+	if !(coin.IsValid() && coin.IsPositive()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid lock coin (%s)", coin.String())
+	}
+	return ValidateLptDenom(coin.Denom)
+}
+
+// ValidateLockDuration returns nil if the duration of a lock is positive
+func ValidateLockDuration(duration time.Duration) error {
+	if duration <= 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "lock duration must be positive: %s", duration)
+	}
+	return nil
+}
+
+// ValidatePoolStatus returns nil if the pool status is known
+func ValidatePoolStatus(status PoolStatus) error {
+	if _, ok := PoolStatus_name[int32(status)]; !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid pool status %s", status)
+	}
+	return nil
+}
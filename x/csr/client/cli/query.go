@@ -30,6 +30,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		CmdQueryCSRByNFT(),
 		CmdQueryCSRByContract(),
 		CmdQueryTurnstile(),
+		CmdQueryCSRRevenueHistory(),
+		CmdQueryTopCSRs(),
+		CmdQueryPendingFees(),
 	)
 
 	return cmd
@@ -193,3 +196,125 @@ func CmdQueryTurnstile() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// CmdQueryCSRRevenueHistory implements a cobra command that will return the revenue of a CSR NFT per day epoch
+func CmdQueryCSRRevenueHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "revenue [nftID]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the revenue of a given NFT ID in past day epochs",
+		Long:    "Query the revenue of a given NFT ID in past day epochs, along with its revenue in the ongoing day epoch",
+		Example: fmt.Sprintf("%s query csr revenue <nftID>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// arg must be converted to a uint
+			nftID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageRequest, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			request := &types.QueryCSRRevenueHistoryRequest{NftId: nftID, Pagination: pageRequest}
+			// Query store
+			response, err := queryClient.CSRRevenueHistory(context.Background(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "revenue")
+	return cmd
+}
+
+// CmdQueryTopCSRs implements a cobra command that will return the CSR NFTs that earned the most revenue in a day epoch
+func CmdQueryTopCSRs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "top [epoch] [limit]",
+		Args:    cobra.RangeArgs(1, 2),
+		Short:   "Query the NFT IDs that earned the most revenue in a given day epoch",
+		Long:    "Query the NFT IDs that earned the most revenue in a given day epoch, 10 by default and at most 100",
+		Example: fmt.Sprintf("%s query csr top <epoch> <limit>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epoch, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var limit uint64
+			if len(args) > 1 {
+				limit, err = strconv.ParseUint(args[1], 10, 32)
+				if err != nil {
+					return err
+				}
+			}
+
+			request := &types.QueryTopCSRsRequest{Epoch: epoch, Limit: uint32(limit)}
+			// Query store
+			response, err := queryClient.TopCSRs(context.Background(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryPendingFees implements a command that will return the csr fees not yet distributed
+// to the Turnstile
+func CmdQueryPendingFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending",
+		Short: "Query the CSR fees not yet distributed to the Turnstile",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageRequest, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			request := &types.QueryPendingFeesRequest{
+				Pagination: pageRequest,
+			}
+
+			// Query store
+			response, err := queryClient.PendingFees(context.Background(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending fees")
+
+	return cmd
+}
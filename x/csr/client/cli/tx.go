@@ -2,22 +2,197 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
-	"github.com/Canto-Network/Canto/v8/x/csr/types"
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/Canto-Network/Canto/v8/x/csr/types"
 )
 
-// GetTxCmd returns the transaction methods allowed for the CLI. However, currently all transaction or state transition
-// functionality is triggered through the Turnstile Smart Contract.
+// GetTxCmd returns the transaction methods allowed for the CLI. Most registrations are triggered through
+// the Turnstile Smart Contract, while deployers can also register contracts and withdraw revenue natively.
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        types.ModuleName,
-		Short:                      fmt.Sprintf("%s has no transaction commands (everything transaction is triggered via the Turnstile Smart Contract)", types.ModuleName),
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
 		DisableFlagParsing:         true,
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
+
+	cmd.AddCommand(
+		CmdWithdrawCSRRevenue(),
+		CmdRegisterContract(),
+		CmdRemoveContract(),
+		CmdReassignContract(),
+		CmdMergeNFTs(),
+	)
+
+	return cmd
+}
+
+// CmdWithdrawCSRRevenue implements a command that withdraws the revenue accrued by a CSR NFT owned by the sender
+func CmdWithdrawCSRRevenue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdraw [nftID] [recipient] [amount]",
+		Args:    cobra.RangeArgs(2, 3),
+		Short:   "Withdraw the revenue accrued by a CSR NFT owned by the sender to a recipient",
+		Long:    "Withdraw the revenue accrued by a CSR NFT owned by the sender to a recipient, the whole revenue if no amount is given",
+		Example: fmt.Sprintf("%s tx csr withdraw <nftID> <recipient> <amount> --from mykey", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// arg must be converted to a uint
+			nftID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount := sdkmath.ZeroInt()
+			if len(args) > 2 {
+				var ok bool
+				amount, ok = sdkmath.NewIntFromString(args[2])
+				if !ok {
+					return fmt.Errorf("invalid amount: %s", args[2])
+				}
+			}
+
+			msg := types.NewMsgWithdrawCSRRevenue(clientCtx.GetFromAddress().String(), nftID, args[1], amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdRegisterContract implements a command that registers a contract deployed by the sender to a new CSR NFT
+func CmdRegisterContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register [contract] [nonce] [recipient]",
+		Args:    cobra.ExactArgs(3),
+		Short:   "Register a contract deployed by the sender to a new CSR NFT minted to a recipient",
+		Long:    "Register a contract deployed by the sender to a new CSR NFT minted to a recipient, where nonce is the nonce of the sender when it deployed the contract",
+		Example: fmt.Sprintf("%s tx csr register <contract> <nonce> <recipient> --from mykey", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterContract(clientCtx.GetFromAddress().String(), args[0], nonce, args[2])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdRemoveContract implements a command that detaches a contract from a CSR NFT owned by the sender
+func CmdRemoveContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove [contract]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Detach a contract from a CSR NFT owned by the sender",
+		Long:    "Detach a contract from a CSR NFT owned by the sender, the Turnstile keeps the contract registered so that it cannot register again",
+		Example: fmt.Sprintf("%s tx csr remove <contract> --from mykey", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveContract(clientCtx.GetFromAddress().String(), args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdReassignContract implements a command that moves a contract from a CSR NFT owned by the sender to another CSR NFT
+func CmdReassignContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reassign [contract] [nftID]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Move a contract from a CSR NFT owned by the sender to another CSR NFT",
+		Example: fmt.Sprintf("%s tx csr reassign <contract> <nftID> --from mykey", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// arg must be converted to a uint
+			nftID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReassignContract(clientCtx.GetFromAddress().String(), args[0], nftID)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdMergeNFTs implements a command that moves every contract of a CSR NFT to another CSR NFT, both owned by the sender
+func CmdMergeNFTs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "merge [sourceNftID] [targetNftID]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Move every contract of a CSR NFT to another CSR NFT, both owned by the sender",
+		Long:    "Move every contract of a CSR NFT to another CSR NFT, both owned by the sender, the revenue accrued by the source NFT remains withdrawable",
+		Example: fmt.Sprintf("%s tx csr merge <sourceNftID> <targetNftID> --from mykey", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// args must be converted to a uint
+			sourceNftID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			targetNftID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMergeNFTs(clientCtx.GetFromAddress().String(), sourceNftID, targetNftID)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
 	return cmd
 }
@@ -21,6 +21,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, accountKeeper authkeeper.Acco
 		turnstileAddress := common.HexToAddress(genState.TurnstileAddress)
 		k.SetTurnstile(ctx, turnstileAddress)
 	}
+	for _, revenue := range genState.RevenueHistory {
+		k.SetCSRRevenue(ctx, revenue)
+	}
+	for _, revenue := range genState.CurrentRevenues {
+		k.SetCurrentCSRRevenue(ctx, revenue)
+	}
+	for _, override := range genState.ShareOverrides {
+		k.SetCSRShareOverride(ctx, override)
+	}
+	for _, pendingFee := range genState.PendingFees {
+		k.SetPendingFee(ctx, pendingFee)
+	}
 	// make sure that the csr module account is set on genesis
 	if acc := accountKeeper.GetModuleAccount(ctx, types.ModuleName); acc == nil {
 		// NOTE: shouldn't occur
@@ -40,6 +52,19 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.Csrs = csrs
 	}
 
+	if revenueHistory := k.GetAllCSRRevenueHistory(ctx); len(revenueHistory) != 0 {
+		genesis.RevenueHistory = revenueHistory
+	}
+	if currentRevenues := k.GetAllCurrentCSRRevenues(ctx); len(currentRevenues) != 0 {
+		genesis.CurrentRevenues = currentRevenues
+	}
+	if shareOverrides := k.GetAllCSRShareOverrides(ctx); len(shareOverrides) != 0 {
+		genesis.ShareOverrides = shareOverrides
+	}
+	if pendingFees := k.GetAllPendingFees(ctx); len(pendingFees) != 0 {
+		genesis.PendingFees = pendingFees
+	}
+
 	turnstileAddr, ok := k.GetTurnstile(ctx)
 	if ok {
 		genesis.TurnstileAddress = turnstileAddr.String()
@@ -88,6 +88,34 @@ func (k Keeper) SetCSR(ctx sdk.Context, csr types.CSR) {
 	}
 }
 
+// Deletes a CSR object from the store along with the mapping of each of its smart contracts
+// to the NFT ID and its share override.
+func (k Keeper) DeleteCSR(ctx sdk.Context, nftId uint64) {
+	csr, found := k.GetCSR(ctx, nftId)
+	if !found {
+		return
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	storeContracts := prefix.NewStore(store, types.KeyPrefixContract)
+	for _, contract := range csr.Contracts {
+		storeContracts.Delete([]byte(contract))
+	}
+
+	storeCSR := prefix.NewStore(store, types.KeyPrefixCSR)
+	storeCSR.Delete(UInt64ToBytes(nftId))
+
+	k.DeleteCSRShareOverride(ctx, nftId)
+}
+
+// Deletes the mapping of a smart contract address to its NFT ID. The caller must also remove the
+// smart contract from the CSR of the NFT.
+func (k Keeper) deleteNFTByContract(ctx sdk.Context, address string) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixContract)
+	prefixStore.Delete([]byte(address))
+}
+
 // Retrieves the deployed Turnstile Address from state if found.
 func (k Keeper) GetTurnstile(ctx sdk.Context) (common.Address, bool) {
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
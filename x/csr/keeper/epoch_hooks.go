@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/Canto-Network/Canto/v8/x/epochs/types"
+)
+
+// BeforeEpochStart: noop, We don't need to do anything here
+func (k Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {
+}
+
+// AfterEpochEnd rolls up the revenue of the CSR NFTs into their revenue history
+// at the end of every day epoch, and prunes the epochs older than the retention
+// of the revenue history
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	if epochIdentifier != epochstypes.DayEpochID {
+		return
+	}
+	k.RollUpCSRRevenues(ctx, epochNumber)
+
+	retention := k.GetParams(ctx).RevenueHistoryEpochs
+	if retention > 0 && uint64(epochNumber) > retention {
+		k.PruneCSRRevenueHistory(ctx, epochNumber-int64(retention))
+	}
+}
+
+// ___________________________________________________________________________________________________
+
+// EpochHooks wrapper struct for csr keeper, kept apart from the EVM Hooks
+type EpochHooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// Return the wrapper struct
+func (k Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k}
+}
+
+// epochs hooks
+func (h EpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	h.k.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+}
+
+func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+}
@@ -18,4 +18,6 @@ var (
 	ErrNonexistentCSR              = errorsmod.Register(types.ModuleName, 2009, "The CSR that was queried does not currently exist")
 	ErrNFTNotFound                 = errorsmod.Register(types.ModuleName, 2010, "The NFT that was queried does not currently exist")
 	ErrDuplicateNFTID              = errorsmod.Register(types.ModuleName, 2011, "There cannot be duplicate NFT IDs passed into a register event")
+	ErrNothingToWithdraw           = errorsmod.Register(types.ModuleName, 2012, "The NFT has not accrued any revenue to withdraw")
+	ErrLastContract                = errorsmod.Register(types.ModuleName, 2013, "A CSR must keep at least one smart contract, merge the NFT instead")
 )
@@ -67,10 +67,14 @@ func (k Keeper) UpdateEvent(ctx sdk.Context, data []byte) error {
 		return err
 	}
 
-	// Check if the NFT that is being updated exists in the CSR store
+	// Check if the NFT that is being updated exists in the CSR store. The Turnstile lets contracts
+	// be assigned to NFTs merged away, which are unregistered again so that they are not orphaned.
 	nftID := event.TokenId.Uint64()
 	csr, found := k.GetCSR(ctx, nftID)
 	if !found {
+		if err := k.deleteTurnstileFeeRecipient(ctx, event.SmartContract); err != nil {
+			return err
+		}
 		return errorsmod.Wrapf(ErrNFTNotFound, "EventHandler::UpdateEvent the nft entered does not currently exist: %d", nftID)
 	}
 	// Add the new smart contract to the CSR NFT and validate
@@ -84,6 +88,103 @@ func (k Keeper) UpdateEvent(ctx sdk.Context, data []byte) error {
 	return nil
 }
 
+// RemoveContract detaches a smart contract from its CSR NFT, so that it no longer earns fees for the
+// NFT. The smart contract is also unregistered in the Turnstile, so that it can register or assign
+// itself again. An NFT must keep at least one smart contract. Returns an error if the smart contract
+// is not registered to any NFT.
+func (k Keeper) RemoveContract(ctx sdk.Context, contract common.Address) error {
+	nftID, found := k.GetNFTByContract(ctx, contract.String())
+	if !found {
+		return errorsmod.Wrapf(ErrNonexistentCSR, "EventHandler::RemoveContract the smart contract is not registered to any NFT: %s", contract)
+	}
+	csr, found := k.GetCSR(ctx, nftID)
+	if !found {
+		return errorsmod.Wrapf(ErrNonexistentCSR, "EventHandler::RemoveContract the NFT ID was found but the CSR was not: %d", nftID)
+	}
+
+	contracts := make([]string, 0, len(csr.Contracts))
+	for _, registered := range csr.Contracts {
+		if registered != contract.String() {
+			contracts = append(contracts, registered)
+		}
+	}
+	if len(contracts) == 0 {
+		return errorsmod.Wrapf(ErrLastContract, "EventHandler::RemoveContract the smart contract is the only one of NFT %d", nftID)
+	}
+
+	csr.Contracts = contracts
+	k.deleteNFTByContract(ctx, contract.String())
+	k.SetCSR(ctx, *csr)
+
+	return k.deleteTurnstileFeeRecipient(ctx, contract)
+}
+
+// ReassignContract moves a smart contract from its CSR NFT to another existing CSR NFT, which earns
+// the fees of the smart contract from then on. The NFT the smart contract is moved from must keep at
+// least one smart contract. Returns an error if the smart contract is not registered to any NFT or is
+// already registered to the NFT entered.
+func (k Keeper) ReassignContract(ctx sdk.Context, contract common.Address, nftID uint64) error {
+	currentID, found := k.GetNFTByContract(ctx, contract.String())
+	if !found {
+		return errorsmod.Wrapf(ErrNonexistentCSR, "EventHandler::ReassignContract the smart contract is not registered to any NFT: %s", contract)
+	}
+	if currentID == nftID {
+		return errorsmod.Wrapf(ErrPrevRegisteredSmartContract, "EventHandler::ReassignContract the smart contract is already registered to NFT %d", nftID)
+	}
+	csr, found := k.GetCSR(ctx, nftID)
+	if !found {
+		return errorsmod.Wrapf(ErrNFTNotFound, "EventHandler::ReassignContract the nft entered does not currently exist: %d", nftID)
+	}
+
+	if err := k.RemoveContract(ctx, contract); err != nil {
+		return err
+	}
+
+	csr.Contracts = append(csr.Contracts, contract.String())
+	if err := csr.Validate(); err != nil {
+		return err
+	}
+	k.SetCSR(ctx, *csr)
+
+	return k.setTurnstileFeeRecipient(ctx, contract, nftID)
+}
+
+// MergeCSRs moves every smart contract of a CSR NFT to another existing CSR NFT and deletes the CSR
+// of the NFT merged away, whose lifetime transactions and revenue are added to the remaining CSR.
+// The smart contracts are registered to the remaining NFT in the Turnstile too. The fees already
+// distributed to the NFT merged away stay withdrawable from the Turnstile.
+func (k Keeper) MergeCSRs(ctx sdk.Context, sourceID, targetID uint64) error {
+	if sourceID == targetID {
+		return errorsmod.Wrapf(ErrDuplicateNFTID, "EventHandler::MergeCSRs cannot merge NFT %d into itself", sourceID)
+	}
+	source, found := k.GetCSR(ctx, sourceID)
+	if !found {
+		return errorsmod.Wrapf(ErrNFTNotFound, "EventHandler::MergeCSRs the nft entered does not currently exist: %d", sourceID)
+	}
+	target, found := k.GetCSR(ctx, targetID)
+	if !found {
+		return errorsmod.Wrapf(ErrNFTNotFound, "EventHandler::MergeCSRs the nft entered does not currently exist: %d", targetID)
+	}
+
+	target.Contracts = append(target.Contracts, source.Contracts...)
+	target.Txs += source.Txs
+	target.Revenue = target.Revenue.Add(source.Revenue)
+	if err := target.Validate(); err != nil {
+		return err
+	}
+
+	k.DeleteCSR(ctx, sourceID)
+	k.SetCSR(ctx, *target)
+
+	for _, contract := range source.Contracts {
+		if err := k.setTurnstileFeeRecipient(ctx, common.HexToAddress(contract), targetID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ValidateContract checks if the smart contract can be registered to a CSR. It checks
 // if the address is a smart contract address, whether the smart contract has code, and
 // whether the contract is already assigned to some other NFT.
@@ -3,7 +3,9 @@ package keeper_test
 import (
 	"strings"
 
-	_ "github.com/Canto-Network/Canto/v8/x/csr/keeper"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/Canto-Network/Canto/v8/x/csr/keeper"
 	"github.com/Canto-Network/Canto/v8/x/csr/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/ethermint/tests"
@@ -216,3 +218,79 @@ func (suite *KeeperTestSuite) TestUpdateEvent() {
 	}
 
 }
+
+// Removes, reassigns and merges contracts of CSRs, and checks that the contract to NFT index stays
+// consistent so that contracts cannot be registered twice
+func (suite *KeeperTestSuite) TestRemoveReassignMerge() {
+	suite.Commit()
+
+	// register contracts with code, so that only the double registration can fail the events
+	contracts := make([]common.Address, 4)
+	for i := range contracts {
+		contract, err := suite.app.CSRKeeper.DeployTurnstile(suite.ctx)
+		suite.Require().NoError(err)
+		contracts[i] = contract
+	}
+	csrs := []types.CSR{
+		{Id: 1, Contracts: []string{contracts[0].String(), contracts[1].String()}, Txs: 1, Revenue: sdkmath.NewInt(100)},
+		{Id: 2, Contracts: []string{contracts[2].String()}, Txs: 2, Revenue: sdkmath.NewInt(200)},
+		{Id: 3, Contracts: []string{contracts[3].String()}, Txs: 3, Revenue: sdkmath.NewInt(300)},
+	}
+	for _, csr := range csrs {
+		suite.app.CSRKeeper.SetCSR(suite.ctx, csr)
+	}
+
+	checkCSR := func(id uint64, expContracts ...common.Address) {
+		csr, found := suite.app.CSRKeeper.GetCSR(suite.ctx, id)
+		suite.Require().True(found)
+		suite.Require().Len(csr.Contracts, len(expContracts))
+		for i, contract := range expContracts {
+			suite.Require().Equal(contract.String(), csr.Contracts[i])
+			nftID, found := suite.app.CSRKeeper.GetNFTByContract(suite.ctx, contract.String())
+			suite.Require().True(found)
+			suite.Require().Equal(id, nftID)
+		}
+	}
+
+	// invalid removals and reassignments leave the CSRs untouched
+	suite.Require().ErrorIs(suite.app.CSRKeeper.RemoveContract(suite.ctx, tests.GenerateAddress()), keeper.ErrNonexistentCSR)
+	suite.Require().ErrorIs(suite.app.CSRKeeper.RemoveContract(suite.ctx, contracts[2]), keeper.ErrLastContract)
+	suite.Require().ErrorIs(suite.app.CSRKeeper.ReassignContract(suite.ctx, contracts[0], 1), keeper.ErrPrevRegisteredSmartContract)
+	suite.Require().ErrorIs(suite.app.CSRKeeper.ReassignContract(suite.ctx, contracts[0], 9), keeper.ErrNFTNotFound)
+	suite.Require().ErrorIs(suite.app.CSRKeeper.ReassignContract(suite.ctx, contracts[2], 1), keeper.ErrLastContract)
+	checkCSR(1, contracts[0], contracts[1])
+	checkCSR(2, contracts[2])
+
+	// a reassigned contract is moved in the index and cannot be registered or assigned again
+	suite.Require().NoError(suite.app.CSRKeeper.ReassignContract(suite.ctx, contracts[0], 2))
+	checkCSR(1, contracts[1])
+	checkCSR(2, contracts[2], contracts[0])
+	data, err := GenerateUpdateEventData(contracts[0], 3)
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(suite.app.CSRKeeper.UpdateEvent(suite.ctx, data), keeper.ErrPrevRegisteredSmartContract)
+	data, err = GenerateRegisterEventData(contracts[0], tests.GenerateAddress(), 4)
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(suite.app.CSRKeeper.RegisterEvent(suite.ctx, data), keeper.ErrPrevRegisteredSmartContract)
+	checkCSR(3, contracts[3])
+
+	// merging moves every contract and the lifetime metrics, and deletes the merged CSR
+	suite.Require().ErrorIs(suite.app.CSRKeeper.MergeCSRs(suite.ctx, 2, 2), keeper.ErrDuplicateNFTID)
+	suite.Require().ErrorIs(suite.app.CSRKeeper.MergeCSRs(suite.ctx, 9, 1), keeper.ErrNFTNotFound)
+	suite.Require().NoError(suite.app.CSRKeeper.MergeCSRs(suite.ctx, 2, 1))
+	checkCSR(1, contracts[1], contracts[2], contracts[0])
+	_, found := suite.app.CSRKeeper.GetCSR(suite.ctx, 2)
+	suite.Require().False(found)
+	csr, _ := suite.app.CSRKeeper.GetCSR(suite.ctx, 1)
+	suite.Require().Equal(uint64(3), csr.Txs)
+	suite.Require().Equal(sdkmath.NewInt(300), csr.Revenue)
+	data, err = GenerateUpdateEventData(contracts[2], 2)
+	suite.Require().NoError(err)
+	suite.Require().Error(suite.app.CSRKeeper.UpdateEvent(suite.ctx, data))
+
+	// a removed contract leaves the index
+	suite.Require().NoError(suite.app.CSRKeeper.RemoveContract(suite.ctx, contracts[2]))
+	checkCSR(1, contracts[1], contracts[0])
+	_, found = suite.app.CSRKeeper.GetNFTByContract(suite.ctx, contracts[2].String())
+	suite.Require().False(found)
+	suite.Require().Len(suite.app.CSRKeeper.GetAllCSRs(suite.ctx), 2)
+}
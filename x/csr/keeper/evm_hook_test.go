@@ -7,9 +7,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/evmos/ethermint/tests"
 
 	"github.com/Canto-Network/Canto/v8/contracts"
+	"github.com/Canto-Network/Canto/v8/x/csr/keeper"
 	csrTypes "github.com/Canto-Network/Canto/v8/x/csr/types"
 
 	"github.com/Canto-Network/Canto/v8/x/erc20/types"
@@ -552,3 +554,344 @@ func (suite *KeeperTestSuite) TestCSRHook() {
 	}
 
 }
+
+// With the multi contract attribution, the CSR fee is split between every registered contract
+// touched by the tx, which are the called contract and the contracts emitting events, in
+// proportion to the gas of their events and the rest of the gas for the called contract
+func (suite *KeeperTestSuite) TestCSRHookMultiContractAttribution() {
+	suite.SetupTest()
+	suite.Commit()
+
+	// Send some initial funds to the fee module account
+	evmDenom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+	coins := sdk.Coins{{Denom: evmDenom, Amount: sdkmath.NewIntFromUint64(1000000000)}}
+	suite.app.BankKeeper.MintCoins(suite.ctx, csrTypes.ModuleName, coins)
+	suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, csrTypes.ModuleName, suite.app.CSRKeeper.FeeCollectorName, coins)
+
+	csrs := GenerateCSRs(2)
+	for _, csr := range csrs {
+		suite.app.CSRKeeper.SetCSR(suite.ctx, csr)
+	}
+
+	turnstileAddress, found := suite.app.CSRKeeper.GetTurnstile(suite.ctx)
+	suite.Require().True(found)
+	turnstile := sdk.AccAddress(turnstileAddress.Bytes())
+
+	// a router registered to the first NFT calling a contract of the same NFT, a token of
+	// the second NFT emitting a transfer event and an unregistered contract. The events
+	// without topics nor data cost 375 gas and the transfer event 375 + 3 * 375 + 32 * 8 = 1756
+	// gas, which leaves 10000 - 2506 = 7494 gas to the router.
+	to := common.HexToAddress(csrs[0].Contracts[0])
+	receipt := &ethtypes.Receipt{
+		Logs: []*ethtypes.Log{
+			{Address: common.HexToAddress(csrs[0].Contracts[1])},
+			{Address: common.HexToAddress(csrs[1].Contracts[0]), Topics: make([]common.Hash, 3), Data: make([]byte, 32)},
+			{Address: tests.GenerateAddress()},
+		},
+		GasUsed: 10000,
+	}
+	msg := ethtypes.NewMessage(
+		types.ModuleAddress,
+		&to,
+		0,
+		big.NewInt(0), // amount
+		uint64(0),     // gasLimit
+		big.NewInt(1), // gasPrice
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		[]byte{},
+		ethtypes.AccessList{}, // AccessList
+		true,                  // checkNonce
+	)
+	shareOverride := sdkmath.LegacyNewDecWithPrec(50, 2)
+
+	testCases := []struct {
+		name                     string
+		multiContractAttribution bool
+		shareOverride            *sdkmath.LegacyDec
+		expRevenues              []int64
+		expTxs                   []uint64
+	}{
+		// the csr fee of 10000 * 0.2 = 2000 goes to the called contract only
+		{"called contract only", false, nil, []int64{2000, 0}, []uint64{1, 0}},
+		// the registered contracts used 7494 + 375 = 7869 gas for the first NFT and 1756 gas
+		// for the second one, which gets intFloor(2000 * 1756 / 9625) = 364
+		{"multi contract attribution", true, nil, []int64{3636, 364}, []uint64{2, 1}},
+		// the second NFT gets intFloor(5000 * 1756 / 9625) = 912 of its own csr fee of 10000 * 0.5
+		{"share override of the second nft", true, &shareOverride, []int64{5272, 1276}, []uint64{3, 2}},
+	}
+	for _, tc := range testCases {
+		params := suite.app.CSRKeeper.GetParams(suite.ctx)
+		params.MultiContractAttribution = tc.multiContractAttribution
+		suite.app.CSRKeeper.SetParams(suite.ctx, params)
+		if tc.shareOverride != nil {
+			suite.app.CSRKeeper.SetCSRShareOverride(suite.ctx, csrTypes.CSRShareOverride{NftId: csrs[1].Id, CsrShares: *tc.shareOverride})
+		}
+
+		err := suite.app.CSRKeeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
+		suite.Require().NoError(err, tc.name)
+
+		totalRevenue := sdkmath.ZeroInt()
+		for i, csr := range csrs {
+			csr, found := suite.app.CSRKeeper.GetCSR(suite.ctx, csr.Id)
+			suite.Require().True(found)
+			suite.Require().Equal(sdkmath.NewInt(tc.expRevenues[i]), csr.Revenue, tc.name)
+			suite.Require().Equal(tc.expTxs[i], csr.Txs, tc.name)
+			totalRevenue = totalRevenue.Add(csr.Revenue)
+		}
+		suite.Require().Equal(totalRevenue, suite.app.BankKeeper.GetBalance(suite.ctx, turnstile, evmDenom).Amount, tc.name)
+	}
+}
+
+// The share of the fees distributed to an NFT decreases as its revenue in the ongoing day epoch
+// reaches the share tiers, unless governance set a share override, and is limited by the revenue cap
+func (suite *KeeperTestSuite) TestCSRHookShareTiers() {
+	suite.SetupTest()
+	suite.Commit()
+
+	// Send some initial funds to the fee module account
+	evmDenom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+	coins := sdk.Coins{{Denom: evmDenom, Amount: sdkmath.NewIntFromUint64(1000000000)}}
+	suite.app.BankKeeper.MintCoins(suite.ctx, csrTypes.ModuleName, coins)
+	suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, csrTypes.ModuleName, suite.app.CSRKeeper.FeeCollectorName, coins)
+
+	csr := GenerateCSRs(1)[0]
+	suite.app.CSRKeeper.SetCSR(suite.ctx, csr)
+
+	turnstileAddress, found := suite.app.CSRKeeper.GetTurnstile(suite.ctx)
+	suite.Require().True(found)
+	turnstile := sdk.AccAddress(turnstileAddress.Bytes())
+
+	// every tx pays a fee of 100 * 100 = 10000
+	to := common.HexToAddress(csr.Contracts[0])
+	receipt := &ethtypes.Receipt{GasUsed: 100}
+	msg := ethtypes.NewMessage(
+		types.ModuleAddress,
+		&to,
+		0,
+		big.NewInt(0),   // amount
+		uint64(0),       // gasLimit
+		big.NewInt(100), // gasPrice
+		big.NewInt(0),   // gasFeeCap
+		big.NewInt(0),   // gasTipCap
+		[]byte{},
+		ethtypes.AccessList{}, // AccessList
+		true,                  // checkNonce
+	)
+
+	// the share drops from 20% to 10% once the NFT earned 2000, and to 5% once it earned 3500
+	params := suite.app.CSRKeeper.GetParams(suite.ctx)
+	params.CsrShareTiers = []csrTypes.CSRShareTier{
+		{RevenueThreshold: sdkmath.NewInt(2000), CsrShares: sdkmath.LegacyNewDecWithPrec(10, 2)},
+		{RevenueThreshold: sdkmath.NewInt(3500), CsrShares: sdkmath.LegacyNewDecWithPrec(5, 2)},
+	}
+	suite.app.CSRKeeper.SetParams(suite.ctx, params)
+
+	testCases := []struct {
+		name          string
+		setUp         func()
+		expFee        int64
+		expEpochTotal int64
+	}{
+		{"no tier reached", func() {}, 2000, 2000},
+		{"first tier reached", func() {}, 1000, 3000},
+		{"first tier still applies", func() {}, 1000, 4000},
+		{"second tier reached", func() {}, 500, 4500},
+		{
+			"fee limited by the revenue cap",
+			func() {
+				params := suite.app.CSRKeeper.GetParams(suite.ctx)
+				params.CsrRevenueCap = sdkmath.NewInt(4800)
+				suite.app.CSRKeeper.SetParams(suite.ctx, params)
+			},
+			300, 4800,
+		},
+		{"revenue cap reached", func() {}, 0, 4800},
+		{
+			"share override under the revenue cap",
+			func() {
+				suite.app.CSRKeeper.SetCSRShareOverride(suite.ctx, csrTypes.CSRShareOverride{NftId: csr.Id, CsrShares: sdkmath.LegacyNewDecWithPrec(50, 2)})
+			},
+			0, 4800,
+		},
+		{
+			"share override in a new epoch",
+			func() {
+				suite.app.CSRKeeper.RollUpCSRRevenues(suite.ctx, 1)
+			},
+			4800, 4800,
+		},
+		{
+			"share override without revenue cap",
+			func() {
+				params := suite.app.CSRKeeper.GetParams(suite.ctx)
+				params.CsrRevenueCap = sdkmath.ZeroInt()
+				suite.app.CSRKeeper.SetParams(suite.ctx, params)
+			},
+			5000, 9800,
+		},
+		{
+			"share override cleared",
+			func() {
+				suite.app.CSRKeeper.DeleteCSRShareOverride(suite.ctx, csr.Id)
+			},
+			500, 10300,
+		},
+	}
+	revenue := sdkmath.ZeroInt()
+	for i, tc := range testCases {
+		tc.setUp()
+
+		err := suite.app.CSRKeeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
+		suite.Require().NoError(err, tc.name)
+		revenue = revenue.Add(sdkmath.NewInt(tc.expFee))
+
+		// every tx is counted, even when no fee is distributed
+		updated, found := suite.app.CSRKeeper.GetCSR(suite.ctx, csr.Id)
+		suite.Require().True(found)
+		suite.Require().Equal(revenue, updated.Revenue, tc.name)
+		suite.Require().Equal(uint64(i+1), updated.Txs, tc.name)
+		suite.Require().Equal(sdkmath.NewInt(tc.expEpochTotal), suite.app.CSRKeeper.GetCurrentCSRRevenue(suite.ctx, csr.Id).Revenue, tc.name)
+		suite.Require().Equal(revenue, suite.app.BankKeeper.GetBalance(suite.ctx, turnstile, evmDenom).Amount, tc.name)
+	}
+}
+
+// With the robust fee distribution, the csr fees the Turnstile fails to take in are kept as
+// pending fees instead of reverting the tx, and are distributed by the end blocker once the
+// Turnstile takes them in
+func (suite *KeeperTestSuite) TestCSRHookRobustFeeDistribution() {
+	suite.SetupTest()
+	suite.Commit()
+
+	// Send some initial funds to the fee module account
+	evmDenom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+	coins := sdk.Coins{{Denom: evmDenom, Amount: sdkmath.NewIntFromUint64(1000000000)}}
+	suite.app.BankKeeper.MintCoins(suite.ctx, csrTypes.ModuleName, coins)
+	suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, csrTypes.ModuleName, suite.app.CSRKeeper.FeeCollectorName, coins)
+
+	csr := GenerateCSRs(1)[0]
+	suite.app.CSRKeeper.SetCSR(suite.ctx, csr)
+
+	turnstileAddress, found := suite.app.CSRKeeper.GetTurnstile(suite.ctx)
+	suite.Require().True(found)
+	turnstile := sdk.AccAddress(turnstileAddress.Bytes())
+	moduleAccount := suite.app.AccountKeeper.GetModuleAddress(csrTypes.ModuleName)
+
+	// a Turnstile owned by another account rejects the fees distributed by the module account
+	deployer := suite.CreateNewAccount(suite.ctx)
+	nonce, err := suite.app.AccountKeeper.GetSequence(suite.ctx, deployer)
+	suite.Require().NoError(err)
+	_, err = suite.app.CSRKeeper.CallEVM(suite.ctx, common.BytesToAddress(deployer), nil, big.NewInt(0), contracts.TurnstileContract.Bin, true)
+	suite.Require().NoError(err)
+	suite.app.CSRKeeper.SetTurnstile(suite.ctx, crypto.CreateAddress(common.BytesToAddress(deployer), nonce))
+
+	// every tx pays a csr fee of 100 * 100 * 0.2 = 2000
+	to := common.HexToAddress(csr.Contracts[0])
+	receipt := &ethtypes.Receipt{GasUsed: 100}
+	msg := ethtypes.NewMessage(
+		types.ModuleAddress,
+		&to,
+		0,
+		big.NewInt(0),   // amount
+		uint64(0),       // gasLimit
+		big.NewInt(100), // gasPrice
+		big.NewInt(0),   // gasFeeCap
+		big.NewInt(0),   // gasTipCap
+		[]byte{},
+		ethtypes.AccessList{}, // AccessList
+		true,                  // checkNonce
+	)
+
+	// the failed distribution reverts the tx by default
+	cacheCtx, _ := suite.ctx.CacheContext()
+	err = suite.app.CSRKeeper.Hooks().PostTxProcessing(cacheCtx, msg, receipt)
+	suite.Require().ErrorIs(err, keeper.ErrFeeDistribution)
+
+	params := suite.app.CSRKeeper.GetParams(suite.ctx)
+	params.RobustFeeDistribution = true
+	suite.app.CSRKeeper.SetParams(suite.ctx, params)
+
+	for i := 0; i < 2; i++ {
+		err = suite.app.CSRKeeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
+		suite.Require().NoError(err)
+	}
+
+	// the fees are attributed to the NFT and held by the module account
+	updated, found := suite.app.CSRKeeper.GetCSR(suite.ctx, csr.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(4000), updated.Revenue)
+	suite.Require().Equal(uint64(2), updated.Txs)
+	pendingFee, found := suite.app.CSRKeeper.GetPendingFee(suite.ctx, csr.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(4000), pendingFee.Amount)
+	suite.Require().Equal(sdkmath.NewInt(4000), suite.app.BankKeeper.GetBalance(suite.ctx, moduleAccount, evmDenom).Amount)
+
+	// the fees stay pending while the Turnstile rejects them
+	suite.Require().NoError(suite.app.CSRKeeper.EndBlocker(suite.ctx))
+	res, err := suite.queryClient.PendingFees(suite.ctx, &csrTypes.QueryPendingFeesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]csrTypes.CSRPendingFee{{NftId: csr.Id, Amount: sdkmath.NewInt(4000)}}, res.PendingFees)
+
+	// and are distributed once the Turnstile takes them in
+	suite.app.CSRKeeper.SetTurnstile(suite.ctx, turnstileAddress)
+	suite.Require().NoError(suite.app.CSRKeeper.EndBlocker(suite.ctx))
+	_, found = suite.app.CSRKeeper.GetPendingFee(suite.ctx, csr.Id)
+	suite.Require().False(found)
+	suite.Require().Equal(sdkmath.NewInt(4000), suite.app.BankKeeper.GetBalance(suite.ctx, turnstile, evmDenom).Amount)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, moduleAccount, evmDenom).IsZero())
+	balance, err := suite.app.CSRKeeper.GetNFTRevenueBalance(suite.ctx, csr.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(4000), balance)
+}
+
+// The end blocker retries at most PendingFeeRetryBudget pending fees, resuming after the last
+// NFT retried in the previous block
+func (suite *KeeperTestSuite) TestDistributePendingFeesBudget() {
+	suite.SetupTest()
+	suite.Commit()
+
+	// the pending fees are held by the module account
+	evmDenom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+	coins := sdk.Coins{{Denom: evmDenom, Amount: sdkmath.NewInt(1000)}}
+	suite.app.BankKeeper.MintCoins(suite.ctx, csrTypes.ModuleName, coins)
+
+	csrs := GenerateCSRs(3)
+	for _, csr := range csrs {
+		suite.app.CSRKeeper.SetCSR(suite.ctx, csr)
+		suite.app.CSRKeeper.AddPendingFee(suite.ctx, csr.Id, sdkmath.NewInt(100))
+	}
+
+	turnstileAddress, found := suite.app.CSRKeeper.GetTurnstile(suite.ctx)
+	suite.Require().True(found)
+	turnstile := sdk.AccAddress(turnstileAddress.Bytes())
+
+	params := suite.app.CSRKeeper.GetParams(suite.ctx)
+	params.PendingFeeRetryBudget = 2
+	suite.app.CSRKeeper.SetParams(suite.ctx, params)
+
+	pendingNFTs := func() (nftIds []uint64) {
+		for _, pendingFee := range suite.app.CSRKeeper.GetAllPendingFees(suite.ctx) {
+			nftIds = append(nftIds, pendingFee.NftId)
+		}
+		return
+	}
+
+	// the first block retries the first two NFTs
+	suite.Require().NoError(suite.app.CSRKeeper.EndBlocker(suite.ctx))
+	suite.Require().Equal([]uint64{csrs[2].Id}, pendingNFTs())
+	suite.Require().Equal(sdkmath.NewInt(200), suite.app.BankKeeper.GetBalance(suite.ctx, turnstile, evmDenom).Amount)
+
+	// the next block resumes from the third NFT and wraps around to the first one
+	suite.app.CSRKeeper.AddPendingFee(suite.ctx, csrs[0].Id, sdkmath.NewInt(100))
+	suite.app.CSRKeeper.AddPendingFee(suite.ctx, csrs[1].Id, sdkmath.NewInt(100))
+	suite.Require().NoError(suite.app.CSRKeeper.EndBlocker(suite.ctx))
+	suite.Require().Equal([]uint64{csrs[1].Id}, pendingNFTs())
+	suite.Require().Equal(sdkmath.NewInt(400), suite.app.BankKeeper.GetBalance(suite.ctx, turnstile, evmDenom).Amount)
+
+	// a zero budget stops retrying the pending fees
+	params.PendingFeeRetryBudget = 0
+	suite.app.CSRKeeper.SetParams(suite.ctx, params)
+	suite.Require().NoError(suite.app.CSRKeeper.EndBlocker(suite.ctx))
+	suite.Require().Equal([]uint64{csrs[1].Id}, pendingNFTs())
+	suite.Require().Equal(sdkmath.NewInt(400), suite.app.BankKeeper.GetBalance(suite.ctx, turnstile, evmDenom).Amount)
+}
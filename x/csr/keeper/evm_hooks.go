@@ -1,8 +1,6 @@
 package keeper
 
 import (
-	"math/big"
-
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,8 +8,10 @@ import (
 	"github.com/Canto-Network/Canto/v8/contracts"
 	"github.com/Canto-Network/Canto/v8/x/csr/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethparams "github.com/ethereum/go-ethereum/params"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
@@ -38,7 +38,15 @@ func (k Keeper) Hooks() Hooks {
 // If some event does exist, the event handler will process and update state accordingly.
 // At the very end of the hook, the hook will check if the To address in the tx belongs
 // to any NFT currently in state. If so, the fees will be split and distributed to the
-// Turnstile Address / NFT.
+// Turnstile Address / NFT. If the multi contract attribution is enabled, the CSR fee
+// is instead split between every registered contract touched by the tx in proportion
+// to the gas attributed to it (see touchedContracts). The
+// share of the fee distributed to each NFT depends on its share override and on the
+// share tiers its revenue reached, and is limited by the revenue cap. With the robust
+// fee distribution, a fee the Turnstile fails to take in is kept as a pending fee of
+// the NFT, retried at the end of the following blocks within the pending fee retry
+// budget, instead of reverting the tx. Failed and reverted txs are out of scope: the
+// hook is only called for successful txs, so no CSR fee is taken from failed txs.
 func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	// Check if the csr module has been enabled
 	params := h.k.GetParams(ctx)
@@ -65,19 +73,17 @@ func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *etht
 		return errorsmod.Wrapf(ErrFeeDistribution, "EVMHook::PostTxProcessing failed to distribute fees from fee collector to module acount, %d", err)
 	}
 
-	contract := msg.To()
-	if contract == nil {
-		// Burn the whole fee if TX isn't smart contract interaction
-		errBurn := h.k.bankKeeper.BurnCoins(ctx, types.ModuleName, fees)
-		if errBurn != nil {
-			return errorsmod.Wrapf(ErrFeeDistribution, "EVMHook::PostTxProcessing failed to burn base fee after contract was nill, %d", errBurn)
-		}
-		return nil
+	// Only the called contract is credited unless the multi contract attribution is enabled
+	var creditedContracts []contractGas
+	if params.MultiContractAttribution {
+		creditedContracts = touchedContracts(msg, receipt)
+	} else if contract := msg.To(); contract != nil {
+		creditedContracts = []contractGas{{contract: *contract, gas: receipt.GasUsed}}
 	}
 
-	nftID, foundNFT := h.k.GetNFTByContract(ctx, contract.String())
-	if !foundNFT {
-		// Burn the whole fee if contract isn't registered to CSR
+	nftFees := h.k.splitCSRFee(ctx, params, creditedContracts, fee)
+	if len(nftFees) == 0 {
+		// Burn the whole fee if TX isn't smart contract interaction or no contract is registered to CSR
 		errBurn := h.k.bankKeeper.BurnCoins(ctx, types.ModuleName, fees)
 		if errBurn != nil {
 			return errorsmod.Wrapf(ErrFeeDistribution, "EVMHook::PostTxProcessing failed to burn base fee after NFT wasn't found, %d", errBurn)
@@ -85,45 +91,154 @@ func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *etht
 		return nil
 	}
 
-	csr, found := h.k.GetCSR(ctx, nftID)
-	if !found {
-		return errorsmod.Wrapf(ErrNonexistentCSR, "EVMHook::PostTxProcessing the NFT ID was found but the CSR was not: %d", nftID)
+	// Check the turnstile which will receive funds for tx fees has been deployed
+	if _, found := h.k.GetTurnstile(ctx); !found {
+		return errorsmod.Wrapf(ErrContractDeployments, "EVMHook::PostTxProcessing the turnstile contract has not been found.")
 	}
 
-	// Calculate fees to be distributed = intFloor(GasUsed * GasPrice * csrShares)
-	csrFee := sdkmath.LegacyNewDecFromInt(fee).Mul(params.CsrShares).TruncateInt()
+	distributedFee := sdkmath.ZeroInt()
+	for _, nftFee := range nftFees {
+		csr, found := h.k.GetCSR(ctx, nftFee.nftID)
+		if !found {
+			return errorsmod.Wrapf(ErrNonexistentCSR, "EVMHook::PostTxProcessing the NFT ID was found but the CSR was not: %d", nftFee.nftID)
+		}
 
-	// Remaining fee is calculated by fee - csrFee = remainingFee
-	remainingFee := fee.Sub(csrFee)
-	burnRemainingFees := sdk.Coins{{Denom: evmDenom, Amount: remainingFee}} // remaining amount to burn
+		// Distribute CSR fee to turnstile contract by NFT ID distributeFees(amount, nftID).
+		// The turnstile reverts when given nothing to distribute, e.g. once the NFT reached
+		// the revenue cap, in which case the tx is still counted.
+		if nftFee.amount.IsPositive() {
+			if err := h.k.DistributeCSRFee(ctx, nftFee.nftID, nftFee.amount); err != nil {
+				if !params.RobustFeeDistribution {
+					return errorsmod.Wrapf(ErrFeeDistribution, "EVMHook::PostTxProcessing failed to distribute fees from module account to turnstile, %d", err)
+				}
+				// Keep the fee in the module account until the end blocker distributes it
+				h.k.Logger(ctx).Error("failed to distribute csr fee, keeping it pending", "nft-id", nftFee.nftID, "amount", nftFee.amount, "error", err)
+				h.k.AddPendingFee(ctx, nftFee.nftID, nftFee.amount)
+			}
+		}
 
-	// Get the turnstile which will receive funds for tx fees
-	turnstileAddress, found := h.k.GetTurnstile(ctx)
-	if !found {
-		return errorsmod.Wrapf(ErrContractDeployments, "EVMHook::PostTxProcessing the turnstile contract has not been found.")
-	}
+		// Update metrics on the CSR obj
+		csr.Txs += 1
+		csr.Revenue = csr.Revenue.Add(nftFee.amount)
 
-	// Distribute CSR fee to turnstile contract by NFT ID distributeFees(amount, nftID)
-	amount := csrFee.BigInt()
-	_, err = h.k.CallMethod(ctx, "distributeFees", contracts.TurnstileContract, types.ModuleAddress, &turnstileAddress, amount, new(big.Int).SetUint64(nftID))
-	if err != nil {
-		return errorsmod.Wrapf(ErrFeeDistribution, "EVMHook::PostTxProcessing failed to distribute fees from module account to turnstile, %d", err)
+		// Store updated CSR
+		h.k.SetCSR(ctx, *csr)
+		// Record the fee in the revenue of the ongoing day epoch
+		h.k.AddCSRRevenue(ctx, nftFee.nftID, nftFee.amount)
+
+		distributedFee = distributedFee.Add(nftFee.amount)
 	}
 
+	// Remaining fee is calculated by fee - csrFee = remainingFee
+	remainingFee := fee.Sub(distributedFee)
+	burnRemainingFees := sdk.Coins{{Denom: evmDenom, Amount: remainingFee}} // remaining amount to burn
+
 	// Burn remaining base fee
 	errBurn := h.k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnRemainingFees)
 	if errBurn != nil {
 		return errorsmod.Wrapf(ErrFeeDistribution, "EVMHook::PostTxProcessing failed to burn remaining base fee, %d", errBurn)
 	}
 
-	// Update metrics on the CSR obj
-	csr.Txs += 1
-	csr.Revenue = csr.Revenue.Add(csrFee)
+	return nil
+}
 
-	// Store updated CSR
-	h.k.SetCSR(ctx, *csr)
+// nftFee is the share of the CSR fee of a transaction distributed to a CSR NFT
+type nftFee struct {
+	nftID  uint64
+	amount sdkmath.Int
+}
 
-	return nil
+// contractGas is the gas of a transaction attributed to a contract
+type contractGas struct {
+	contract common.Address
+	gas      uint64
+}
+
+// touchedContracts returns the contracts touched by the tx with the gas attributed
+// to each of them. The EVM hooks are not given the call trace of the tx, so the
+// event logs stand for the contracts reached through internal calls: a contract
+// which emits no event is not credited, and the events emitted by code run
+// through a delegatecall are credited to the calling contract. Each contract
+// emitting events is attributed the gas of its LOG operations, and the called
+// contract the rest of the gas used by the tx, so that a contract emitting a
+// single event, such as the Transfer of a token, earns a small share of the fee.
+func touchedContracts(msg core.Message, receipt *ethtypes.Receipt) []contractGas {
+	var contracts []contractGas
+	index := make(map[common.Address]int)
+	if contract := msg.To(); contract != nil {
+		contracts = append(contracts, contractGas{contract: *contract})
+		index[*contract] = 0
+	}
+
+	var logsGas uint64
+	for _, log := range receipt.Logs {
+		if to := msg.To(); to != nil && log.Address == *to {
+			continue
+		}
+		i, ok := index[log.Address]
+		if !ok {
+			i = len(contracts)
+			contracts = append(contracts, contractGas{contract: log.Address})
+			index[log.Address] = i
+		}
+		gas := ethparams.LogGas + ethparams.LogTopicGas*uint64(len(log.Topics)) + ethparams.LogDataGas*uint64(len(log.Data))
+		contracts[i].gas += gas
+		logsGas += gas
+	}
+
+	// The gas refunds may lower the gas used by the tx below the gas of its logs
+	if msg.To() != nil && receipt.GasUsed > logsGas {
+		contracts[0].gas = receipt.GasUsed - logsGas
+	}
+	return contracts
+}
+
+// splitCSRFee splits the fee between the contracts registered to some NFT in
+// proportion to their gas and returns the CSR fee of each of their NFTs, in the
+// order in which the NFTs first appear. The CSR fee of an NFT is computed as the
+// legacy single share split: intFloor(fee * csrShares) is divided between the
+// registered contracts, the remainder of the split being credited to the first
+// one, with the csr shares being those of the NFT (see GetEffectiveCSRShares).
+// It is then limited to what the NFT can still earn under the revenue cap in
+// the ongoing day epoch.
+func (k Keeper) splitCSRFee(ctx sdk.Context, params types.Params, contracts []contractGas, fee sdkmath.Int) []nftFee {
+	// Sum the gas of the registered contracts of each NFT
+	var nftFees []nftFee
+	weights := make(map[uint64]sdkmath.Int)
+	total := sdkmath.ZeroInt()
+	for _, contract := range contracts {
+		nftID, found := k.GetNFTByContract(ctx, contract.contract.String())
+		if !found || contract.gas == 0 {
+			continue
+		}
+		if _, ok := weights[nftID]; !ok {
+			nftFees = append(nftFees, nftFee{nftID: nftID})
+			weights[nftID] = sdkmath.ZeroInt()
+		}
+		gas := sdkmath.NewIntFromUint64(contract.gas)
+		weights[nftID] = weights[nftID].Add(gas)
+		total = total.Add(gas)
+	}
+	if len(nftFees) == 0 {
+		return nil
+	}
+
+	for i := range nftFees {
+		nftID := nftFees[i].nftID
+		csrFee := sdkmath.LegacyNewDecFromInt(fee).Mul(k.GetEffectiveCSRShares(ctx, params, nftID)).TruncateInt()
+
+		amount := csrFee.Mul(weights[nftID]).Quo(total)
+		if i == 0 {
+			amount = csrFee.Sub(csrFee.Mul(total.Sub(weights[nftID])).Quo(total))
+		}
+
+		if !params.CsrRevenueCap.IsNil() && params.CsrRevenueCap.IsPositive() {
+			remaining := params.CsrRevenueCap.Sub(k.GetCurrentCSRRevenue(ctx, nftID).Revenue)
+			amount = sdkmath.MinInt(amount, sdkmath.MaxInt(remaining, sdkmath.ZeroInt()))
+		}
+		nftFees[i].amount = amount
+	}
+	return nftFees
 }
 
 func (h Hooks) processEvents(ctx sdk.Context, receipt *ethtypes.Receipt) {
@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"time"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/Canto-Network/Canto/v8/x/csr"
 	"github.com/Canto-Network/Canto/v8/x/csr/types"
 	"github.com/evmos/ethermint/tests"
@@ -74,6 +76,13 @@ func (suite *KeeperTestSuite) TestImportExportGenesis() {
 	turnstileAddress := tests.GenerateAddress()
 	suite.app.CSRKeeper.SetTurnstile(suite.ctx, turnstileAddress)
 
+	suite.app.CSRKeeper.AddCSRRevenue(suite.ctx, csrs[0].Id, sdkmath.NewInt(100))
+	suite.app.CSRKeeper.RollUpCSRRevenues(suite.ctx, 1)
+	suite.app.CSRKeeper.AddCSRRevenue(suite.ctx, csrs[1].Id, sdkmath.NewInt(200))
+	override := types.CSRShareOverride{NftId: csrs[2].Id, CsrShares: sdkmath.LegacyNewDecWithPrec(5, 2)}
+	suite.app.CSRKeeper.SetCSRShareOverride(suite.ctx, override)
+	suite.app.CSRKeeper.AddPendingFee(suite.ctx, csrs[3].Id, sdkmath.NewInt(300))
+
 	genState := csr.ExportGenesis(suite.ctx, suite.app.CSRKeeper)
 	bz := suite.app.AppCodec().MustMarshalJSON(genState)
 
@@ -92,4 +101,9 @@ func (suite *KeeperTestSuite) TestImportExportGenesis() {
 	ta, found := suite.app.CSRKeeper.GetTurnstile(suite.ctx)
 	suite.True(found)
 	suite.Equal(turnstileAddress, ta)
+
+	suite.Equal([]types.CSRRevenue{{NftId: csrs[0].Id, EpochNumber: 1, Txs: 1, Revenue: sdkmath.NewInt(100)}}, exported.RevenueHistory)
+	suite.Equal([]types.CSRRevenue{{NftId: csrs[1].Id, Txs: 1, Revenue: sdkmath.NewInt(200)}}, exported.CurrentRevenues)
+	suite.Equal([]types.CSRShareOverride{override}, exported.ShareOverrides)
+	suite.Equal([]types.CSRPendingFee{{NftId: csrs[3].Id, Amount: sdkmath.NewInt(300)}}, exported.PendingFees)
 }
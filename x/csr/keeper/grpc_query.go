@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"sort"
 	"strings"
 
 	"cosmossdk.io/store/prefix"
@@ -113,3 +114,117 @@ func (k Keeper) Turnstile(c context.Context, _ *types.QueryTurnstileRequest) (*t
 	}
 	return &types.QueryTurnstileResponse{Address: address.String()}, nil
 }
+
+const (
+	// defaultTopCSRsLimit is the number of CSR NFTs returned by TopCSRs when no limit is requested
+	defaultTopCSRsLimit = 10
+	// maxTopCSRsLimit is the maximum number of CSR NFTs returned by TopCSRs
+	maxTopCSRsLimit = 100
+)
+
+// CSRRevenueHistory returns the revenue of a CSR NFT in past day epochs with optional pagination,
+// along with its revenue in the ongoing day epoch
+func (k Keeper) CSRRevenueHistory(c context.Context, request *types.QueryCSRRevenueHistoryRequest) (*types.QueryCSRRevenueHistoryResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetCSR(ctx, request.NftId); !found {
+		return nil, status.Errorf(codes.NotFound, "no csr is associated with NFT ID %d", request.NftId)
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.GetRevenueHistoryPrefix(request.NftId))
+
+	revenues := make([]types.CSRRevenue, 0)
+	pageRes, err := query.Paginate(
+		prefixStore,
+		request.Pagination,
+		func(key, value []byte) error {
+			var revenue types.CSRRevenue
+			if err := k.cdc.Unmarshal(value, &revenue); err != nil {
+				return err
+			}
+			revenues = append(revenues, revenue)
+			return nil
+		},
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCSRRevenueHistoryResponse{
+		Revenues:   revenues,
+		Current:    k.GetCurrentCSRRevenue(ctx, request.NftId),
+		Pagination: pageRes,
+	}, nil
+}
+
+// PendingFees returns the csr fees not yet distributed to the Turnstile with optional pagination
+func (k Keeper) PendingFees(c context.Context, request *types.QueryPendingFeesRequest) (*types.QueryPendingFeesResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixPendingFee)
+
+	pendingFees := make([]types.CSRPendingFee, 0)
+	pageRes, err := query.Paginate(
+		prefixStore,
+		request.Pagination,
+		func(key, value []byte) error {
+			var pendingFee types.CSRPendingFee
+			if err := k.cdc.Unmarshal(value, &pendingFee); err != nil {
+				return err
+			}
+			pendingFees = append(pendingFees, pendingFee)
+			return nil
+		},
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingFeesResponse{PendingFees: pendingFees, Pagination: pageRes}, nil
+}
+
+// TopCSRs returns the CSR NFTs that earned the most revenue in a past day epoch, by descending revenue
+func (k Keeper) TopCSRs(c context.Context, request *types.QueryTopCSRsRequest) (*types.QueryTopCSRsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if request.Epoch <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid epoch number %d", request.Epoch)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	limit := int(request.Limit)
+	if limit == 0 {
+		limit = defaultTopCSRsLimit
+	}
+	if limit > maxTopCSRsLimit {
+		limit = maxTopCSRsLimit
+	}
+
+	revenues := k.GetEpochCSRRevenues(ctx, request.Epoch)
+	// ties are broken by the NFT ID, as the revenues are ordered by NFT ID
+	sort.SliceStable(revenues, func(i, j int) bool {
+		return revenues[i].Revenue.GT(revenues[j].Revenue)
+	})
+	if len(revenues) > limit {
+		revenues = revenues[:limit]
+	}
+	if revenues == nil {
+		revenues = make([]types.CSRRevenue, 0)
+	}
+
+	return &types.QueryTopCSRsResponse{Revenues: revenues}, nil
+}
@@ -295,6 +295,8 @@ func (suite *KeeperTestSuite) TestCSRByContract() {
 func (suite *KeeperTestSuite) TestQueryParams() {
 	expectedParams := types.DefaultParams()
 	expectedParams.EnableCsr = true
+	// an empty list of share tiers is decoded as nil from the response
+	expectedParams.CsrShareTiers = nil
 
 	res, err := suite.queryClient.Params(suite.ctx, &types.QueryParamsRequest{})
 	suite.Require().NoError(err)
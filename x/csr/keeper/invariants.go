@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Canto-Network/Canto/v8/x/csr/types"
+)
+
+// RegisterInvariants registers the csr module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "contract-index", ContractIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "unique-contracts", UniqueContractsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "turnstile-balance", TurnstileBalanceInvariant(k))
+}
+
+// AllInvariants runs all invariants of the csr module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ContractIndexInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = UniqueContractsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return TurnstileBalanceInvariant(k)(ctx)
+	}
+}
+
+// ContractIndexInvariant checks that every smart contract of a CSR maps back to
+// the NFT of the CSR through the contract index
+func ContractIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		for _, csr := range k.GetAllCSRs(ctx) {
+			for _, contract := range csr.Contracts {
+				nftID, found := k.GetNFTByContract(ctx, contract)
+				if !found || nftID != csr.Id {
+					count++
+					msg += fmt.Sprintf("\tcontract %s of NFT %d is indexed to NFT %d (found: %t)\n", contract, csr.Id, nftID, found)
+				}
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "contract-index",
+			fmt.Sprintf("amount of contracts not indexed to their NFT found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// UniqueContractsInvariant checks that no smart contract appears twice, within
+// a CSR or across CSRs
+func UniqueContractsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		seen := make(map[string]uint64)
+		for _, csr := range k.GetAllCSRs(ctx) {
+			for _, contract := range csr.Contracts {
+				if nftID, ok := seen[contract]; ok {
+					count++
+					msg += fmt.Sprintf("\tcontract %s appears in NFT %d and NFT %d\n", contract, nftID, csr.Id)
+					continue
+				}
+				seen[contract] = csr.Id
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "unique-contracts",
+			fmt.Sprintf("amount of duplicate contracts found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// TurnstileBalanceInvariant checks that the balance of the Turnstile covers the
+// revenue of every NFT it minted that has not been withdrawn yet
+func TurnstileBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		turnstileAddress, found := k.GetTurnstile(ctx)
+		if !found {
+			return sdk.FormatInvariant(types.ModuleName, "turnstile-balance", "the turnstile has not been deployed\n"), false
+		}
+
+		count, err := k.GetNFTCount(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(
+				types.ModuleName, "turnstile-balance",
+				fmt.Sprintf("failed to query the number of NFTs of the turnstile: %s\n", err),
+			), true
+		}
+
+		unwithdrawn := new(big.Int)
+		for nftID := uint64(0); nftID < count; nftID++ {
+			revenue, err := k.GetNFTRevenueBalance(ctx, nftID)
+			if err != nil {
+				return sdk.FormatInvariant(
+					types.ModuleName, "turnstile-balance",
+					fmt.Sprintf("failed to query the revenue of NFT %d: %s\n", nftID, err),
+				), true
+			}
+			unwithdrawn.Add(unwithdrawn, revenue)
+		}
+
+		balance := new(big.Int)
+		if account := k.evmKeeper.GetAccount(ctx, turnstileAddress); account != nil {
+			balance = account.Balance
+		}
+
+		broken := balance.Cmp(unwithdrawn) < 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "turnstile-balance",
+			fmt.Sprintf("turnstile balance %s, unwithdrawn revenue of %d NFTs %s\n", balance, count, unwithdrawn),
+		), broken
+	}
+}
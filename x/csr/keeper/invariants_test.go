@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Canto-Network/Canto/v8/contracts"
+	"github.com/Canto-Network/Canto/v8/x/csr/keeper"
+	csrtypes "github.com/Canto-Network/Canto/v8/x/csr/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	suite.SetupTest()
+	suite.Commit()
+	k := suite.app.CSRKeeper
+
+	turnstileAddress, found := k.GetTurnstile(suite.ctx)
+	suite.Require().True(found)
+	turnstile := sdk.AccAddress(turnstileAddress.Bytes())
+
+	// register two contracts through governance and distribute fees to both NFTs
+	owner := suite.CreateNewAccount(suite.ctx)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	msgServer := keeper.NewMsgServerImpl(k)
+	registered := make([]string, 2)
+	for i := range registered {
+		contract, err := k.DeployTurnstile(suite.ctx)
+		suite.Require().NoError(err)
+		registered[i] = contract.String()
+		_, err = msgServer.RegisterContract(suite.ctx, csrtypes.NewMsgRegisterContract(authority, registered[i], 0, owner.String()))
+		suite.Require().NoError(err)
+	}
+
+	evmDenom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, csrtypes.ModuleName, sdk.NewCoins(sdk.NewCoin(evmDenom, sdkmath.NewInt(3000)))))
+	for nftID, amount := range []int64{1000, 2000} {
+		_, err := k.CallMethod(suite.ctx, "distributeFees", contracts.TurnstileContract, csrtypes.ModuleAddress, &turnstileAddress, big.NewInt(amount), big.NewInt(int64(nftID)))
+		suite.Require().NoError(err)
+	}
+
+	// withdrawals keep the invariants
+	_, err := msgServer.WithdrawCSRRevenue(suite.ctx, csrtypes.NewMsgWithdrawCSRRevenue(owner.String(), 1, owner.String(), sdkmath.NewInt(500)))
+	suite.Require().NoError(err)
+
+	msg, broken := keeper.AllInvariants(k)(suite.ctx)
+	suite.Require().False(broken, msg)
+
+	// coins leaving the Turnstile outside of withdrawals leave unwithdrawn revenue uncovered
+	suite.Require().NoError(suite.app.BankKeeper.SendCoins(suite.ctx, turnstile, owner, sdk.NewCoins(sdk.NewCoin(evmDenom, sdkmath.NewInt(1)))))
+	_, broken = keeper.TurnstileBalanceInvariant(k)(suite.ctx)
+	suite.Require().True(broken)
+
+	// a contract of an NFT registered to another NFT is indexed to the latter only
+	k.SetCSR(suite.ctx, csrtypes.NewCSR([]string{registered[0]}, 9))
+	_, broken = keeper.ContractIndexInvariant(k)(suite.ctx)
+	suite.Require().True(broken)
+	_, broken = keeper.UniqueContractsInvariant(k)(suite.ctx)
+	suite.Require().True(broken)
+}
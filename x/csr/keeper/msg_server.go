@@ -4,8 +4,12 @@ import (
 	"context"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/Canto-Network/Canto/v8/x/csr/types"
 )
@@ -36,3 +40,205 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// WithdrawCSRRevenue withdraws the revenue accrued by a CSR NFT to a bech32 address. The signer
+// must own the NFT in the Turnstile, which lets accounts without an EVM wallet, such as multisigs,
+// claim the revenue of the NFTs they own. As the Turnstile withdraw method is restricted to the
+// owner of the NFT by its onlyNftOwner modifier, the keeper calls it from the EVM address of the
+// owner once the signer is authorized.
+func (k msgServer) WithdrawCSRRevenue(goCtx context.Context, req *types.MsgWithdrawCSRRevenue) (*types.MsgWithdrawCSRRevenueResponse, error) {
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+	if req.Amount.IsNil() || req.Amount.IsNegative() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid amount %s", req.Amount)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the revenue of NFTs merged away, which no longer have a CSR, remains withdrawable
+	nftOwner, err := k.authorizeNFTOwner(ctx, req.Owner, req.NftId)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := k.GetNFTRevenueBalance(ctx, req.NftId)
+	if err != nil {
+		return nil, err
+	}
+	if balance.Sign() == 0 {
+		return nil, errorsmod.Wrapf(ErrNothingToWithdraw, "NFT ID %d", req.NftId)
+	}
+
+	// a zero amount withdraws the whole revenue, and larger amounts are capped by the Turnstile
+	amount := req.Amount.BigInt()
+	if req.Amount.IsZero() {
+		amount = balance
+	}
+
+	withdrawn, err := k.Keeper.WithdrawCSRRevenue(ctx, nftOwner, req.NftId, common.BytesToAddress(recipient), amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawCSRRevenueResponse{Amount: sdkmath.NewIntFromBigInt(withdrawn)}, nil
+}
+
+// RegisterContract registers a deployed contract to a new CSR NFT, for contracts that did not
+// call the Turnstile at deployment, such as immutable contracts. The governance authority can
+// register any contract, while a deployer must prove that it created the contract from the
+// nonce its account had at deployment.
+func (k msgServer) RegisterContract(goCtx context.Context, req *types.MsgRegisterContract) (*types.MsgRegisterContractResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+	if !common.IsHexAddress(req.Contract) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSmartContractAddress, "invalid contract address %s", req.Contract)
+	}
+	contract := common.HexToAddress(req.Contract)
+
+	// contracts deployed by CREATE2 or by other contracts can only be registered by governance
+	if k.GetAuthority() != req.Sender && crypto.CreateAddress(common.BytesToAddress(sender), req.Nonce) != contract {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s did not deploy %s with nonce %d", req.Sender, req.Contract, req.Nonce)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).EnableCsr {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "csr is disabled")
+	}
+
+	nftID, err := k.Keeper.RegisterContract(ctx, contract, common.BytesToAddress(recipient))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterContractResponse{NftId: nftID}, nil
+}
+
+// RemoveContract detaches a contract from its CSR NFT. The signer must own the NFT in the Turnstile.
+func (k msgServer) RemoveContract(goCtx context.Context, req *types.MsgRemoveContract) (*types.MsgRemoveContractResponse, error) {
+	if !common.IsHexAddress(req.Contract) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSmartContractAddress, "invalid contract address %s", req.Contract)
+	}
+	contract := common.HexToAddress(req.Contract)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	nftID, found := k.GetNFTByContract(ctx, contract.String())
+	if !found {
+		return nil, errorsmod.Wrapf(ErrNonexistentCSR, "no csr contains an smart contract with address %s", req.Contract)
+	}
+	if _, err := k.authorizeNFTOwner(ctx, req.Owner, nftID); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RemoveContract(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveContractResponse{}, nil
+}
+
+// ReassignContract moves a contract from its CSR NFT to another CSR NFT. The signer must own the NFT
+// of the contract in the Turnstile, while the NFT receiving the contract can be any registered NFT,
+// as with the Turnstile assign method.
+func (k msgServer) ReassignContract(goCtx context.Context, req *types.MsgReassignContract) (*types.MsgReassignContractResponse, error) {
+	if !common.IsHexAddress(req.Contract) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSmartContractAddress, "invalid contract address %s", req.Contract)
+	}
+	contract := common.HexToAddress(req.Contract)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	nftID, found := k.GetNFTByContract(ctx, contract.String())
+	if !found {
+		return nil, errorsmod.Wrapf(ErrNonexistentCSR, "no csr contains an smart contract with address %s", req.Contract)
+	}
+	if _, err := k.authorizeNFTOwner(ctx, req.Owner, nftID); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.ReassignContract(ctx, contract, req.NftId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReassignContractResponse{}, nil
+}
+
+// MergeNFTs moves every contract of a CSR NFT to another CSR NFT. The signer must own both NFTs in
+// the Turnstile.
+func (k msgServer) MergeNFTs(goCtx context.Context, req *types.MsgMergeNFTs) (*types.MsgMergeNFTsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, nftID := range []uint64{req.SourceNftId, req.TargetNftId} {
+		if _, found := k.GetCSR(ctx, nftID); !found {
+			return nil, errorsmod.Wrapf(ErrNFTNotFound, "no csr is associated with NFT ID %d", nftID)
+		}
+		if _, err := k.authorizeNFTOwner(ctx, req.Owner, nftID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.MergeCSRs(ctx, req.SourceNftId, req.TargetNftId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMergeNFTsResponse{}, nil
+}
+
+// SetCSRShareOverride sets the share of the transaction fees distributed to a CSR NFT, regardless
+// of the csr shares and share tiers of the params. Nil csr shares clear the override. Only the
+// governance authority can set an override.
+func (k msgServer) SetCSRShareOverride(goCtx context.Context, req *types.MsgSetCSRShareOverride) (*types.MsgSetCSRShareOverrideResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetCSR(ctx, req.NftId); !found {
+		return nil, errorsmod.Wrapf(ErrNFTNotFound, "no csr is associated with NFT ID %d", req.NftId)
+	}
+
+	if req.CsrShares == nil {
+		k.DeleteCSRShareOverride(ctx, req.NftId)
+		return &types.MsgSetCSRShareOverrideResponse{}, nil
+	}
+
+	if err := types.ValidateShares(*req.CsrShares); err != nil {
+		return nil, err
+	}
+
+	k.Keeper.SetCSRShareOverride(ctx, types.CSRShareOverride{NftId: req.NftId, CsrShares: *req.CsrShares})
+
+	return &types.MsgSetCSRShareOverrideResponse{}, nil
+}
+
+// authorizeNFTOwner checks that the signer owns the NFT in the Turnstile, and returns the owner.
+// Only 20 bytes accounts can own an NFT, as the EVM address of a longer account, such as a module
+// or interchain account, is made of its last 20 bytes and could collide with the owner.
+func (k msgServer) authorizeNFTOwner(ctx sdk.Context, signer string, nftID uint64) (common.Address, error) {
+	signerAddr, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return common.Address{}, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+	if len(signerAddr) != common.AddressLength {
+		return common.Address{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of NFT ID %d", signer, nftID)
+	}
+
+	owner, err := k.GetNFTOwner(ctx, nftID)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if owner != common.BytesToAddress(signerAddr) {
+		return common.Address{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of NFT ID %d", signer, nftID)
+	}
+	return owner, nil
+}
@@ -1,16 +1,25 @@
 package keeper_test
 
 import (
+	"bytes"
 	"math"
+	"math/big"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 
+	"github.com/Canto-Network/Canto/v8/contracts"
 	"github.com/Canto-Network/Canto/v8/testutil"
+	"github.com/Canto-Network/Canto/v8/x/csr/keeper"
 	csrtypes "github.com/Canto-Network/Canto/v8/x/csr/types"
 )
 
@@ -43,14 +52,18 @@ func (suite *KeeperTestSuite) TestMsgExecutionByProposal() {
 			&csrtypes.MsgUpdateParams{
 				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 				Params: csrtypes.Params{
-					EnableCsr: false,
-					CsrShares: sdkmath.LegacyNewDecWithPrec(20, 2),
+					EnableCsr:     false,
+					CsrShares:     sdkmath.LegacyNewDecWithPrec(20, 2),
+					CsrShareTiers: []csrtypes.CSRShareTier{{RevenueThreshold: sdkmath.NewInt(1000), CsrShares: sdkmath.LegacyNewDecWithPrec(10, 2)}},
+					CsrRevenueCap: sdkmath.NewInt(5000),
 				},
 			},
 			func(proposalId uint64) {
 				changeParams := csrtypes.Params{
-					EnableCsr: false,
-					CsrShares: sdkmath.LegacyNewDecWithPrec(20, 2),
+					EnableCsr:     false,
+					CsrShares:     sdkmath.LegacyNewDecWithPrec(20, 2),
+					CsrShareTiers: []csrtypes.CSRShareTier{{RevenueThreshold: sdkmath.NewInt(1000), CsrShares: sdkmath.LegacyNewDecWithPrec(10, 2)}},
+					CsrRevenueCap: sdkmath.NewInt(5000),
 				}
 
 				proposal, err := suite.app.GovKeeper.Proposals.Get(suite.ctx, proposalId)
@@ -82,3 +95,301 @@ func (suite *KeeperTestSuite) TestMsgExecutionByProposal() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgWithdrawCSRRevenue() {
+	suite.SetupTest()
+	suite.Commit()
+
+	turnstileAddress, found := suite.app.CSRKeeper.GetTurnstile(suite.ctx)
+	suite.Require().True(found)
+
+	// mint the NFT 0 to the owner through the Turnstile, as a registering contract would
+	owner := suite.CreateNewAccount(suite.ctx)
+	registrant := suite.CreateNewAccount(suite.ctx)
+	_, err := suite.app.CSRKeeper.CallMethod(suite.ctx, "register", contracts.TurnstileContract, common.BytesToAddress(registrant), &turnstileAddress, big.NewInt(0), common.BytesToAddress(owner))
+	suite.Require().NoError(err)
+	suite.app.CSRKeeper.SetCSR(suite.ctx, csrtypes.NewCSR([]string{common.BytesToAddress(registrant).String()}, 0))
+
+	// distribute fees to the NFT from the module account
+	evmDenom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+	coins := sdk.NewCoins(sdk.NewCoin(evmDenom, sdkmath.NewInt(1000)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, csrtypes.ModuleName, coins))
+	_, err = suite.app.CSRKeeper.CallMethod(suite.ctx, "distributeFees", contracts.TurnstileContract, csrtypes.ModuleAddress, &turnstileAddress, big.NewInt(1000), big.NewInt(0))
+	suite.Require().NoError(err)
+
+	nftOwner, err := suite.app.CSRKeeper.GetNFTOwner(suite.ctx, 0)
+	suite.Require().NoError(err)
+	suite.Require().Equal(common.BytesToAddress(owner), nftOwner)
+	_, err = suite.app.CSRKeeper.GetNFTOwner(suite.ctx, 1)
+	suite.Require().ErrorIs(err, keeper.ErrNFTNotFound)
+
+	recipient := suite.CreateNewAccount(suite.ctx)
+	msgServer := keeper.NewMsgServerImpl(suite.app.CSRKeeper)
+
+	// a 32 bytes account, such as a module or interchain account, whose last 20 bytes are
+	// those of the owner maps to the EVM address of the owner
+	colliding := sdk.AccAddress(append(bytes.Repeat([]byte{1}, 12), owner...))
+	suite.Require().Equal(common.BytesToAddress(owner), common.BytesToAddress(colliding))
+
+	testCases := []struct {
+		name        string
+		msg         *csrtypes.MsgWithdrawCSRRevenue
+		err         error
+		expWithdraw int64
+	}{
+		{"invalid recipient", csrtypes.NewMsgWithdrawCSRRevenue(owner.String(), 0, "", sdkmath.ZeroInt()), sdkerrors.ErrInvalidAddress, 0},
+		{"negative amount", csrtypes.NewMsgWithdrawCSRRevenue(owner.String(), 0, recipient.String(), sdkmath.NewInt(-1)), sdkerrors.ErrInvalidRequest, 0},
+		{"unknown nft", csrtypes.NewMsgWithdrawCSRRevenue(owner.String(), 1, recipient.String(), sdkmath.ZeroInt()), keeper.ErrNFTNotFound, 0},
+		{"not the owner", csrtypes.NewMsgWithdrawCSRRevenue(registrant.String(), 0, recipient.String(), sdkmath.ZeroInt()), sdkerrors.ErrUnauthorized, 0},
+		{"address colliding with the owner", csrtypes.NewMsgWithdrawCSRRevenue(colliding.String(), 0, recipient.String(), sdkmath.ZeroInt()), sdkerrors.ErrUnauthorized, 0},
+		{"partial withdrawal", csrtypes.NewMsgWithdrawCSRRevenue(owner.String(), 0, recipient.String(), sdkmath.NewInt(300)), nil, 300},
+		{"zero amount withdraws the whole revenue", csrtypes.NewMsgWithdrawCSRRevenue(owner.String(), 0, recipient.String(), sdkmath.ZeroInt()), nil, 700},
+		{"nothing to withdraw", csrtypes.NewMsgWithdrawCSRRevenue(owner.String(), 0, recipient.String(), sdkmath.ZeroInt()), keeper.ErrNothingToWithdraw, 0},
+	}
+	for _, tc := range testCases {
+		balance := suite.app.BankKeeper.GetBalance(suite.ctx, recipient, evmDenom)
+		res, err := msgServer.WithdrawCSRRevenue(suite.ctx, tc.msg)
+		if tc.err != nil {
+			suite.Require().ErrorIs(err, tc.err, tc.name)
+			continue
+		}
+		suite.Require().NoError(err, tc.name)
+		suite.Require().Equal(sdkmath.NewInt(tc.expWithdraw), res.Amount, tc.name)
+		suite.Require().Equal(balance.AddAmount(res.Amount), suite.app.BankKeeper.GetBalance(suite.ctx, recipient, evmDenom), tc.name)
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgRegisterContract() {
+	suite.SetupTest()
+	suite.Commit()
+
+	// deploy a contract which does not register itself from an EOA
+	deployer := suite.CreateNewAccount(suite.ctx)
+	nonce, err := suite.app.AccountKeeper.GetSequence(suite.ctx, deployer)
+	suite.Require().NoError(err)
+	_, err = suite.app.CSRKeeper.CallEVM(suite.ctx, common.BytesToAddress(deployer), nil, big.NewInt(0), contracts.TurnstileContract.Bin, true)
+	suite.Require().NoError(err)
+	deployed := crypto.CreateAddress(common.BytesToAddress(deployer), nonce)
+
+	// a contract deployed by the module account, which can only be registered by governance
+	moduleDeployed, err := suite.app.CSRKeeper.DeployTurnstile(suite.ctx)
+	suite.Require().NoError(err)
+
+	recipient := suite.CreateNewAccount(suite.ctx)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	msgServer := keeper.NewMsgServerImpl(suite.app.CSRKeeper)
+
+	testCases := []struct {
+		name     string
+		msg      *csrtypes.MsgRegisterContract
+		err      error
+		expNFTID uint64
+	}{
+		{"invalid contract", csrtypes.NewMsgRegisterContract(deployer.String(), "0x", nonce, recipient.String()), csrtypes.ErrInvalidSmartContractAddress, 0},
+		{"wrong deployer nonce", csrtypes.NewMsgRegisterContract(deployer.String(), deployed.String(), nonce+1, recipient.String()), sdkerrors.ErrUnauthorized, 0},
+		{"not the deployer", csrtypes.NewMsgRegisterContract(recipient.String(), deployed.String(), nonce, recipient.String()), sdkerrors.ErrUnauthorized, 0},
+		{"deployer registers the contract", csrtypes.NewMsgRegisterContract(deployer.String(), deployed.String(), nonce, recipient.String()), nil, 0},
+		{"contract already registered", csrtypes.NewMsgRegisterContract(authority, deployed.String(), 0, recipient.String()), keeper.ErrPrevRegisteredSmartContract, 0},
+		{"governance cannot register an EOA", csrtypes.NewMsgRegisterContract(authority, common.BytesToAddress(recipient).String(), 0, recipient.String()), keeper.ErrRegisterInvalidContract, 0},
+		{"governance registers any contract", csrtypes.NewMsgRegisterContract(authority, moduleDeployed.String(), 0, recipient.String()), nil, 1},
+	}
+	for _, tc := range testCases {
+		res, err := msgServer.RegisterContract(suite.ctx, tc.msg)
+		if tc.err != nil {
+			suite.Require().ErrorIs(err, tc.err, tc.name)
+			continue
+		}
+		suite.Require().NoError(err, tc.name)
+		suite.Require().Equal(tc.expNFTID, res.NftId, tc.name)
+
+		csr, found := suite.app.CSRKeeper.GetCSR(suite.ctx, res.NftId)
+		suite.Require().True(found, tc.name)
+		suite.Require().Equal([]string{tc.msg.Contract}, csr.Contracts, tc.name)
+		nftID, found := suite.app.CSRKeeper.GetNFTByContract(suite.ctx, tc.msg.Contract)
+		suite.Require().True(found, tc.name)
+		suite.Require().Equal(res.NftId, nftID, tc.name)
+		owner, err := suite.app.CSRKeeper.GetNFTOwner(suite.ctx, res.NftId)
+		suite.Require().NoError(err, tc.name)
+		suite.Require().Equal(common.BytesToAddress(recipient), owner, tc.name)
+	}
+
+	// registrations are rejected while csr is disabled
+	params := suite.app.CSRKeeper.GetParams(suite.ctx)
+	params.EnableCsr = false
+	suite.app.CSRKeeper.SetParams(suite.ctx, params)
+	_, err = msgServer.RegisterContract(suite.ctx, csrtypes.NewMsgRegisterContract(authority, deployed.String(), 0, recipient.String()))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
+
+func (suite *KeeperTestSuite) TestMsgRemoveReassignMerge() {
+	suite.SetupTest()
+	suite.Commit()
+
+	turnstileAddress, found := suite.app.CSRKeeper.GetTurnstile(suite.ctx)
+	suite.Require().True(found)
+
+	// register four contracts through governance, the NFTs 0, 1 and 3 to the owner and 2 to another account
+	owner := suite.CreateNewAccount(suite.ctx)
+	other := suite.CreateNewAccount(suite.ctx)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	msgServer := keeper.NewMsgServerImpl(suite.app.CSRKeeper)
+	registered := make([]string, 4)
+	for i := range registered {
+		contract, err := suite.app.CSRKeeper.DeployTurnstile(suite.ctx)
+		suite.Require().NoError(err)
+		registered[i] = contract.String()
+		recipient := owner
+		if i == 2 {
+			recipient = other
+		}
+		res, err := msgServer.RegisterContract(suite.ctx, csrtypes.NewMsgRegisterContract(authority, registered[i], 0, recipient.String()))
+		suite.Require().NoError(err)
+		suite.Require().Equal(uint64(i), res.NftId)
+	}
+
+	// fees distributed to the NFT 1 before it is merged away
+	evmDenom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, csrtypes.ModuleName, sdk.NewCoins(sdk.NewCoin(evmDenom, sdkmath.NewInt(1000)))))
+	_, err := suite.app.CSRKeeper.CallMethod(suite.ctx, "distributeFees", contracts.TurnstileContract, csrtypes.ModuleAddress, &turnstileAddress, big.NewInt(1000), big.NewInt(1))
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name string
+		msg  sdk.Msg
+		err  error
+	}{
+		{"merge into itself", csrtypes.NewMsgMergeNFTs(owner.String(), 1, 1), keeper.ErrDuplicateNFTID},
+		{"merge an unknown nft", csrtypes.NewMsgMergeNFTs(owner.String(), 9, 0), keeper.ErrNFTNotFound},
+		{"merge the nft of another owner", csrtypes.NewMsgMergeNFTs(owner.String(), 2, 0), sdkerrors.ErrUnauthorized},
+		{"merge into the nft of another owner", csrtypes.NewMsgMergeNFTs(owner.String(), 0, 2), sdkerrors.ErrUnauthorized},
+		{"merge nfts of the owner", csrtypes.NewMsgMergeNFTs(owner.String(), 1, 0), nil},
+		{"reassign an unregistered contract", csrtypes.NewMsgReassignContract(owner.String(), common.BytesToAddress(owner).String(), 2), keeper.ErrNonexistentCSR},
+		{"reassign by another owner", csrtypes.NewMsgReassignContract(other.String(), registered[1], 2), sdkerrors.ErrUnauthorized},
+		{"reassign to the nft of another owner", csrtypes.NewMsgReassignContract(owner.String(), registered[1], 2), nil},
+		{"remove the last contract", csrtypes.NewMsgRemoveContract(owner.String(), registered[0]), keeper.ErrLastContract},
+		{"remove by another owner", csrtypes.NewMsgRemoveContract(owner.String(), registered[1]), sdkerrors.ErrUnauthorized},
+		{"remove a contract", csrtypes.NewMsgRemoveContract(other.String(), registered[2]), nil},
+		// the removed contract is unregistered in the Turnstile too
+		{"register a removed contract", csrtypes.NewMsgRegisterContract(authority, registered[2], 0, other.String()), nil},
+		{"register a registered contract", csrtypes.NewMsgRegisterContract(authority, registered[2], 0, other.String()), keeper.ErrPrevRegisteredSmartContract},
+	}
+	for _, tc := range testCases {
+		var err error
+		switch msg := tc.msg.(type) {
+		case *csrtypes.MsgMergeNFTs:
+			_, err = msgServer.MergeNFTs(suite.ctx, msg)
+		case *csrtypes.MsgReassignContract:
+			_, err = msgServer.ReassignContract(suite.ctx, msg)
+		case *csrtypes.MsgRemoveContract:
+			_, err = msgServer.RemoveContract(suite.ctx, msg)
+		case *csrtypes.MsgRegisterContract:
+			_, err = msgServer.RegisterContract(suite.ctx, msg)
+		}
+		if tc.err != nil {
+			suite.Require().ErrorIs(err, tc.err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+
+	// queries the Turnstile registration of a contract
+	turnstileTokenId := func(contract common.Address) (uint64, bool) {
+		res, err := suite.app.CSRKeeper.CallMethod(suite.ctx, "isRegistered", contracts.TurnstileContract, csrtypes.ModuleAddress, &turnstileAddress, big.NewInt(0), contract)
+		suite.Require().NoError(err)
+		ret, err := contracts.TurnstileContract.ABI.Unpack("isRegistered", res.Ret)
+		suite.Require().NoError(err)
+		if !ret[0].(bool) {
+			return 0, false
+		}
+		res, err = suite.app.CSRKeeper.CallMethod(suite.ctx, "getTokenId", contracts.TurnstileContract, csrtypes.ModuleAddress, &turnstileAddress, big.NewInt(0), contract)
+		suite.Require().NoError(err)
+		ret, err = contracts.TurnstileContract.ABI.Unpack("getTokenId", res.Ret)
+		suite.Require().NoError(err)
+		return ret[0].(*big.Int).Uint64(), true
+	}
+
+	// the Turnstile registers every contract to the NFT of its CSR
+	csrs := suite.app.CSRKeeper.GetAllCSRs(suite.ctx)
+	suite.Require().Len(csrs, 4)
+	for _, csr := range csrs {
+		for _, contract := range csr.Contracts {
+			nftID, found := suite.app.CSRKeeper.GetNFTByContract(suite.ctx, contract)
+			suite.Require().True(found)
+			suite.Require().Equal(csr.Id, nftID)
+			tokenId, isRegistered := turnstileTokenId(common.HexToAddress(contract))
+			suite.Require().True(isRegistered)
+			suite.Require().Equal(csr.Id, tokenId)
+		}
+	}
+	nftID, found := suite.app.CSRKeeper.GetNFTByContract(suite.ctx, registered[2])
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(4), nftID)
+
+	// a contract assigned to the NFT merged away is unregistered, and can be assigned again
+	contract, err := suite.app.CSRKeeper.DeployTurnstile(suite.ctx)
+	suite.Require().NoError(err)
+	assign := func(nftID int64) {
+		res, err := suite.app.CSRKeeper.CallMethod(suite.ctx, "assign", contracts.TurnstileContract, contract, &turnstileAddress, big.NewInt(0), big.NewInt(nftID))
+		suite.Require().NoError(err)
+		err = suite.app.CSRKeeper.Hooks().PostTxProcessing(suite.ctx, ethtypes.NewMessage(contract, &turnstileAddress, 0, big.NewInt(0), 0, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, true), &ethtypes.Receipt{Logs: evmtypes.LogsToEthereum(res.Logs)})
+		suite.Require().NoError(err)
+	}
+	assign(1)
+	_, found = suite.app.CSRKeeper.GetNFTByContract(suite.ctx, contract.String())
+	suite.Require().False(found)
+	_, isRegistered := turnstileTokenId(contract)
+	suite.Require().False(isRegistered)
+	assign(0)
+	nftID, found = suite.app.CSRKeeper.GetNFTByContract(suite.ctx, contract.String())
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(0), nftID)
+	tokenId, isRegistered := turnstileTokenId(contract)
+	suite.Require().True(isRegistered)
+	suite.Require().Equal(uint64(0), tokenId)
+
+	// the revenue of the NFT merged away remains withdrawable
+	res, err := msgServer.WithdrawCSRRevenue(suite.ctx, csrtypes.NewMsgWithdrawCSRRevenue(owner.String(), 1, owner.String(), sdkmath.ZeroInt()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.NewInt(1000), res.Amount)
+}
+
+func (suite *KeeperTestSuite) TestMsgSetCSRShareOverride() {
+	suite.SetupTest()
+	suite.Commit()
+
+	csr := GenerateCSRs(1)[0]
+	suite.app.CSRKeeper.SetCSR(suite.ctx, csr)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	other := suite.CreateNewAccount(suite.ctx)
+	msgServer := keeper.NewMsgServerImpl(suite.app.CSRKeeper)
+	shares := sdkmath.LegacyNewDecWithPrec(50, 2)
+	invalidShares := sdkmath.LegacyNewDec(2)
+
+	testCases := []struct {
+		name        string
+		msg         *csrtypes.MsgSetCSRShareOverride
+		err         error
+		expOverride *sdkmath.LegacyDec
+	}{
+		{"not the governance authority", csrtypes.NewMsgSetCSRShareOverride(other.String(), csr.Id, &shares), govtypes.ErrInvalidSigner, nil},
+		{"unknown nft", csrtypes.NewMsgSetCSRShareOverride(authority, csr.Id+1, &shares), keeper.ErrNFTNotFound, nil},
+		{"shares over 100%", csrtypes.NewMsgSetCSRShareOverride(authority, csr.Id, &invalidShares), csrtypes.ErrInvalidParams, nil},
+		{"set the override", csrtypes.NewMsgSetCSRShareOverride(authority, csr.Id, &shares), nil, &shares},
+		{"clear the override", csrtypes.NewMsgSetCSRShareOverride(authority, csr.Id, nil), nil, nil},
+	}
+	for _, tc := range testCases {
+		_, err := msgServer.SetCSRShareOverride(suite.ctx, tc.msg)
+		if tc.err != nil {
+			suite.Require().ErrorIs(err, tc.err, tc.name)
+			continue
+		}
+		suite.Require().NoError(err, tc.name)
+
+		override, found := suite.app.CSRKeeper.GetCSRShareOverride(suite.ctx, csr.Id)
+		suite.Require().Equal(tc.expOverride != nil, found, tc.name)
+		if tc.expOverride != nil {
+			suite.Require().Equal(*tc.expOverride, override.CsrShares, tc.name)
+		}
+	}
+}
@@ -8,6 +8,10 @@ import (
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramstore.GetParamSet(ctx, &params)
+	// the param store decodes an empty list of share tiers as nil
+	if params.CsrShareTiers == nil {
+		params.CsrShareTiers = []types.CSRShareTier{}
+	}
 	return params
 }
 
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/Canto-Network/Canto/v8/x/csr/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Returns the csr fee of a CSR NFT the Turnstile failed to take in. If the NFT has
+// no pending fee, it will return (empty, false).
+func (k Keeper) GetPendingFee(ctx sdk.Context, nftId uint64) (types.CSRPendingFee, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixPendingFee)
+
+	bz := prefixStore.Get(sdk.Uint64ToBigEndian(nftId))
+	if len(bz) == 0 {
+		return types.CSRPendingFee{}, false
+	}
+
+	var pendingFee types.CSRPendingFee
+	k.cdc.MustUnmarshal(bz, &pendingFee)
+	return pendingFee, true
+}
+
+// Sets the pending fee of a CSR NFT, held by the module account until it is distributed.
+func (k Keeper) SetPendingFee(ctx sdk.Context, pendingFee types.CSRPendingFee) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixPendingFee)
+	prefixStore.Set(sdk.Uint64ToBigEndian(pendingFee.NftId), k.cdc.MustMarshal(&pendingFee))
+}
+
+// Removes the pending fee of a CSR NFT.
+func (k Keeper) DeletePendingFee(ctx sdk.Context, nftId uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixPendingFee)
+	prefixStore.Delete(sdk.Uint64ToBigEndian(nftId))
+}
+
+// Adds an amount to the pending fee of a CSR NFT.
+func (k Keeper) AddPendingFee(ctx sdk.Context, nftId uint64, amount sdkmath.Int) {
+	pendingFee, found := k.GetPendingFee(ctx, nftId)
+	if !found {
+		pendingFee = types.CSRPendingFee{NftId: nftId, Amount: sdkmath.ZeroInt()}
+	}
+	pendingFee.Amount = pendingFee.Amount.Add(amount)
+	k.SetPendingFee(ctx, pendingFee)
+}
+
+// Returns the pending fee of every CSR NFT, ordered by NFT ID.
+func (k Keeper) GetAllPendingFees(ctx sdk.Context) (pendingFees []types.CSRPendingFee) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iter := storetypes.KVStorePrefixIterator(store, types.KeyPrefixPendingFee)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var pendingFee types.CSRPendingFee
+		k.cdc.MustUnmarshal(iter.Value(), &pendingFee)
+		pendingFees = append(pendingFees, pendingFee)
+	}
+	return
+}
+
+// getPendingFeesFrom returns up to limit pending fees, starting from the NFT ID cursor
+// and wrapping around to the lowest NFT ID.
+func (k Keeper) getPendingFeesFrom(ctx sdk.Context, cursor uint64, limit int) (pendingFees []types.CSRPendingFee) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixPendingFee)
+	for _, bounds := range [][2][]byte{
+		{sdk.Uint64ToBigEndian(cursor), nil},
+		{nil, sdk.Uint64ToBigEndian(cursor)},
+	} {
+		iter := prefixStore.Iterator(bounds[0], bounds[1])
+		for ; iter.Valid() && len(pendingFees) < limit; iter.Next() {
+			var pendingFee types.CSRPendingFee
+			k.cdc.MustUnmarshal(iter.Value(), &pendingFee)
+			pendingFees = append(pendingFees, pendingFee)
+		}
+		iter.Close()
+	}
+	return
+}
+
+// getPendingFeeCursor gets the NFT ID the next block resumes retrying the pending fees from.
+func (k Keeper) getPendingFeeCursor(ctx sdk.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get(types.KeyPendingFeeCursor)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setPendingFeeCursor sets the NFT ID the next block resumes retrying the pending fees from.
+func (k Keeper) setPendingFeeCursor(ctx sdk.Context, cursor uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	store.Set(types.KeyPendingFeeCursor, sdk.Uint64ToBigEndian(cursor))
+}
+
+// DistributePendingFees retries the distribution of up to PendingFeeRetryBudget pending fees
+// to the Turnstile, resuming after the last NFT retried in the previous block. The fees
+// distributed are removed, while the ones the Turnstile still fails to take in stay pending
+// until their next retry.
+func (k Keeper) DistributePendingFees(ctx sdk.Context) {
+	budget := k.GetParams(ctx).PendingFeeRetryBudget
+	if budget == 0 {
+		return
+	}
+
+	if _, found := k.GetTurnstile(ctx); !found {
+		return
+	}
+
+	pendingFees := k.getPendingFeesFrom(ctx, k.getPendingFeeCursor(ctx), int(budget))
+	if len(pendingFees) == 0 {
+		return
+	}
+
+	for _, pendingFee := range pendingFees {
+		if err := k.DistributeCSRFee(ctx, pendingFee.NftId, pendingFee.Amount); err != nil {
+			k.Logger(ctx).Error("failed to distribute pending csr fee", "nft-id", pendingFee.NftId, "amount", pendingFee.Amount, "error", err)
+			continue
+		}
+		k.DeletePendingFee(ctx, pendingFee.NftId)
+	}
+	k.setPendingFeeCursor(ctx, pendingFees[len(pendingFees)-1].NftId+1)
+}
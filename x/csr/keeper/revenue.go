@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/Canto-Network/Canto/v8/x/csr/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Returns the revenue of a CSR NFT in the ongoing day epoch. If the NFT has not
+// earned anything yet during the epoch, an empty revenue is returned.
+func (k Keeper) GetCurrentCSRRevenue(ctx sdk.Context, nftId uint64) types.CSRRevenue {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixCurrentRevenue)
+
+	bz := prefixStore.Get(sdk.Uint64ToBigEndian(nftId))
+	if len(bz) == 0 {
+		return types.CSRRevenue{NftId: nftId, Revenue: sdkmath.ZeroInt()}
+	}
+
+	var revenue types.CSRRevenue
+	k.cdc.MustUnmarshal(bz, &revenue)
+	return revenue
+}
+
+// Sets the revenue of a CSR NFT in the ongoing day epoch.
+func (k Keeper) SetCurrentCSRRevenue(ctx sdk.Context, revenue types.CSRRevenue) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixCurrentRevenue)
+	prefixStore.Set(sdk.Uint64ToBigEndian(revenue.NftId), k.cdc.MustMarshal(&revenue))
+}
+
+// Returns the revenue of every CSR NFT in the ongoing day epoch.
+func (k Keeper) GetAllCurrentCSRRevenues(ctx sdk.Context) (revenues []types.CSRRevenue) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iter := storetypes.KVStorePrefixIterator(store, types.KeyPrefixCurrentRevenue)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var revenue types.CSRRevenue
+		k.cdc.MustUnmarshal(iter.Value(), &revenue)
+		revenues = append(revenues, revenue)
+	}
+	return
+}
+
+// Adds a transaction and the fees distributed for it to the revenue of a CSR NFT
+// in the ongoing day epoch.
+func (k Keeper) AddCSRRevenue(ctx sdk.Context, nftId uint64, amount sdkmath.Int) {
+	revenue := k.GetCurrentCSRRevenue(ctx, nftId)
+	revenue.Txs++
+	revenue.Revenue = revenue.Revenue.Add(amount)
+	k.SetCurrentCSRRevenue(ctx, revenue)
+}
+
+// Returns the revenue of a CSR NFT in a past day epoch. If the NFT did not earn
+// anything during the epoch, it will return (empty, false).
+func (k Keeper) GetCSRRevenue(ctx sdk.Context, nftId uint64, epochNumber int64) (types.CSRRevenue, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.GetRevenueHistoryKey(nftId, epochNumber))
+	if len(bz) == 0 {
+		return types.CSRRevenue{}, false
+	}
+
+	var revenue types.CSRRevenue
+	k.cdc.MustUnmarshal(bz, &revenue)
+	return revenue, true
+}
+
+// Stores the revenue of a CSR NFT in a past day epoch, indexed by both the NFT
+// and the epoch.
+func (k Keeper) SetCSRRevenue(ctx sdk.Context, revenue types.CSRRevenue) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.GetRevenueHistoryKey(revenue.NftId, revenue.EpochNumber), k.cdc.MustMarshal(&revenue))
+	store.Set(types.GetEpochRevenueKey(revenue.EpochNumber, revenue.NftId), []byte{})
+}
+
+// Returns the revenue of every CSR NFT in every past day epoch.
+func (k Keeper) GetAllCSRRevenueHistory(ctx sdk.Context) (revenues []types.CSRRevenue) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iter := storetypes.KVStorePrefixIterator(store, types.KeyPrefixRevenueHistory)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var revenue types.CSRRevenue
+		k.cdc.MustUnmarshal(iter.Value(), &revenue)
+		revenues = append(revenues, revenue)
+	}
+	return
+}
+
+// Returns the revenue of every CSR NFT that earned fees in a past day epoch,
+// ordered by NFT ID.
+func (k Keeper) GetEpochCSRRevenues(ctx sdk.Context, epochNumber int64) (revenues []types.CSRRevenue) {
+	epochPrefix := types.GetEpochRevenuePrefix(epochNumber)
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iter := storetypes.KVStorePrefixIterator(store, epochPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		nftId := sdk.BigEndianToUint64(iter.Key()[len(epochPrefix):])
+		if revenue, found := k.GetCSRRevenue(ctx, nftId, epochNumber); found {
+			revenues = append(revenues, revenue)
+		}
+	}
+	return
+}
+
+// PruneCSRRevenueHistory deletes the revenues of every CSR NFT in the day epochs
+// up to the given epoch number from the revenue history.
+func (k Keeper) PruneCSRRevenueHistory(ctx sdk.Context, epochNumber int64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iter := store.Iterator(types.KeyPrefixEpochRevenue, types.GetEpochRevenuePrefix(epochNumber+1))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		epoch := int64(sdk.BigEndianToUint64(key[len(types.KeyPrefixEpochRevenue) : len(types.KeyPrefixEpochRevenue)+8]))
+		nftId := sdk.BigEndianToUint64(key[len(types.KeyPrefixEpochRevenue)+8:])
+		store.Delete(types.GetRevenueHistoryKey(nftId, epoch))
+		store.Delete(key)
+	}
+}
+
+// RollUpCSRRevenues moves the revenue of every CSR NFT in the ongoing day epoch
+// into the revenue history under the number of the epoch that just ended.
+func (k Keeper) RollUpCSRRevenues(ctx sdk.Context, epochNumber int64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixCurrentRevenue)
+	for _, revenue := range k.GetAllCurrentCSRRevenues(ctx) {
+		prefixStore.Delete(sdk.Uint64ToBigEndian(revenue.NftId))
+		revenue.EpochNumber = epochNumber
+		k.SetCSRRevenue(ctx, revenue)
+	}
+}
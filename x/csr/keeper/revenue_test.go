@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	csrTypes "github.com/Canto-Network/Canto/v8/x/csr/types"
+	epochstypes "github.com/Canto-Network/Canto/v8/x/epochs/types"
+	"github.com/Canto-Network/Canto/v8/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestCSRRevenueHistory() {
+	suite.SetupTest()
+	suite.Commit()
+
+	// Send some initial funds to the fee module account
+	evmDenom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+	coins := sdk.Coins{{Denom: evmDenom, Amount: sdkmath.NewIntFromUint64(1000000000)}}
+	suite.app.BankKeeper.MintCoins(suite.ctx, csrTypes.ModuleName, coins)
+	suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, csrTypes.ModuleName, suite.app.CSRKeeper.FeeCollectorName, coins)
+
+	csrs := GenerateCSRs(3)
+	for _, csr := range csrs {
+		suite.app.CSRKeeper.SetCSR(suite.ctx, csr)
+	}
+
+	// a transaction to a contract of the NFT earns a csr fee of gasUsed * 100 * 0.2
+	sendTx := func(nft int, gasUsed uint64) {
+		to := common.HexToAddress(csrs[nft].Contracts[0])
+		msg := ethtypes.NewMessage(
+			types.ModuleAddress,
+			&to,
+			0,
+			big.NewInt(0),   // amount
+			uint64(0),       // gasLimit
+			big.NewInt(100), // gasPrice
+			big.NewInt(0),   // gasFeeCap
+			big.NewInt(0),   // gasTipCap
+			[]byte{},
+			ethtypes.AccessList{}, // AccessList
+			true,                  // checkNonce
+		)
+		err := suite.app.CSRKeeper.Hooks().PostTxProcessing(suite.ctx, msg, &ethtypes.Receipt{GasUsed: gasUsed})
+		suite.Require().NoError(err)
+	}
+
+	// epoch 1: the second NFT earns the most
+	sendTx(0, 100)
+	sendTx(0, 100)
+	sendTx(1, 500)
+	current := suite.app.CSRKeeper.GetCurrentCSRRevenue(suite.ctx, csrs[0].Id)
+	suite.Require().Equal(csrTypes.CSRRevenue{NftId: csrs[0].Id, Txs: 2, Revenue: sdkmath.NewInt(4000)}, current)
+
+	// only the day epoch rolls up the revenues
+	suite.app.CSRKeeper.EpochHooks().AfterEpochEnd(suite.ctx, epochstypes.WeekEpochID, 1)
+	suite.Require().Len(suite.app.CSRKeeper.GetAllCurrentCSRRevenues(suite.ctx), 2)
+	suite.app.CSRKeeper.EpochHooks().AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 1)
+	suite.Require().Empty(suite.app.CSRKeeper.GetAllCurrentCSRRevenues(suite.ctx))
+
+	// epoch 2: the first and third NFTs earn the same
+	sendTx(0, 300)
+	sendTx(2, 300)
+	suite.app.CSRKeeper.EpochHooks().AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 2)
+	// ongoing epoch
+	sendTx(0, 50)
+
+	// the lifetime counters keep accumulating
+	csr, _ := suite.app.CSRKeeper.GetCSR(suite.ctx, csrs[0].Id)
+	suite.Require().Equal(uint64(4), csr.Txs)
+	suite.Require().Equal(sdkmath.NewInt(11000), csr.Revenue)
+
+	historyRes, err := suite.app.CSRKeeper.CSRRevenueHistory(suite.ctx, &csrTypes.QueryCSRRevenueHistoryRequest{NftId: csrs[0].Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]csrTypes.CSRRevenue{
+		{NftId: csrs[0].Id, EpochNumber: 1, Txs: 2, Revenue: sdkmath.NewInt(4000)},
+		{NftId: csrs[0].Id, EpochNumber: 2, Txs: 1, Revenue: sdkmath.NewInt(6000)},
+	}, historyRes.Revenues)
+	suite.Require().Equal(csrTypes.CSRRevenue{NftId: csrs[0].Id, Txs: 1, Revenue: sdkmath.NewInt(1000)}, historyRes.Current)
+
+	historyRes, err = suite.app.CSRKeeper.CSRRevenueHistory(suite.ctx, &csrTypes.QueryCSRRevenueHistoryRequest{
+		NftId:      csrs[0].Id,
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(historyRes.Revenues, 1)
+	suite.Require().Equal(int64(2), historyRes.Revenues[0].EpochNumber)
+	suite.Require().Equal(uint64(2), historyRes.Pagination.Total)
+
+	_, err = suite.app.CSRKeeper.CSRRevenueHistory(suite.ctx, &csrTypes.QueryCSRRevenueHistoryRequest{NftId: 1000})
+	suite.Require().Error(err)
+
+	testCases := []struct {
+		name   string
+		epoch  int64
+		limit  uint32
+		expIDs []uint64
+		pass   bool
+	}{
+		{"invalid epoch", 0, 0, nil, false},
+		{"epoch without revenue", 3, 0, []uint64{}, true},
+		{"ordered by revenue", 1, 0, []uint64{csrs[1].Id, csrs[0].Id}, true},
+		{"ties ordered by nft id", 2, 0, []uint64{csrs[0].Id, csrs[2].Id}, true},
+		{"limited", 1, 1, []uint64{csrs[1].Id}, true},
+	}
+	for _, tc := range testCases {
+		res, err := suite.app.CSRKeeper.TopCSRs(suite.ctx, &csrTypes.QueryTopCSRsRequest{Epoch: tc.epoch, Limit: tc.limit})
+		if !tc.pass {
+			suite.Require().Error(err, tc.name)
+			continue
+		}
+		suite.Require().NoError(err, tc.name)
+		ids := []uint64{}
+		for _, revenue := range res.Revenues {
+			suite.Require().Equal(tc.epoch, revenue.EpochNumber, tc.name)
+			ids = append(ids, revenue.NftId)
+		}
+		suite.Require().Equal(tc.expIDs, ids, tc.name)
+	}
+}
+
+// The revenue history keeps the revenues of the last RevenueHistoryEpochs day epochs
+func (suite *KeeperTestSuite) TestCSRRevenueHistoryRetention() {
+	suite.SetupTest()
+
+	params := suite.app.CSRKeeper.GetParams(suite.ctx)
+	params.RevenueHistoryEpochs = 2
+	suite.app.CSRKeeper.SetParams(suite.ctx, params)
+
+	for epoch := int64(1); epoch <= 3; epoch++ {
+		suite.app.CSRKeeper.AddCSRRevenue(suite.ctx, 1, sdkmath.NewInt(epoch))
+		suite.app.CSRKeeper.AddCSRRevenue(suite.ctx, 2, sdkmath.NewInt(epoch))
+		suite.app.CSRKeeper.EpochHooks().AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, epoch)
+	}
+
+	// the first epoch is pruned once the third one ends
+	_, found := suite.app.CSRKeeper.GetCSRRevenue(suite.ctx, 1, 1)
+	suite.Require().False(found)
+	suite.Require().Empty(suite.app.CSRKeeper.GetEpochCSRRevenues(suite.ctx, 1))
+	for epoch := int64(2); epoch <= 3; epoch++ {
+		suite.Require().Len(suite.app.CSRKeeper.GetEpochCSRRevenues(suite.ctx, epoch), 2)
+	}
+	suite.Require().Len(suite.app.CSRKeeper.GetAllCSRRevenueHistory(suite.ctx), 4)
+
+	// the whole history is kept without retention
+	params.RevenueHistoryEpochs = 0
+	suite.app.CSRKeeper.SetParams(suite.ctx, params)
+	suite.app.CSRKeeper.AddCSRRevenue(suite.ctx, 1, sdkmath.NewInt(4))
+	suite.app.CSRKeeper.EpochHooks().AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 4)
+	suite.Require().Len(suite.app.CSRKeeper.GetAllCSRRevenueHistory(suite.ctx), 5)
+
+	// the number of CSR NFTs returned by TopCSRs is capped
+	for nftId := uint64(1); nftId <= 150; nftId++ {
+		suite.app.CSRKeeper.SetCSRRevenue(suite.ctx, csrTypes.CSRRevenue{NftId: nftId, EpochNumber: 5, Txs: 1, Revenue: sdkmath.NewIntFromUint64(nftId)})
+	}
+	res, err := suite.app.CSRKeeper.TopCSRs(suite.ctx, &csrTypes.QueryTopCSRsRequest{Epoch: 5, Limit: 1000})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Revenues, 100)
+	suite.Require().Equal(uint64(150), res.Revenues[0].NftId)
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/Canto-Network/Canto/v8/x/csr/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Returns the share of the transaction fees governance set for a CSR NFT. If no
+// override was set, it will return (empty, false).
+func (k Keeper) GetCSRShareOverride(ctx sdk.Context, nftId uint64) (types.CSRShareOverride, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixShareOverride)
+
+	bz := prefixStore.Get(sdk.Uint64ToBigEndian(nftId))
+	if len(bz) == 0 {
+		return types.CSRShareOverride{}, false
+	}
+
+	var override types.CSRShareOverride
+	k.cdc.MustUnmarshal(bz, &override)
+	return override, true
+}
+
+// Sets the share of the transaction fees distributed to a CSR NFT, taking
+// precedence over the csr shares and share tiers of the params.
+func (k Keeper) SetCSRShareOverride(ctx sdk.Context, override types.CSRShareOverride) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixShareOverride)
+	prefixStore.Set(sdk.Uint64ToBigEndian(override.NftId), k.cdc.MustMarshal(&override))
+}
+
+// Removes the share override of a CSR NFT.
+func (k Keeper) DeleteCSRShareOverride(ctx sdk.Context, nftId uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixShareOverride)
+	prefixStore.Delete(sdk.Uint64ToBigEndian(nftId))
+}
+
+// Returns every share override set by governance.
+func (k Keeper) GetAllCSRShareOverrides(ctx sdk.Context) (overrides []types.CSRShareOverride) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iter := storetypes.KVStorePrefixIterator(store, types.KeyPrefixShareOverride)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var override types.CSRShareOverride
+		k.cdc.MustUnmarshal(iter.Value(), &override)
+		overrides = append(overrides, override)
+	}
+	return
+}
+
+// Returns the share of the transaction fees distributed to a CSR NFT: the share
+// override set by governance if any, otherwise the share of the tier reached by
+// the revenue of the NFT in the ongoing day epoch.
+func (k Keeper) GetEffectiveCSRShares(ctx sdk.Context, params types.Params, nftId uint64) sdkmath.LegacyDec {
+	if override, found := k.GetCSRShareOverride(ctx, nftId); found {
+		return override.CsrShares
+	}
+	return params.GetCSRShares(k.GetCurrentCSRRevenue(ctx, nftId).Revenue)
+}
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/Canto-Network/Canto/v8/contracts"
+	"github.com/Canto-Network/Canto/v8/x/csr/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// queryTurnstile calls a view method of the Turnstile from the module account without
+// committing the call, and unpacks its single return value into out.
+func (k Keeper) queryTurnstile(ctx sdk.Context, method string, out interface{}, args ...interface{}) error {
+	turnstileAddress, found := k.GetTurnstile(ctx)
+	if !found {
+		return errorsmod.Wrapf(ErrContractDeployments, "EVM::queryTurnstile the turnstile has not been deployed")
+	}
+
+	data, err := TurnstileContract.Pack(method, args...)
+	if err != nil {
+		return errorsmod.Wrapf(ErrMethodCall, "EVM::queryTurnstile there was an issue packing the arguments into the method signature: %s", err.Error())
+	}
+
+	res, err := k.CallEVM(ctx, types.ModuleAddress, &turnstileAddress, big.NewInt(0), data, false)
+	if err != nil {
+		return errorsmod.Wrapf(ErrMethodCall, "EVM::queryTurnstile error applying message: %s", err.Error())
+	}
+
+	if err := TurnstileContract.UnpackIntoInterface(out, method, res.Ret); err != nil {
+		return errorsmod.Wrapf(ErrMethodCall, "EVM::queryTurnstile error unpacking %s: %s", method, err.Error())
+	}
+	return nil
+}
+
+// GetNFTOwner returns the owner of a CSR NFT as recorded by the Turnstile ownerOf method.
+// Returns an error if the NFT has not been minted.
+func (k Keeper) GetNFTOwner(ctx sdk.Context, nftId uint64) (common.Address, error) {
+	var owner common.Address
+	if err := k.queryTurnstile(ctx, "ownerOf", &owner, new(big.Int).SetUint64(nftId)); err != nil {
+		return common.Address{}, errorsmod.Wrapf(ErrNFTNotFound, "EVM::GetNFTOwner no owner found for NFT id %d: %s", nftId, err.Error())
+	}
+	return owner, nil
+}
+
+// GetNFTRevenueBalance returns the revenue accrued by a CSR NFT in the Turnstile that has
+// not been withdrawn yet.
+func (k Keeper) GetNFTRevenueBalance(ctx sdk.Context, nftId uint64) (*big.Int, error) {
+	balance := new(big.Int)
+	if err := k.queryTurnstile(ctx, "balances", &balance, new(big.Int).SetUint64(nftId)); err != nil {
+		return nil, err
+	}
+	return balance, nil
+}
+
+// WithdrawCSRRevenue withdraws an amount of the revenue accrued by a CSR NFT to the recipient,
+// capped to the revenue accrued, and returns the amount withdrawn. The Turnstile only lets the
+// owner of the NFT withdraw, so the withdrawal is made from the owner address, which must
+// have been authorized by the caller.
+func (k Keeper) WithdrawCSRRevenue(ctx sdk.Context, owner common.Address, nftId uint64, recipient common.Address, amount *big.Int) (*big.Int, error) {
+	turnstileAddress, found := k.GetTurnstile(ctx)
+	if !found {
+		return nil, errorsmod.Wrapf(ErrContractDeployments, "EVM::WithdrawCSRRevenue the turnstile has not been deployed")
+	}
+
+	res, err := k.CallMethod(ctx, "withdraw", contracts.TurnstileContract, owner, &turnstileAddress, big.NewInt(0), new(big.Int).SetUint64(nftId), recipient, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawn := new(big.Int)
+	if err := TurnstileContract.UnpackIntoInterface(&withdrawn, "withdraw", res.Ret); err != nil {
+		return nil, errorsmod.Wrapf(ErrMethodCall, "EVM::WithdrawCSRRevenue error unpacking withdraw: %s", err.Error())
+	}
+	return withdrawn, nil
+}
+
+// GetNFTCount returns the number of CSR NFTs minted by the Turnstile, whose ids run from zero,
+// as recorded by the Turnstile currentCounterId method.
+func (k Keeper) GetNFTCount(ctx sdk.Context) (uint64, error) {
+	count := new(big.Int)
+	if err := k.queryTurnstile(ctx, "currentCounterId", &count); err != nil {
+		return 0, err
+	}
+	return count.Uint64(), nil
+}
+
+// DistributeCSRFee sends a csr fee held by the module account to the Turnstile for a CSR NFT
+// with the distributeFees method. The call is made in a cached context, so that a failed
+// distribution leaves no state change behind.
+func (k Keeper) DistributeCSRFee(ctx sdk.Context, nftId uint64, amount sdkmath.Int) error {
+	turnstileAddress, found := k.GetTurnstile(ctx)
+	if !found {
+		return errorsmod.Wrapf(ErrContractDeployments, "EVM::DistributeCSRFee the turnstile has not been deployed")
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	_, err := k.CallMethod(cacheCtx, "distributeFees", contracts.TurnstileContract, types.ModuleAddress, &turnstileAddress, amount.BigInt(), new(big.Int).SetUint64(nftId))
+	if err != nil {
+		return err
+	}
+	writeCache()
+	return nil
+}
+
+// RegisterContract mints a new CSR NFT to the recipient for a deployed contract that was never
+// registered, and returns the id of the NFT. The Turnstile only lets a contract register itself,
+// so register is called from the contract address, and the Register event emitted is processed
+// like the ones of EVM transactions. The caller must have authorized the registration.
+func (k Keeper) RegisterContract(ctx sdk.Context, contract common.Address, recipient common.Address) (uint64, error) {
+	turnstileAddress, found := k.GetTurnstile(ctx)
+	if !found {
+		return 0, errorsmod.Wrapf(ErrContractDeployments, "EVM::RegisterContract the turnstile has not been deployed")
+	}
+
+	if err := k.ValidateContract(ctx, contract); err != nil {
+		return 0, err
+	}
+
+	res, err := k.CallMethod(ctx, "register", contracts.TurnstileContract, contract, &turnstileAddress, big.NewInt(0), recipient)
+	if err != nil {
+		return 0, err
+	}
+
+	registerID := TurnstileContract.Events[types.TurnstileEventRegister].ID
+	for _, log := range evmtypes.LogsToEthereum(res.Logs) {
+		if log.Address != turnstileAddress || len(log.Topics) == 0 || log.Topics[0] != registerID {
+			continue
+		}
+
+		var event types.RegisterCSREvent
+		if err := TurnstileContract.UnpackIntoInterface(&event, types.TurnstileEventRegister, log.Data); err != nil {
+			return 0, err
+		}
+		if err := k.RegisterEvent(ctx, log.Data); err != nil {
+			return 0, err
+		}
+		return event.TokenId.Uint64(), nil
+	}
+
+	return 0, errorsmod.Wrapf(ErrMethodCall, "EVM::RegisterContract the turnstile did not emit a register event")
+}
+
+// turnstileFeeRecipientSlot is the storage slot of the feeRecipient mapping of the Turnstile,
+// which follows the storage of Ownable, ERC721Enumerable and the token id counter.
+const turnstileFeeRecipientSlot = 12
+
+// turnstileFeeRecipientKey returns the storage key of the NftData of a contract in the
+// feeRecipient mapping of the Turnstile. The token id of the NFT is stored at the key, and
+// whether the contract is registered at the next key.
+func turnstileFeeRecipientKey(contract common.Address) common.Hash {
+	return crypto.Keccak256Hash(
+		common.LeftPadBytes(contract.Bytes(), common.HashLength),
+		common.BigToHash(big.NewInt(turnstileFeeRecipientSlot)).Bytes(),
+	)
+}
+
+// setTurnstileFeeRecipient registers a contract to a CSR NFT in the Turnstile storage, as the
+// Turnstile has no method moving a registered contract, so that getTokenId and isRegistered
+// reflect the reassignments and merges of the CSR store.
+func (k Keeper) setTurnstileFeeRecipient(ctx sdk.Context, contract common.Address, nftID uint64) error {
+	turnstileAddress, found := k.GetTurnstile(ctx)
+	if !found {
+		return errorsmod.Wrapf(ErrContractDeployments, "EVM::setTurnstileFeeRecipient the turnstile has not been deployed")
+	}
+
+	key := turnstileFeeRecipientKey(contract)
+	k.evmKeeper.SetState(ctx, turnstileAddress, key, common.BigToHash(new(big.Int).SetUint64(nftID)).Bytes())
+	k.evmKeeper.SetState(ctx, turnstileAddress, common.BigToHash(new(big.Int).Add(key.Big(), big.NewInt(1))), common.BigToHash(big.NewInt(1)).Bytes())
+	return nil
+}
+
+// deleteTurnstileFeeRecipient unregisters a contract in the Turnstile storage, as the Turnstile
+// has no method removing a registered contract, so that the contract can register or be assigned
+// to a CSR NFT again.
+func (k Keeper) deleteTurnstileFeeRecipient(ctx sdk.Context, contract common.Address) error {
+	turnstileAddress, found := k.GetTurnstile(ctx)
+	if !found {
+		return errorsmod.Wrapf(ErrContractDeployments, "EVM::deleteTurnstileFeeRecipient the turnstile has not been deployed")
+	}
+
+	key := turnstileFeeRecipientKey(contract)
+	k.evmKeeper.SetState(ctx, turnstileAddress, key, nil)
+	k.evmKeeper.SetState(ctx, turnstileAddress, common.BigToHash(new(big.Int).Add(key.Big(), big.NewInt(1))), nil)
+	return nil
+}
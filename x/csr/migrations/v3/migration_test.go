@@ -0,0 +1,49 @@
+package v3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/evmos/ethermint/encoding"
+
+	v3 "github.com/Canto-Network/Canto/v8/x/csr/migrations/v3"
+	csrtypes "github.com/Canto-Network/Canto/v8/x/csr/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	encCfg := encoding.MakeTestEncodingConfig()
+	csrKey := storetypes.NewKVStoreKey(csrtypes.StoreKey)
+	tCsrKey := storetypes.NewTransientStoreKey(fmt.Sprintf("%s_test", csrtypes.StoreKey))
+	ctx := testutil.DefaultContext(csrKey, tCsrKey)
+	paramstore := paramtypes.NewSubspace(
+		encCfg.Codec, encCfg.Amino, csrKey, tCsrKey, "csr",
+	)
+	paramstore = paramstore.WithKeyTable(csrtypes.ParamKeyTable())
+	require.True(t, paramstore.HasKeyTable())
+
+	// check no params
+	require.False(t, paramstore.Has(ctx, csrtypes.ParamStoreKeyMultiContractAttribution))
+
+	// Run migrations
+	err := v3.UpdateParams(ctx, &paramstore)
+	require.NoError(t, err)
+
+	// Make sure the params are set
+	require.True(t, paramstore.Has(ctx, csrtypes.ParamStoreKeyMultiContractAttribution))
+
+	var multiContractAttribution bool
+
+	// Make sure the new params are set
+	require.NotPanics(t, func() {
+		paramstore.Get(ctx, csrtypes.ParamStoreKeyMultiContractAttribution, &multiContractAttribution)
+	})
+
+	// check the params are updated
+	require.Equal(t, csrtypes.DefaultMultiContractAttribution, multiContractAttribution)
+}
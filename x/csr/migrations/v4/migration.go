@@ -0,0 +1,25 @@
+package v4
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/Canto-Network/Canto/v8/x/csr/types"
+)
+
+// UpdateParams sets the module parameters CSRShareTiers, CSRRevenueCap,
+// RobustFeeDistribution, RevenueHistoryEpochs and PendingFeeRetryBudget to their
+// default values.
+func UpdateParams(ctx sdk.Context, paramstore *paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		ps := paramstore.WithKeyTable(types.ParamKeyTable())
+		paramstore = &ps
+	}
+
+	paramstore.Set(ctx, types.ParamStoreKeyCSRShareTiers, types.DefaultCSRShareTiers)
+	paramstore.Set(ctx, types.ParamStoreKeyCSRRevenueCap, types.DefaultCSRRevenueCap)
+	paramstore.Set(ctx, types.ParamStoreKeyRobustFeeDistribution, types.DefaultRobustFeeDistribution)
+	paramstore.Set(ctx, types.ParamStoreKeyRevenueHistoryEpochs, types.DefaultRevenueHistoryEpochs)
+	paramstore.Set(ctx, types.ParamStoreKeyPendingFeeRetryBudget, types.DefaultPendingFeeRetryBudget)
+	return nil
+}
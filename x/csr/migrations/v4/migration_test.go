@@ -0,0 +1,72 @@
+package v4_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/evmos/ethermint/encoding"
+
+	v4 "github.com/Canto-Network/Canto/v8/x/csr/migrations/v4"
+	csrtypes "github.com/Canto-Network/Canto/v8/x/csr/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	encCfg := encoding.MakeTestEncodingConfig()
+	csrKey := storetypes.NewKVStoreKey(csrtypes.StoreKey)
+	tCsrKey := storetypes.NewTransientStoreKey(fmt.Sprintf("%s_test", csrtypes.StoreKey))
+	ctx := testutil.DefaultContext(csrKey, tCsrKey)
+	paramstore := paramtypes.NewSubspace(
+		encCfg.Codec, encCfg.Amino, csrKey, tCsrKey, "csr",
+	)
+	paramstore = paramstore.WithKeyTable(csrtypes.ParamKeyTable())
+	require.True(t, paramstore.HasKeyTable())
+
+	// check no params
+	require.False(t, paramstore.Has(ctx, csrtypes.ParamStoreKeyCSRShareTiers))
+	require.False(t, paramstore.Has(ctx, csrtypes.ParamStoreKeyCSRRevenueCap))
+	require.False(t, paramstore.Has(ctx, csrtypes.ParamStoreKeyRobustFeeDistribution))
+	require.False(t, paramstore.Has(ctx, csrtypes.ParamStoreKeyRevenueHistoryEpochs))
+	require.False(t, paramstore.Has(ctx, csrtypes.ParamStoreKeyPendingFeeRetryBudget))
+
+	// Run migrations
+	err := v4.UpdateParams(ctx, &paramstore)
+	require.NoError(t, err)
+
+	// Make sure the params are set
+	require.True(t, paramstore.Has(ctx, csrtypes.ParamStoreKeyCSRShareTiers))
+	require.True(t, paramstore.Has(ctx, csrtypes.ParamStoreKeyCSRRevenueCap))
+	require.True(t, paramstore.Has(ctx, csrtypes.ParamStoreKeyRobustFeeDistribution))
+	require.True(t, paramstore.Has(ctx, csrtypes.ParamStoreKeyRevenueHistoryEpochs))
+	require.True(t, paramstore.Has(ctx, csrtypes.ParamStoreKeyPendingFeeRetryBudget))
+
+	var (
+		csrShareTiers         []csrtypes.CSRShareTier
+		csrRevenueCap         sdkmath.Int
+		robustFeeDistribution bool
+		revenueHistoryEpochs  uint64
+		pendingFeeRetryBudget uint32
+	)
+
+	// Make sure the new params are set
+	require.NotPanics(t, func() {
+		paramstore.Get(ctx, csrtypes.ParamStoreKeyCSRShareTiers, &csrShareTiers)
+		paramstore.Get(ctx, csrtypes.ParamStoreKeyCSRRevenueCap, &csrRevenueCap)
+		paramstore.Get(ctx, csrtypes.ParamStoreKeyRobustFeeDistribution, &robustFeeDistribution)
+		paramstore.Get(ctx, csrtypes.ParamStoreKeyRevenueHistoryEpochs, &revenueHistoryEpochs)
+		paramstore.Get(ctx, csrtypes.ParamStoreKeyPendingFeeRetryBudget, &pendingFeeRetryBudget)
+	})
+
+	// check the params are updated
+	require.Empty(t, csrShareTiers)
+	require.Equal(t, csrtypes.DefaultCSRRevenueCap, csrRevenueCap)
+	require.Equal(t, csrtypes.DefaultRobustFeeDistribution, robustFeeDistribution)
+	require.Equal(t, csrtypes.DefaultRevenueHistoryEpochs, revenueHistoryEpochs)
+	require.Equal(t, csrtypes.DefaultPendingFeeRetryBudget, pendingFeeRetryBudget)
+}
@@ -16,8 +16,14 @@ import (
 
 // simulation parameter constants
 const (
-	enableCsr = "enable_csr"
-	csrShares = "csr_shares"
+	enableCsr                = "enable_csr"
+	csrShares                = "csr_shares"
+	multiContractAttribution = "multi_contract_attribution"
+	csrShareTiers            = "csr_share_tiers"
+	csrRevenueCap            = "csr_revenue_cap"
+	robustFeeDistribution    = "robust_fee_distribution"
+	revenueHistoryEpochs     = "revenue_history_epochs"
+	pendingFeeRetryBudget    = "pending_fee_retry_budget"
 )
 
 func generateRandomBool(r *rand.Rand) bool {
@@ -28,6 +34,33 @@ func generateRandomCsrShares(r *rand.Rand) sdkmath.LegacyDec {
 	return sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 100)), 2)
 }
 
+// generateRandomCsrShareTiers returns tiers of increasing thresholds whose shares decrease
+// from at most the csr shares
+func generateRandomCsrShareTiers(r *rand.Rand, csrShares sdkmath.LegacyDec) []types.CSRShareTier {
+	tiers := []types.CSRShareTier{}
+	threshold := sdkmath.ZeroInt()
+	shares := csrShares
+	numTiers := simtypes.RandIntBetween(r, 0, 4)
+	for i := 0; i < numTiers; i++ {
+		threshold = threshold.Add(sdkmath.NewInt(int64(simtypes.RandIntBetween(r, 1, 1_000_000))))
+		shares = shares.Mul(sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 101)), 2))
+		tiers = append(tiers, types.CSRShareTier{RevenueThreshold: threshold, CsrShares: shares})
+	}
+	return tiers
+}
+
+func generateRandomRevenueHistoryEpochs(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 0, 100))
+}
+
+func generateRandomPendingFeeRetryBudget(r *rand.Rand) uint32 {
+	return uint32(simtypes.RandIntBetween(r, 0, 200))
+}
+
+func generateRandomCsrRevenueCap(r *rand.Rand) sdkmath.Int {
+	return sdkmath.NewInt(int64(simtypes.RandIntBetween(r, 0, 10_000_000)))
+}
+
 // RandomizedGenState generates a random GenesisState for CSR.
 func RandomizedGenState(simState *module.SimulationState) {
 	genesis := types.DefaultGenesis()
@@ -42,6 +75,38 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { genesis.Params.CsrShares = generateRandomCsrShares(r) },
 	)
 
+	simState.AppParams.GetOrGenerate(
+		multiContractAttribution, &genesis.Params.MultiContractAttribution, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.MultiContractAttribution = generateRandomBool(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		csrShareTiers, &genesis.Params.CsrShareTiers, simState.Rand,
+		func(r *rand.Rand) {
+			genesis.Params.CsrShareTiers = generateRandomCsrShareTiers(r, genesis.Params.CsrShares)
+		},
+	)
+
+	simState.AppParams.GetOrGenerate(
+		csrRevenueCap, &genesis.Params.CsrRevenueCap, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.CsrRevenueCap = generateRandomCsrRevenueCap(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		robustFeeDistribution, &genesis.Params.RobustFeeDistribution, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.RobustFeeDistribution = generateRandomBool(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		revenueHistoryEpochs, &genesis.Params.RevenueHistoryEpochs, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.RevenueHistoryEpochs = generateRandomRevenueHistoryEpochs(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		pendingFeeRetryBudget, &genesis.Params.PendingFeeRetryBudget, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.PendingFeeRetryBudget = generateRandomPendingFeeRetryBudget(r) },
+	)
+
 	bz, _ := json.MarshalIndent(&genesis, "", " ")
 	fmt.Printf("Selected randomly generated csr parameters:\n%s\n", bz)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
@@ -36,6 +36,12 @@ func SimulateMsgUpdateParams(r *rand.Rand, _ sdk.Context, _ []simtypes.Account)
 	params := types.DefaultParams()
 	params.CsrShares = generateRandomCsrShares(r)
 	params.EnableCsr = generateRandomBool(r)
+	params.MultiContractAttribution = generateRandomBool(r)
+	params.CsrShareTiers = generateRandomCsrShareTiers(r, params.CsrShares)
+	params.CsrRevenueCap = generateRandomCsrRevenueCap(r)
+	params.RobustFeeDistribution = generateRandomBool(r)
+	params.RevenueHistoryEpochs = generateRandomRevenueHistoryEpochs(r)
+	params.PendingFeeRetryBudget = generateRandomPendingFeeRetryBudget(r)
 
 	return &types.MsgUpdateParams{
 		Authority: authority.String(),
@@ -23,6 +23,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgWithdrawCSRRevenue{},
+		&MsgRegisterContract{},
+		&MsgRemoveContract{},
+		&MsgReassignContract{},
+		&MsgMergeNFTs{},
+		&MsgSetCSRShareOverride{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -31,5 +37,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // register csr msg types for Amino Codec in adherence to EIP-712 signing conventions
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "canto/x/csr/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgWithdrawCSRRevenue{}, "canto/x/csr/MsgWithdrawCSRRevenue", nil)
+	cdc.RegisterConcrete(&MsgRegisterContract{}, "canto/x/csr/MsgRegisterContract", nil)
+	cdc.RegisterConcrete(&MsgRemoveContract{}, "canto/x/csr/MsgRemoveContract", nil)
+	cdc.RegisterConcrete(&MsgReassignContract{}, "canto/x/csr/MsgReassignContract", nil)
+	cdc.RegisterConcrete(&MsgMergeNFTs{}, "canto/x/csr/MsgMergeNFTs", nil)
+	cdc.RegisterConcrete(&MsgSetCSRShareOverride{}, "canto/x/csr/MsgSetCSRShareOverride", nil)
 	cdc.RegisterConcrete(&Params{}, "canto/x/csr/Params", nil)
 }
@@ -92,31 +92,208 @@ func (m *CSR) GetTxs() uint64 {
 	return 0
 }
 
+// CSRRevenue is the revenue earned by a CSR NFT during a day epoch
+type CSRRevenue struct {
+	// The NFT id which this revenue corresponds to
+	NftId uint64 `protobuf:"varint,1,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	// The number of the day epoch, zero while the epoch is ongoing
+	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// The number of transactions for this CSR NFT during the epoch
+	Txs uint64 `protobuf:"varint,3,opt,name=txs,proto3" json:"txs,omitempty"`
+	// The revenue for this CSR NFT during the epoch -> represented as a sdk.Int
+	Revenue cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=revenue,proto3,customtype=cosmossdk.io/math.Int" json:"revenue"`
+}
+
+func (m *CSRRevenue) Reset()         { *m = CSRRevenue{} }
+func (m *CSRRevenue) String() string { return proto.CompactTextString(m) }
+func (*CSRRevenue) ProtoMessage()    {}
+func (*CSRRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_57c53cea3d443afa, []int{1}
+}
+func (m *CSRRevenue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CSRRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CSRRevenue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CSRRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CSRRevenue.Merge(m, src)
+}
+func (m *CSRRevenue) XXX_Size() int {
+	return m.Size()
+}
+func (m *CSRRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_CSRRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CSRRevenue proto.InternalMessageInfo
+
+func (m *CSRRevenue) GetNftId() uint64 {
+	if m != nil {
+		return m.NftId
+	}
+	return 0
+}
+
+func (m *CSRRevenue) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *CSRRevenue) GetTxs() uint64 {
+	if m != nil {
+		return m.Txs
+	}
+	return 0
+}
+
+// CSRShareOverride is the share of the transaction fees distributed to a CSR
+// NFT set by governance, replacing the csr shares and tiers of the params
+type CSRShareOverride struct {
+	// The NFT id which this override corresponds to
+	NftId uint64 `protobuf:"varint,1,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	// decimal to determine the transaction fee split between network operators
+	// (validators) and CSR for the NFT
+	CsrShares cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=csr_shares,json=csrShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"csr_shares"`
+}
+
+func (m *CSRShareOverride) Reset()         { *m = CSRShareOverride{} }
+func (m *CSRShareOverride) String() string { return proto.CompactTextString(m) }
+func (*CSRShareOverride) ProtoMessage()    {}
+func (*CSRShareOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_57c53cea3d443afa, []int{2}
+}
+func (m *CSRShareOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CSRShareOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CSRShareOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CSRShareOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CSRShareOverride.Merge(m, src)
+}
+func (m *CSRShareOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *CSRShareOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_CSRShareOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CSRShareOverride proto.InternalMessageInfo
+
+func (m *CSRShareOverride) GetNftId() uint64 {
+	if m != nil {
+		return m.NftId
+	}
+	return 0
+}
+
+// CSRPendingFee is the csr fee of a CSR NFT the Turnstile failed to take in,
+// held by the module account until it is distributed
+type CSRPendingFee struct {
+	// The NFT id which this pending fee corresponds to
+	NftId uint64 `protobuf:"varint,1,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	// The amount of the pending fee
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *CSRPendingFee) Reset()         { *m = CSRPendingFee{} }
+func (m *CSRPendingFee) String() string { return proto.CompactTextString(m) }
+func (*CSRPendingFee) ProtoMessage()    {}
+func (*CSRPendingFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_57c53cea3d443afa, []int{3}
+}
+func (m *CSRPendingFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CSRPendingFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CSRPendingFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CSRPendingFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CSRPendingFee.Merge(m, src)
+}
+func (m *CSRPendingFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *CSRPendingFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_CSRPendingFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CSRPendingFee proto.InternalMessageInfo
+
+func (m *CSRPendingFee) GetNftId() uint64 {
+	if m != nil {
+		return m.NftId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*CSR)(nil), "canto.csr.v1.CSR")
+	proto.RegisterType((*CSRRevenue)(nil), "canto.csr.v1.CSRRevenue")
+	proto.RegisterType((*CSRShareOverride)(nil), "canto.csr.v1.CSRShareOverride")
+	proto.RegisterType((*CSRPendingFee)(nil), "canto.csr.v1.CSRPendingFee")
 }
 
 func init() { proto.RegisterFile("canto/csr/v1/csr.proto", fileDescriptor_57c53cea3d443afa) }
 
 var fileDescriptor_57c53cea3d443afa = []byte{
-	// 268 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0x4e, 0xcc, 0x2b,
-	0xc9, 0xd7, 0x4f, 0x2e, 0x2e, 0xd2, 0x2f, 0x33, 0x04, 0x51, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0x3c, 0x60, 0x71, 0x3d, 0x90, 0x40, 0x99, 0xa1, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x58,
-	0x42, 0x1f, 0xc4, 0x82, 0xa8, 0x91, 0x92, 0x4c, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0x8e, 0x87, 0x48,
-	0x40, 0x38, 0x10, 0x29, 0xa5, 0x1e, 0x46, 0x2e, 0x66, 0xe7, 0xe0, 0x20, 0x21, 0x19, 0x2e, 0xce,
-	0xe4, 0xfc, 0xbc, 0x92, 0xa2, 0xc4, 0xe4, 0x92, 0x62, 0x09, 0x46, 0x05, 0x66, 0x0d, 0xce, 0x20,
-	0x84, 0x80, 0x10, 0x1f, 0x17, 0x53, 0x66, 0x8a, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x4b, 0x10, 0x53,
-	0x66, 0x8a, 0x90, 0x00, 0x17, 0x73, 0x49, 0x45, 0xb1, 0x04, 0x33, 0x58, 0x00, 0xc4, 0x14, 0x72,
-	0xe5, 0x62, 0x2f, 0x4a, 0x2d, 0x4b, 0xcd, 0x2b, 0x4d, 0x95, 0x60, 0x51, 0x60, 0xd4, 0xe0, 0x74,
-	0xd2, 0x3e, 0x71, 0x4f, 0x9e, 0xe1, 0xd6, 0x3d, 0x79, 0x51, 0x88, 0x75, 0xc5, 0x29, 0xd9, 0x7a,
-	0x99, 0xf9, 0xfa, 0xb9, 0x89, 0x25, 0x19, 0x7a, 0x9e, 0x79, 0x25, 0x97, 0xb6, 0xe8, 0x72, 0x41,
-	0xdd, 0xe1, 0x99, 0x57, 0x12, 0x04, 0xd3, 0xeb, 0xe4, 0x7e, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47,
-	0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d,
-	0xc7, 0x72, 0x0c, 0x51, 0xba, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa,
-	0xce, 0x20, 0x2f, 0xeb, 0xfa, 0xa5, 0x96, 0x94, 0xe7, 0x17, 0x65, 0x43, 0x78, 0xfa, 0x65, 0x16,
-	0xfa, 0x15, 0xe0, 0xd0, 0x29, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x7b, 0xcf, 0x18, 0x10,
-	0x00, 0x00, 0xff, 0xff, 0xb6, 0xa7, 0x10, 0x34, 0x37, 0x01, 0x00, 0x00,
+	// 411 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xc1, 0xaa, 0xd3, 0x40,
+	0x14, 0x86, 0x33, 0xc9, 0xb5, 0x92, 0xe3, 0x55, 0x2e, 0xc1, 0x2b, 0xf1, 0x2a, 0xb9, 0xb5, 0xab,
+	0x82, 0x34, 0xa1, 0xb8, 0x71, 0xdd, 0x5c, 0x95, 0x82, 0xd4, 0x32, 0xd9, 0xb9, 0x09, 0xe9, 0x64,
+	0x9a, 0x84, 0x92, 0x99, 0x32, 0x33, 0x89, 0x2d, 0xbe, 0x82, 0x0b, 0xdf, 0xc0, 0x97, 0xf0, 0x21,
+	0xba, 0x2c, 0xae, 0xc4, 0x45, 0x91, 0xf6, 0x45, 0x24, 0x93, 0x8a, 0x82, 0x74, 0xe1, 0xe2, 0xae,
+	0xe6, 0x9c, 0xff, 0x9c, 0xc3, 0xf7, 0x0f, 0xfc, 0xf0, 0x88, 0x24, 0x4c, 0xf1, 0x80, 0x48, 0x11,
+	0xd4, 0xc3, 0xe6, 0xf1, 0x97, 0x82, 0x2b, 0xee, 0x9c, 0x6b, 0xdd, 0x6f, 0x84, 0x7a, 0x78, 0xf5,
+	0x30, 0xe3, 0x19, 0xd7, 0x83, 0xa0, 0xa9, 0xda, 0x9d, 0xab, 0xc7, 0x84, 0xcb, 0x92, 0xcb, 0xb8,
+	0x1d, 0xb4, 0x4d, 0x3b, 0xea, 0x7d, 0x42, 0x60, 0x85, 0x11, 0x76, 0x9e, 0x82, 0x4d, 0x38, 0x53,
+	0x22, 0x21, 0x4a, 0xba, 0xa8, 0x6b, 0xf5, 0x6d, 0xfc, 0x47, 0x70, 0x1e, 0x80, 0x59, 0xa4, 0xae,
+	0xd9, 0x45, 0xfd, 0x33, 0x6c, 0x16, 0xa9, 0x73, 0x01, 0x96, 0x5a, 0x49, 0xd7, 0xd2, 0x42, 0x53,
+	0x3a, 0xaf, 0xe0, 0xae, 0xa0, 0x35, 0x65, 0x15, 0x75, 0xcf, 0xba, 0xa8, 0x6f, 0x8f, 0x9e, 0x6f,
+	0x76, 0xd7, 0xc6, 0x8f, 0xdd, 0xf5, 0x65, 0x8b, 0x93, 0xe9, 0xc2, 0x2f, 0x78, 0x50, 0x26, 0x2a,
+	0xf7, 0xc7, 0x4c, 0x7d, 0xfb, 0x3a, 0x80, 0xa3, 0x8f, 0x31, 0x53, 0xf8, 0xf7, 0x6d, 0xef, 0x0b,
+	0x02, 0x08, 0x23, 0x8c, 0xdb, 0xd6, 0xb9, 0x84, 0x0e, 0x9b, 0xab, 0xb8, 0x48, 0x5d, 0xa4, 0x51,
+	0x77, 0xd8, 0x5c, 0x8d, 0x53, 0xe7, 0x19, 0x9c, 0xd3, 0x25, 0x27, 0x79, 0xcc, 0xaa, 0x72, 0x46,
+	0x85, 0x36, 0x66, 0xe1, 0x7b, 0x5a, 0x9b, 0x68, 0xe9, 0xf6, 0x1c, 0x7e, 0x84, 0x8b, 0x30, 0xc2,
+	0x51, 0x9e, 0x08, 0xfa, 0xae, 0xa6, 0x42, 0x14, 0xe9, 0x49, 0x9b, 0x53, 0x00, 0x22, 0x45, 0x2c,
+	0x9b, 0x5d, 0xa9, 0x4d, 0xda, 0xa3, 0xe1, 0x11, 0xfa, 0xe4, 0x5f, 0xe8, 0x5b, 0x9a, 0x25, 0x64,
+	0x7d, 0x43, 0xc9, 0x5f, 0xe8, 0x1b, 0x4a, 0xb0, 0x4d, 0xa4, 0xd0, 0x3c, 0xd9, 0x5b, 0xc0, 0xfd,
+	0x30, 0xc2, 0x53, 0xca, 0xd2, 0x82, 0x65, 0xaf, 0xe9, 0x49, 0x72, 0x08, 0x9d, 0xa4, 0xe4, 0x15,
+	0x53, 0xae, 0xf9, 0xff, 0x5f, 0x3d, 0x9e, 0x8e, 0xde, 0x6c, 0xf6, 0x1e, 0xda, 0xee, 0x3d, 0xf4,
+	0x73, 0xef, 0xa1, 0xcf, 0x07, 0xcf, 0xd8, 0x1e, 0x3c, 0xe3, 0xfb, 0xc1, 0x33, 0xde, 0x0f, 0xb2,
+	0x42, 0xe5, 0xd5, 0xcc, 0x27, 0xbc, 0x0c, 0xc2, 0x26, 0x7e, 0x83, 0x09, 0x55, 0x1f, 0xb8, 0x58,
+	0xb4, 0x5d, 0x50, 0xbf, 0x0c, 0x56, 0x3a, 0xa9, 0x6a, 0xbd, 0xa4, 0x72, 0xd6, 0xd1, 0x51, 0x7b,
+	0xf1, 0x6b, 0x00, 0x6d, 0x84, 0xb9, 0xc1, 0xc3, 0x02, 0x00, 0x00,
 }
 
 func (m *CSR) Marshal() (dAtA []byte, err error) {
@@ -171,6 +348,130 @@ func (m *CSR) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CSRRevenue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CSRRevenue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSRRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Revenue.Size()
+		i -= size
+		if _, err := m.Revenue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCsr(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Txs != 0 {
+		i = encodeVarintCsr(dAtA, i, uint64(m.Txs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintCsr(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NftId != 0 {
+		i = encodeVarintCsr(dAtA, i, uint64(m.NftId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CSRShareOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CSRShareOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSRShareOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CsrShares.Size()
+		i -= size
+		if _, err := m.CsrShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCsr(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.NftId != 0 {
+		i = encodeVarintCsr(dAtA, i, uint64(m.NftId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CSRPendingFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CSRPendingFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSRPendingFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCsr(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.NftId != 0 {
+		i = encodeVarintCsr(dAtA, i, uint64(m.NftId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCsr(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCsr(v)
 	base := offset
@@ -205,6 +506,54 @@ func (m *CSR) Size() (n int) {
 	return n
 }
 
+func (m *CSRRevenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NftId != 0 {
+		n += 1 + sovCsr(uint64(m.NftId))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovCsr(uint64(m.EpochNumber))
+	}
+	if m.Txs != 0 {
+		n += 1 + sovCsr(uint64(m.Txs))
+	}
+	l = m.Revenue.Size()
+	n += 1 + l + sovCsr(uint64(l))
+	return n
+}
+
+func (m *CSRShareOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NftId != 0 {
+		n += 1 + sovCsr(uint64(m.NftId))
+	}
+	l = m.CsrShares.Size()
+	n += 1 + l + sovCsr(uint64(l))
+	return n
+}
+
+func (m *CSRPendingFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NftId != 0 {
+		n += 1 + sovCsr(uint64(m.NftId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCsr(uint64(l))
+	return n
+}
+
 func sovCsr(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -365,6 +714,353 @@ func (m *CSR) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CSRRevenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCsr
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CSRRevenue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CSRRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			m.NftId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCsr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NftId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCsr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			m.Txs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCsr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Txs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCsr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCsr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCsr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Revenue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCsr(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCsr
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CSRShareOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCsr
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CSRShareOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CSRShareOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			m.NftId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCsr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NftId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CsrShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCsr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCsr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCsr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CsrShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCsr(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCsr
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CSRPendingFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCsr
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CSRPendingFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CSRPendingFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			m.NftId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCsr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NftId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCsr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCsr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCsr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCsr(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCsr
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCsr(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
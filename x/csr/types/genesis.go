@@ -1,11 +1,22 @@
 package types
 
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
 // DefaultIndex is the default capability global index
 const DefaultIndex uint64 = 1
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
-	return NewGenesisState(DefaultParams(), []CSR{}, "")
+	genesis := NewGenesisState(DefaultParams(), []CSR{}, "")
+	genesis.RevenueHistory = []CSRRevenue{}
+	genesis.CurrentRevenues = []CSRRevenue{}
+	genesis.ShareOverrides = []CSRShareOverride{}
+	genesis.PendingFees = []CSRPendingFee{}
+	return genesis
 }
 
 func NewGenesisState(params Params, csrs []CSR, turnstileAddress string) *GenesisState {
@@ -17,7 +28,97 @@ func NewGenesisState(params Params, csrs []CSR, turnstileAddress string) *Genesi
 }
 
 // By default, there should be no CSRs on genesis because the CSR turnstile and NFT smart contracts
-// have not been deployed yet. Checks if params are valid.
+// have not been deployed yet. Checks if params, the CSRs, the revenues, the share overrides and the
+// pending fees of the CSR NFTs are valid, and that no contract is registered to two NFTs.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if gs.TurnstileAddress != "" && !common.IsHexAddress(gs.TurnstileAddress) {
+		return fmt.Errorf("invalid turnstile address %s", gs.TurnstileAddress)
+	}
+
+	// each contract is indexed to a single NFT, so it cannot appear in two CSRs
+	seenCSRs := make(map[uint64]bool)
+	seenContracts := make(map[string]uint64)
+	for _, csr := range gs.Csrs {
+		if err := csr.Validate(); err != nil {
+			return err
+		}
+		if seenCSRs[csr.Id] {
+			return fmt.Errorf("duplicate csr of NFT %d", csr.Id)
+		}
+		seenCSRs[csr.Id] = true
+
+		for _, contract := range csr.Contracts {
+			if nftID, ok := seenContracts[contract]; ok {
+				return fmt.Errorf("contract %s is registered to both NFT %d and NFT %d", contract, nftID, csr.Id)
+			}
+			seenContracts[contract] = csr.Id
+		}
+	}
+
+	seenHistory := make(map[string]bool)
+	for _, revenue := range gs.RevenueHistory {
+		if revenue.EpochNumber <= 0 {
+			return fmt.Errorf("invalid epoch number %d in the revenue history of NFT %d", revenue.EpochNumber, revenue.NftId)
+		}
+		if err := validateCSRRevenue(revenue); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%d/%d", revenue.NftId, revenue.EpochNumber)
+		if seenHistory[key] {
+			return fmt.Errorf("duplicate revenue of NFT %d in epoch %d", revenue.NftId, revenue.EpochNumber)
+		}
+		seenHistory[key] = true
+	}
+
+	seenCurrent := make(map[uint64]bool)
+	for _, revenue := range gs.CurrentRevenues {
+		if revenue.EpochNumber != 0 {
+			return fmt.Errorf("epoch number of the current revenue of NFT %d must be zero, got %d", revenue.NftId, revenue.EpochNumber)
+		}
+		if err := validateCSRRevenue(revenue); err != nil {
+			return err
+		}
+		if seenCurrent[revenue.NftId] {
+			return fmt.Errorf("duplicate current revenue of NFT %d", revenue.NftId)
+		}
+		seenCurrent[revenue.NftId] = true
+	}
+
+	seenOverrides := make(map[uint64]bool)
+	for _, override := range gs.ShareOverrides {
+		if !seenCSRs[override.NftId] {
+			return fmt.Errorf("share override of NFT %d without csr", override.NftId)
+		}
+		if err := ValidateShares(override.CsrShares); err != nil {
+			return fmt.Errorf("invalid share override of NFT %d: %w", override.NftId, err)
+		}
+		if seenOverrides[override.NftId] {
+			return fmt.Errorf("duplicate share override of NFT %d", override.NftId)
+		}
+		seenOverrides[override.NftId] = true
+	}
+
+	seenPendingFees := make(map[uint64]bool)
+	for _, pendingFee := range gs.PendingFees {
+		if pendingFee.Amount.IsNil() || !pendingFee.Amount.IsPositive() {
+			return fmt.Errorf("invalid pending fee %s of NFT %d", pendingFee.Amount, pendingFee.NftId)
+		}
+		if seenPendingFees[pendingFee.NftId] {
+			return fmt.Errorf("duplicate pending fee of NFT %d", pendingFee.NftId)
+		}
+		seenPendingFees[pendingFee.NftId] = true
+	}
+
+	return nil
+}
+
+func validateCSRRevenue(revenue CSRRevenue) error {
+	if revenue.Revenue.IsNil() || revenue.Revenue.IsNegative() {
+		return fmt.Errorf("invalid revenue %s of NFT %d", revenue.Revenue, revenue.NftId)
+	}
+	return nil
 }
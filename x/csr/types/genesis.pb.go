@@ -29,6 +29,14 @@ type GenesisState struct {
 	Params           Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	Csrs             []CSR  `protobuf:"bytes,2,rep,name=csrs,proto3" json:"csrs"`
 	TurnstileAddress string `protobuf:"bytes,3,opt,name=turnstile_address,json=turnstileAddress,proto3" json:"turnstile_address,omitempty"`
+	// revenue_history is the revenue of every CSR NFT in past day epochs
+	RevenueHistory []CSRRevenue `protobuf:"bytes,4,rep,name=revenue_history,json=revenueHistory,proto3" json:"revenue_history"`
+	// current_revenues is the revenue of every CSR NFT in the ongoing day epoch
+	CurrentRevenues []CSRRevenue `protobuf:"bytes,5,rep,name=current_revenues,json=currentRevenues,proto3" json:"current_revenues"`
+	// share_overrides is the csr shares set by governance for CSR NFTs
+	ShareOverrides []CSRShareOverride `protobuf:"bytes,6,rep,name=share_overrides,json=shareOverrides,proto3" json:"share_overrides"`
+	// pending_fees is the csr fees not yet distributed to the Turnstile
+	PendingFees []CSRPendingFee `protobuf:"bytes,7,rep,name=pending_fees,json=pendingFees,proto3" json:"pending_fees"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -85,6 +93,34 @@ func (m *GenesisState) GetTurnstileAddress() string {
 	return ""
 }
 
+func (m *GenesisState) GetRevenueHistory() []CSRRevenue {
+	if m != nil {
+		return m.RevenueHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCurrentRevenues() []CSRRevenue {
+	if m != nil {
+		return m.CurrentRevenues
+	}
+	return nil
+}
+
+func (m *GenesisState) GetShareOverrides() []CSRShareOverride {
+	if m != nil {
+		return m.ShareOverrides
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingFees() []CSRPendingFee {
+	if m != nil {
+		return m.PendingFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "canto.csr.v1.GenesisState")
 }
@@ -92,24 +128,31 @@ func init() {
 func init() { proto.RegisterFile("canto/csr/v1/genesis.proto", fileDescriptor_4c1065f59845b427) }
 
 var fileDescriptor_4c1065f59845b427 = []byte{
-	// 268 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0x4e, 0xcc, 0x2b,
-	0xc9, 0xd7, 0x4f, 0x2e, 0x2e, 0xd2, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x01, 0xcb, 0xe9, 0x25, 0x17, 0x17, 0xe9, 0x95,
-	0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x25, 0xf4, 0x41, 0x2c, 0x88, 0x1a, 0x29, 0x49,
-	0x14, 0xfd, 0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0xed, 0x52, 0x62, 0x28, 0x52, 0x20, 0x53, 0xc0,
-	0xe2, 0x4a, 0x73, 0x18, 0xb9, 0x78, 0xdc, 0x21, 0x16, 0x05, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x19,
-	0x71, 0xb1, 0x41, 0x34, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x89, 0xe8, 0x21, 0x5b, 0xac,
-	0x17, 0x00, 0x96, 0x73, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0x52, 0x48, 0x9b, 0x8b,
-	0x25, 0xb9, 0xb8, 0xa8, 0x58, 0x82, 0x49, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x10, 0x55, 0x87, 0x73,
-	0x70, 0x10, 0x54, 0x39, 0x58, 0x91, 0x90, 0x36, 0x97, 0x60, 0x49, 0x69, 0x51, 0x5e, 0x71, 0x49,
-	0x66, 0x4e, 0x6a, 0x7c, 0x62, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0xb1, 0x04, 0xb3, 0x02, 0xa3, 0x06,
-	0x67, 0x90, 0x00, 0x5c, 0xc2, 0x11, 0x22, 0xee, 0xe4, 0x7e, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47,
-	0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d,
-	0xc7, 0x72, 0x0c, 0x51, 0xba, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa,
-	0xce, 0x20, 0xfb, 0x74, 0xfd, 0x52, 0x4b, 0xca, 0xf3, 0x8b, 0xb2, 0x21, 0x3c, 0xfd, 0x32, 0x0b,
-	0xfd, 0x0a, 0xb0, 0x77, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xde, 0x35, 0x06, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x71, 0x8d, 0xe3, 0x00, 0x63, 0x01, 0x00, 0x00,
+	// 382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0xae, 0xd2, 0x40,
+	0x14, 0xc6, 0x5b, 0x41, 0x8c, 0x03, 0x91, 0x7b, 0x27, 0x37, 0x66, 0xac, 0x49, 0x25, 0xae, 0x48,
+	0x08, 0x6d, 0xc0, 0x8d, 0x5b, 0xc1, 0x88, 0x2e, 0x54, 0x52, 0x76, 0x6e, 0x9a, 0xd2, 0x1e, 0x4b,
+	0xa3, 0x74, 0x9a, 0x39, 0xd3, 0x2a, 0x6f, 0xe1, 0x4b, 0x99, 0xb0, 0x64, 0xe9, 0xca, 0x18, 0x78,
+	0x11, 0xd3, 0x99, 0x81, 0x40, 0xd8, 0xdc, 0x5d, 0xfb, 0xfd, 0xf9, 0x7d, 0xb3, 0x38, 0xc4, 0x89,
+	0xa3, 0x5c, 0x72, 0x3f, 0x46, 0xe1, 0x57, 0x23, 0x3f, 0x85, 0x1c, 0x30, 0x43, 0xaf, 0x10, 0x5c,
+	0x72, 0xda, 0x51, 0x9e, 0x17, 0xa3, 0xf0, 0xaa, 0x91, 0x73, 0x97, 0xf2, 0x94, 0x2b, 0xc3, 0xaf,
+	0xbf, 0x74, 0xc6, 0x79, 0x76, 0xd1, 0x2f, 0x22, 0x11, 0xad, 0x4d, 0xdd, 0x79, 0x7a, 0x61, 0xd5,
+	0x14, 0xa5, 0xbf, 0xfc, 0xdd, 0x20, 0x9d, 0x99, 0x1e, 0x5a, 0xc8, 0x48, 0x02, 0x1d, 0x93, 0x96,
+	0x2e, 0x32, 0xbb, 0x67, 0xf7, 0xdb, 0xe3, 0x3b, 0xef, 0x7c, 0xd8, 0x9b, 0x2b, 0x6f, 0xd2, 0xdc,
+	0xfe, 0x7d, 0x61, 0x05, 0x26, 0x49, 0x07, 0xa4, 0x19, 0xa3, 0x40, 0xf6, 0xa0, 0xd7, 0xe8, 0xb7,
+	0xc7, 0xb7, 0x97, 0x8d, 0xe9, 0x22, 0x30, 0x71, 0x15, 0xa2, 0x03, 0x72, 0x2b, 0x4b, 0x91, 0xa3,
+	0xcc, 0xbe, 0x43, 0x18, 0x25, 0x89, 0x00, 0x44, 0xd6, 0xe8, 0xd9, 0xfd, 0xc7, 0xc1, 0xcd, 0xc9,
+	0x78, 0xa3, 0x75, 0x3a, 0x23, 0x5d, 0x01, 0x15, 0xe4, 0x25, 0x84, 0xab, 0x0c, 0x25, 0x17, 0x1b,
+	0xd6, 0x54, 0x23, 0xec, 0x6a, 0x24, 0xd0, 0x39, 0xb3, 0xf5, 0xc4, 0xd4, 0xde, 0xeb, 0x16, 0xfd,
+	0x40, 0x6e, 0xe2, 0x52, 0x08, 0xc8, 0x65, 0x68, 0x1c, 0x64, 0x0f, 0xef, 0x45, 0xea, 0x9a, 0x9e,
+	0x51, 0x91, 0x7e, 0x24, 0x5d, 0x5c, 0x45, 0x02, 0x42, 0x5e, 0x81, 0x10, 0x59, 0x02, 0xc8, 0x5a,
+	0x8a, 0xe4, 0x5e, 0x91, 0x16, 0x75, 0xee, 0xb3, 0x89, 0x1d, 0x5f, 0x86, 0xe7, 0x22, 0xd2, 0xb7,
+	0xa4, 0x53, 0x40, 0x9e, 0x64, 0x79, 0x1a, 0x7e, 0x05, 0x40, 0xf6, 0x48, 0xb1, 0x9e, 0x5f, 0xb1,
+	0xe6, 0x3a, 0xf4, 0x0e, 0x8e, 0xa0, 0x76, 0x71, 0x52, 0x70, 0x32, 0xdb, 0xee, 0x5d, 0x7b, 0xb7,
+	0x77, 0xed, 0x7f, 0x7b, 0xd7, 0xfe, 0x75, 0x70, 0xad, 0xdd, 0xc1, 0xb5, 0xfe, 0x1c, 0x5c, 0xeb,
+	0xcb, 0x30, 0xcd, 0xe4, 0xaa, 0x5c, 0x7a, 0x31, 0x5f, 0xfb, 0xd3, 0x9a, 0x39, 0xfc, 0x04, 0xf2,
+	0x07, 0x17, 0xdf, 0xf4, 0x9f, 0x5f, 0xbd, 0xf6, 0x7f, 0xaa, 0xbb, 0x90, 0x9b, 0x02, 0x70, 0xd9,
+	0x52, 0x77, 0xf1, 0xea, 0xff, 0x00, 0x9f, 0x8c, 0x9a, 0x66, 0x8c, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -132,6 +175,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingFees) > 0 {
+		for iNdEx := len(m.PendingFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ShareOverrides) > 0 {
+		for iNdEx := len(m.ShareOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShareOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CurrentRevenues) > 0 {
+		for iNdEx := len(m.CurrentRevenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentRevenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RevenueHistory) > 0 {
+		for iNdEx := len(m.RevenueHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RevenueHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.TurnstileAddress) > 0 {
 		i -= len(m.TurnstileAddress)
 		copy(dAtA[i:], m.TurnstileAddress)
@@ -195,6 +294,30 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.RevenueHistory) > 0 {
+		for _, e := range m.RevenueHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CurrentRevenues) > 0 {
+		for _, e := range m.CurrentRevenues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ShareOverrides) > 0 {
+		for _, e := range m.ShareOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingFees) > 0 {
+		for _, e := range m.PendingFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -332,6 +455,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.TurnstileAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevenueHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevenueHistory = append(m.RevenueHistory, CSRRevenue{})
+			if err := m.RevenueHistory[len(m.RevenueHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRevenues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentRevenues = append(m.CurrentRevenues, CSRRevenue{})
+			if err := m.CurrentRevenues[len(m.CurrentRevenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareOverrides = append(m.ShareOverrides, CSRShareOverride{})
+			if err := m.ShareOverrides[len(m.ShareOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingFees = append(m.PendingFees, CSRPendingFee{})
+			if err := m.PendingFees[len(m.PendingFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
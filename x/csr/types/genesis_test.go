@@ -3,6 +3,8 @@ package types_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/Canto-Network/Canto/v8/x/csr/types"
 	"github.com/stretchr/testify/suite"
 )
@@ -10,6 +12,7 @@ import (
 type GensisStateSuite struct {
 	suite.Suite
 	params types.Params
+	csrs   []types.CSR
 }
 
 func TestGenesisStateSuite(t *testing.T) {
@@ -18,6 +21,10 @@ func TestGenesisStateSuite(t *testing.T) {
 
 func (suite *GensisStateSuite) SetupTest() {
 	suite.params = types.DefaultParams()
+	suite.csrs = []types.CSR{
+		types.NewCSR([]string{"0x0000000000000000000000000000000000000001"}, 1),
+		types.NewCSR([]string{"0x0000000000000000000000000000000000000002"}, 2),
+	}
 }
 
 // Test all of the genesis states, when empty and when not
@@ -32,6 +39,148 @@ func (suite *GensisStateSuite) TestGenesisStateValidate() {
 			genState: types.DefaultGenesis(),
 			valid:    true,
 		},
+		{
+			desc: "Revenues of the CSR NFTs are valid - pass",
+			genState: &types.GenesisState{
+				Params:          suite.params,
+				RevenueHistory:  []types.CSRRevenue{{NftId: 1, EpochNumber: 1, Txs: 1, Revenue: sdkmath.NewInt(100)}, {NftId: 1, EpochNumber: 2, Revenue: sdkmath.ZeroInt()}},
+				CurrentRevenues: []types.CSRRevenue{{NftId: 1, Txs: 1, Revenue: sdkmath.NewInt(100)}},
+			},
+			valid: true,
+		},
+		{
+			desc: "Revenue history without an epoch number - fail",
+			genState: &types.GenesisState{
+				Params:         suite.params,
+				RevenueHistory: []types.CSRRevenue{{NftId: 1, Revenue: sdkmath.NewInt(100)}},
+			},
+			valid: false,
+		},
+		{
+			desc: "Duplicate revenue history - fail",
+			genState: &types.GenesisState{
+				Params:         suite.params,
+				RevenueHistory: []types.CSRRevenue{{NftId: 1, EpochNumber: 1, Revenue: sdkmath.NewInt(100)}, {NftId: 1, EpochNumber: 1, Revenue: sdkmath.NewInt(100)}},
+			},
+			valid: false,
+		},
+		{
+			desc: "Current revenue with an epoch number - fail",
+			genState: &types.GenesisState{
+				Params:          suite.params,
+				CurrentRevenues: []types.CSRRevenue{{NftId: 1, EpochNumber: 1, Revenue: sdkmath.NewInt(100)}},
+			},
+			valid: false,
+		},
+		{
+			desc: "Negative current revenue - fail",
+			genState: &types.GenesisState{
+				Params:          suite.params,
+				CurrentRevenues: []types.CSRRevenue{{NftId: 1, Revenue: sdkmath.NewInt(-1)}},
+			},
+			valid: false,
+		},
+		{
+			desc: "Share overrides of the CSR NFTs are valid - pass",
+			genState: &types.GenesisState{
+				Params:         suite.params,
+				Csrs:           suite.csrs,
+				ShareOverrides: []types.CSRShareOverride{{NftId: 1, CsrShares: sdkmath.LegacyZeroDec()}, {NftId: 2, CsrShares: sdkmath.LegacyOneDec()}},
+			},
+			valid: true,
+		},
+		{
+			desc: "Share override over 100% - fail",
+			genState: &types.GenesisState{
+				Params:         suite.params,
+				Csrs:           suite.csrs,
+				ShareOverrides: []types.CSRShareOverride{{NftId: 1, CsrShares: sdkmath.LegacyNewDec(2)}},
+			},
+			valid: false,
+		},
+		{
+			desc: "Duplicate share override - fail",
+			genState: &types.GenesisState{
+				Params:         suite.params,
+				Csrs:           suite.csrs,
+				ShareOverrides: []types.CSRShareOverride{{NftId: 1, CsrShares: sdkmath.LegacyOneDec()}, {NftId: 1, CsrShares: sdkmath.LegacyZeroDec()}},
+			},
+			valid: false,
+		},
+		{
+			desc: "Share override of an NFT without csr - fail",
+			genState: &types.GenesisState{
+				Params:         suite.params,
+				Csrs:           suite.csrs,
+				ShareOverrides: []types.CSRShareOverride{{NftId: 3, CsrShares: sdkmath.LegacyOneDec()}},
+			},
+			valid: false,
+		},
+		{
+			desc: "CSRs registering distinct contracts - pass",
+			genState: &types.GenesisState{
+				Params:           suite.params,
+				Csrs:             suite.csrs,
+				TurnstileAddress: "0x0000000000000000000000000000000000000003",
+			},
+			valid: true,
+		},
+		{
+			desc: "Invalid turnstile address - fail",
+			genState: &types.GenesisState{
+				Params:           suite.params,
+				TurnstileAddress: "turnstile",
+			},
+			valid: false,
+		},
+		{
+			desc: "CSR without contracts - fail",
+			genState: &types.GenesisState{
+				Params: suite.params,
+				Csrs:   []types.CSR{types.NewCSR([]string{}, 1)},
+			},
+			valid: false,
+		},
+		{
+			desc: "Duplicate CSR - fail",
+			genState: &types.GenesisState{
+				Params: suite.params,
+				Csrs:   []types.CSR{suite.csrs[0], types.NewCSR([]string{"0x0000000000000000000000000000000000000003"}, 1)},
+			},
+			valid: false,
+		},
+		{
+			desc: "Contract registered to two CSRs - fail",
+			genState: &types.GenesisState{
+				Params: suite.params,
+				Csrs:   []types.CSR{suite.csrs[0], types.NewCSR([]string{suite.csrs[0].Contracts[0]}, 2)},
+			},
+			valid: false,
+		},
+		{
+			desc: "Pending fees of the CSR NFTs are valid - pass",
+			genState: &types.GenesisState{
+				Params:      suite.params,
+				PendingFees: []types.CSRPendingFee{{NftId: 1, Amount: sdkmath.NewInt(100)}, {NftId: 2, Amount: sdkmath.NewInt(1)}},
+			},
+			valid: true,
+		},
+		{
+			desc: "Zero pending fee - fail",
+			genState: &types.GenesisState{
+				Params:      suite.params,
+				PendingFees: []types.CSRPendingFee{{NftId: 1, Amount: sdkmath.ZeroInt()}},
+			},
+			valid: false,
+		},
+		{
+			desc: "Duplicate pending fee - fail",
+			genState: &types.GenesisState{
+				Params:      suite.params,
+				PendingFees: []types.CSRPendingFee{{NftId: 1, Amount: sdkmath.NewInt(100)}, {NftId: 1, Amount: sdkmath.NewInt(100)}},
+			},
+			valid: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -30,4 +30,6 @@ type EVMKeeper interface {
 	EstimateGas(c context.Context, req *evmtypes.EthCallRequest) (*evmtypes.EstimateGasResponse, error)
 	GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account
 	GetParams(ctx sdk.Context) evmtypes.Params
+	GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash
+	SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte)
 }
@@ -1,6 +1,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -33,6 +34,18 @@ const (
 	prefixContract
 	// prefix address of the Turnstile smart contract
 	prefixAddrs
+	// nft id -> revenue in the ongoing day epoch
+	prefixCurrentRevenue
+	// nft id | epoch number -> revenue in a past day epoch
+	prefixRevenueHistory
+	// epoch number | nft id -> nil
+	prefixEpochRevenue
+	// nft id -> csr share override
+	prefixShareOverride
+	// nft id -> pending csr fee
+	prefixPendingFee
+	// nft id the end blocker resumes retrying the pending fees from
+	prefixPendingFeeCursor
 )
 
 // KVStore key prefixes
@@ -40,4 +53,32 @@ var (
 	KeyPrefixCSR      = []byte{prefixCSR}
 	KeyPrefixContract = []byte{prefixContract}
 	KeyPrefixAddrs    = []byte{prefixAddrs}
+
+	KeyPrefixCurrentRevenue = []byte{prefixCurrentRevenue}
+	KeyPrefixRevenueHistory = []byte{prefixRevenueHistory}
+	KeyPrefixEpochRevenue   = []byte{prefixEpochRevenue}
+
+	KeyPrefixShareOverride = []byte{prefixShareOverride}
+	KeyPrefixPendingFee    = []byte{prefixPendingFee}
+	KeyPendingFeeCursor    = []byte{prefixPendingFeeCursor}
 )
+
+// GetRevenueHistoryPrefix returns the prefix of the revenue history of a CSR NFT
+func GetRevenueHistoryPrefix(nftID uint64) []byte {
+	return append(KeyPrefixRevenueHistory, sdk.Uint64ToBigEndian(nftID)...)
+}
+
+// GetRevenueHistoryKey returns the key of the revenue of a CSR NFT in a day epoch
+func GetRevenueHistoryKey(nftID uint64, epochNumber int64) []byte {
+	return append(GetRevenueHistoryPrefix(nftID), sdk.Uint64ToBigEndian(uint64(epochNumber))...)
+}
+
+// GetEpochRevenuePrefix returns the prefix of the CSR NFTs that earned revenue in a day epoch
+func GetEpochRevenuePrefix(epochNumber int64) []byte {
+	return append(KeyPrefixEpochRevenue, sdk.Uint64ToBigEndian(uint64(epochNumber))...)
+}
+
+// GetEpochRevenueKey returns the key indexing the revenue of a CSR NFT by day epoch
+func GetEpochRevenueKey(epochNumber int64, nftID uint64) []byte {
+	return append(GetEpochRevenuePrefix(epochNumber), sdk.Uint64ToBigEndian(nftID)...)
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgWithdrawCSRRevenue{}
+	_ sdk.Msg = &MsgRegisterContract{}
+	_ sdk.Msg = &MsgRemoveContract{}
+	_ sdk.Msg = &MsgReassignContract{}
+	_ sdk.Msg = &MsgMergeNFTs{}
+	_ sdk.Msg = &MsgSetCSRShareOverride{}
+)
+
+// NewMsgWithdrawCSRRevenue creates a new MsgWithdrawCSRRevenue object. A zero amount
+// withdraws the whole revenue accrued by the NFT.
+func NewMsgWithdrawCSRRevenue(owner string, nftId uint64, recipient string, amount sdkmath.Int) *MsgWithdrawCSRRevenue {
+	return &MsgWithdrawCSRRevenue{
+		Owner:     owner,
+		NftId:     nftId,
+		Recipient: recipient,
+		Amount:    amount,
+	}
+}
+
+// NewMsgRegisterContract creates a new MsgRegisterContract object. The nonce is the one of the
+// deployer account when it created the contract, and is ignored for the governance authority.
+func NewMsgRegisterContract(sender string, contract string, nonce uint64, recipient string) *MsgRegisterContract {
+	return &MsgRegisterContract{
+		Sender:    sender,
+		Contract:  contract,
+		Nonce:     nonce,
+		Recipient: recipient,
+	}
+}
+
+// NewMsgRemoveContract creates a new MsgRemoveContract object
+func NewMsgRemoveContract(owner string, contract string) *MsgRemoveContract {
+	return &MsgRemoveContract{
+		Owner:    owner,
+		Contract: contract,
+	}
+}
+
+// NewMsgReassignContract creates a new MsgReassignContract object
+func NewMsgReassignContract(owner string, contract string, nftId uint64) *MsgReassignContract {
+	return &MsgReassignContract{
+		Owner:    owner,
+		Contract: contract,
+		NftId:    nftId,
+	}
+}
+
+// NewMsgMergeNFTs creates a new MsgMergeNFTs object
+func NewMsgMergeNFTs(owner string, sourceNftId, targetNftId uint64) *MsgMergeNFTs {
+	return &MsgMergeNFTs{
+		Owner:       owner,
+		SourceNftId: sourceNftId,
+		TargetNftId: targetNftId,
+	}
+}
+
+// NewMsgSetCSRShareOverride creates a new MsgSetCSRShareOverride object. Nil csr shares
+// clear the override of the NFT.
+func NewMsgSetCSRShareOverride(authority string, nftId uint64, csrShares *sdkmath.LegacyDec) *MsgSetCSRShareOverride {
+	return &MsgSetCSRShareOverride{
+		Authority: authority,
+		NftId:     nftId,
+		CsrShares: csrShares,
+	}
+}
@@ -7,11 +7,23 @@ import (
 )
 
 var (
-	DefaultEnableCSR = false
-	DefaultCSRShares = sdkmath.LegacyNewDecWithPrec(20, 2)
-
-	ParamStoreKeyEnableCSR = []byte("EnableCSR")
-	ParamStoreKeyCSRShares = []byte("CSRShares")
+	DefaultEnableCSR                = false
+	DefaultCSRShares                = sdkmath.LegacyNewDecWithPrec(20, 2)
+	DefaultMultiContractAttribution = false
+	DefaultCSRShareTiers            = []CSRShareTier{}
+	DefaultCSRRevenueCap            = sdkmath.ZeroInt()
+	DefaultRobustFeeDistribution    = false
+	DefaultRevenueHistoryEpochs     = uint64(30)
+	DefaultPendingFeeRetryBudget    = uint32(100)
+
+	ParamStoreKeyEnableCSR                = []byte("EnableCSR")
+	ParamStoreKeyCSRShares                = []byte("CSRShares")
+	ParamStoreKeyMultiContractAttribution = []byte("MultiContractAttribution")
+	ParamStoreKeyCSRShareTiers            = []byte("CSRShareTiers")
+	ParamStoreKeyCSRRevenueCap            = []byte("CSRRevenueCap")
+	ParamStoreKeyRobustFeeDistribution    = []byte("RobustFeeDistribution")
+	ParamStoreKeyRevenueHistoryEpochs     = []byte("RevenueHistoryEpochs")
+	ParamStoreKeyPendingFeeRetryBudget    = []byte("PendingFeeRetryBudget")
 )
 
 // ParamKeyTable the param key table
@@ -20,16 +32,31 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(enableCSR bool, csrShares sdkmath.LegacyDec) Params {
+func NewParams(
+	enableCSR bool,
+	csrShares sdkmath.LegacyDec,
+	multiContractAttribution bool,
+	csrShareTiers []CSRShareTier,
+	csrRevenueCap sdkmath.Int,
+	robustFeeDistribution bool,
+	revenueHistoryEpochs uint64,
+	pendingFeeRetryBudget uint32,
+) Params {
 	return Params{
-		EnableCsr: enableCSR,
-		CsrShares: csrShares,
+		EnableCsr:                enableCSR,
+		CsrShares:                csrShares,
+		MultiContractAttribution: multiContractAttribution,
+		CsrShareTiers:            csrShareTiers,
+		CsrRevenueCap:            csrRevenueCap,
+		RobustFeeDistribution:    robustFeeDistribution,
+		RevenueHistoryEpochs:     revenueHistoryEpochs,
+		PendingFeeRetryBudget:    pendingFeeRetryBudget,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultEnableCSR, DefaultCSRShares)
+	return NewParams(DefaultEnableCSR, DefaultCSRShares, DefaultMultiContractAttribution, DefaultCSRShareTiers, DefaultCSRRevenueCap, DefaultRobustFeeDistribution, DefaultRevenueHistoryEpochs, DefaultPendingFeeRetryBudget)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -37,6 +64,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableCSR, &p.EnableCsr, ValidateEnableCSR),
 		paramtypes.NewParamSetPair(ParamStoreKeyCSRShares, &p.CsrShares, ValidateShares),
+		paramtypes.NewParamSetPair(ParamStoreKeyMultiContractAttribution, &p.MultiContractAttribution, ValidateMultiContractAttribution),
+		paramtypes.NewParamSetPair(ParamStoreKeyCSRShareTiers, &p.CsrShareTiers, ValidateShareTiers),
+		paramtypes.NewParamSetPair(ParamStoreKeyCSRRevenueCap, &p.CsrRevenueCap, ValidateRevenueCap),
+		paramtypes.NewParamSetPair(ParamStoreKeyRobustFeeDistribution, &p.RobustFeeDistribution, ValidateRobustFeeDistribution),
+		paramtypes.NewParamSetPair(ParamStoreKeyRevenueHistoryEpochs, &p.RevenueHistoryEpochs, ValidateRevenueHistoryEpochs),
+		paramtypes.NewParamSetPair(ParamStoreKeyPendingFeeRetryBudget, &p.PendingFeeRetryBudget, ValidatePendingFeeRetryBudget),
 	}
 }
 
@@ -50,6 +83,26 @@ func ValidateEnableCSR(i interface{}) error {
 	return nil
 }
 
+// Validates the boolean which enables the multi contract attribution of the CSR fees
+func ValidateMultiContractAttribution(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return errorsmod.Wrapf(ErrInvalidParams, "Params::Validate::ValidateMultiContractAttribution multiContractAttribution must be a bool")
+	}
+
+	return nil
+}
+
+// Validates the boolean which enables the robust fee distribution of the CSR fees
+func ValidateRobustFeeDistribution(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return errorsmod.Wrapf(ErrInvalidParams, "Params::Validate::ValidateRobustFeeDistribution robustFeeDistribution must be a bool")
+	}
+
+	return nil
+}
+
 // Validates the CSR share dec that is inputted
 func ValidateShares(i interface{}) error {
 	v, ok := i.(sdkmath.LegacyDec)
@@ -73,9 +126,109 @@ func ValidateShares(i interface{}) error {
 	return nil
 }
 
+// Validates the CSR share tiers, whose thresholds must be positive and strictly increasing, and
+// whose shares must be non-increasing, so that the share of an NFT never grows with its revenue
+func ValidateShareTiers(i interface{}) error {
+	v, ok := i.([]CSRShareTier)
+	if !ok {
+		return errorsmod.Wrapf(ErrInvalidParams, "Params::Validate::ValidateShareTiers CSRShareTiers must be of type []CSRShareTier")
+	}
+
+	for index, tier := range v {
+		if tier.RevenueThreshold.IsNil() || !tier.RevenueThreshold.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidParams, "Params::Validate::ValidateShareTiers the revenue threshold of tier %d must be positive", index)
+		}
+		if index > 0 && tier.RevenueThreshold.LTE(v[index-1].RevenueThreshold) {
+			return errorsmod.Wrapf(ErrInvalidParams, "Params::Validate::ValidateShareTiers the revenue thresholds must be strictly increasing")
+		}
+		if err := ValidateShares(tier.CsrShares); err != nil {
+			return err
+		}
+		if index > 0 && tier.CsrShares.GT(v[index-1].CsrShares) {
+			return errorsmod.Wrapf(ErrInvalidParams, "Params::Validate::ValidateShareTiers the csr shares of the tiers must be non-increasing")
+		}
+	}
+
+	return nil
+}
+
+// Validates the CSR revenue cap, zero meaning no cap
+func ValidateRevenueCap(i interface{}) error {
+	v, ok := i.(sdkmath.Int)
+	if !ok {
+		return errorsmod.Wrapf(ErrInvalidParams, "Params::Validate::ValidateRevenueCap CSRRevenueCap must be of type sdkmath.Int")
+	}
+
+	// an unset cap, e.g. in a genesis predating the parameter, means no cap
+	if v.IsNil() {
+		return nil
+	}
+
+	if v.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidParams, "Params::Validate::ValidateRevenueCap CSRRevenueCap cannot be negative")
+	}
+
+	return nil
+}
+
+// Validates the number of day epochs kept in the revenue history, zero meaning the whole history
+func ValidateRevenueHistoryEpochs(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return errorsmod.Wrapf(ErrInvalidParams, "Params::Validate::ValidateRevenueHistoryEpochs revenueHistoryEpochs must be a uint64")
+	}
+
+	return nil
+}
+
+// Validates the number of pending fees retried at the end of a block, a zero budget stops retrying them
+func ValidatePendingFeeRetryBudget(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return errorsmod.Wrapf(ErrInvalidParams, "Params::Validate::ValidatePendingFeeRetryBudget pendingFeeRetryBudget must be a uint32")
+	}
+
+	return nil
+}
+
 func (p Params) Validate() error {
 	if err := ValidateEnableCSR(p.EnableCsr); err != nil {
 		return err
 	}
+	if err := ValidateMultiContractAttribution(p.MultiContractAttribution); err != nil {
+		return err
+	}
+	if err := ValidateRobustFeeDistribution(p.RobustFeeDistribution); err != nil {
+		return err
+	}
+	if err := ValidateShareTiers(p.CsrShareTiers); err != nil {
+		return err
+	}
+	if len(p.CsrShareTiers) > 0 && !p.CsrShares.IsNil() && p.CsrShareTiers[0].CsrShares.GT(p.CsrShares) {
+		return errorsmod.Wrapf(ErrInvalidParams, "Params::Validate the csr shares of the first tier cannot be greater than CSRShares")
+	}
+	if err := ValidateRevenueCap(p.CsrRevenueCap); err != nil {
+		return err
+	}
+	if err := ValidateRevenueHistoryEpochs(p.RevenueHistoryEpochs); err != nil {
+		return err
+	}
+	if err := ValidatePendingFeeRetryBudget(p.PendingFeeRetryBudget); err != nil {
+		return err
+	}
 	return ValidateShares(p.CsrShares)
 }
+
+// GetCSRShares returns the share of the transaction fees distributed to an NFT given its revenue in
+// the ongoing day epoch: the share of the last tier whose threshold the revenue reached, or the csr
+// shares if it reached none.
+func (p Params) GetCSRShares(epochRevenue sdkmath.Int) sdkmath.LegacyDec {
+	shares := p.CsrShares
+	for _, tier := range p.CsrShareTiers {
+		if epochRevenue.LT(tier.RevenueThreshold) {
+			break
+		}
+		shares = tier.CsrShares
+	}
+	return shares
+}
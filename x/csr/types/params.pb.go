@@ -33,6 +33,31 @@ type Params struct {
 	// decimal to determine the transaction fee split between network operators
 	// (validators) and CSR
 	CsrShares cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=csr_shares,json=csrShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"csr_shares"`
+	// boolean to split the csr fee of a transaction across every registered
+	// contract touched by it, instead of crediting the called contract only. The
+	// touched contracts are the called contract and the contracts emitting events,
+	// as the call trace is not available to the EVM hooks, weighted by the gas of
+	// their events and the rest of the gas of the transaction for the called
+	// contract
+	MultiContractAttribution bool `protobuf:"varint,3,opt,name=multi_contract_attribution,json=multiContractAttribution,proto3" json:"multi_contract_attribution,omitempty"`
+	// tiers replacing csr_shares for the NFTs whose revenue in the ongoing day
+	// epoch reached their threshold, by increasing threshold and non-increasing
+	// csr shares of at most csr_shares
+	CsrShareTiers []CSRShareTier `protobuf:"bytes,4,rep,name=csr_share_tiers,json=csrShareTiers,proto3" json:"csr_share_tiers"`
+	// maximum revenue of an NFT in a day epoch, beyond which its csr fee is
+	// burned, or zero for no cap
+	CsrRevenueCap cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=csr_revenue_cap,json=csrRevenueCap,proto3,customtype=cosmossdk.io/math.Int" json:"csr_revenue_cap"`
+	// boolean to keep the csr fees the Turnstile failed to take in as pending
+	// fees of their NFT, retried at the end of every block, instead of reverting
+	// the transaction. Failed or reverted transactions are out of scope, as the
+	// EVM hooks only run for successful transactions
+	RobustFeeDistribution bool `protobuf:"varint,6,opt,name=robust_fee_distribution,json=robustFeeDistribution,proto3" json:"robust_fee_distribution,omitempty"`
+	// number of past day epochs whose revenues are kept in the revenue history,
+	// or zero to keep the whole history
+	RevenueHistoryEpochs uint64 `protobuf:"varint,7,opt,name=revenue_history_epochs,json=revenueHistoryEpochs,proto3" json:"revenue_history_epochs,omitempty"`
+	// maximum number of pending fees retried at the end of a block, resuming
+	// after the last NFT retried in the previous block, or zero to stop retrying
+	PendingFeeRetryBudget uint32 `protobuf:"varint,8,opt,name=pending_fee_retry_budget,json=pendingFeeRetryBudget,proto3" json:"pending_fee_retry_budget,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -75,33 +100,128 @@ func (m *Params) GetEnableCsr() bool {
 	return false
 }
 
+func (m *Params) GetMultiContractAttribution() bool {
+	if m != nil {
+		return m.MultiContractAttribution
+	}
+	return false
+}
+
+func (m *Params) GetCsrShareTiers() []CSRShareTier {
+	if m != nil {
+		return m.CsrShareTiers
+	}
+	return nil
+}
+
+func (m *Params) GetRobustFeeDistribution() bool {
+	if m != nil {
+		return m.RobustFeeDistribution
+	}
+	return false
+}
+
+func (m *Params) GetRevenueHistoryEpochs() uint64 {
+	if m != nil {
+		return m.RevenueHistoryEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetPendingFeeRetryBudget() uint32 {
+	if m != nil {
+		return m.PendingFeeRetryBudget
+	}
+	return 0
+}
+
+// CSRShareTier is the share of the transaction fees distributed to the NFTs
+// whose revenue in the ongoing day epoch reached a threshold
+type CSRShareTier struct {
+	// revenue of an NFT in the ongoing day epoch from which the tier applies
+	RevenueThreshold cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=revenue_threshold,json=revenueThreshold,proto3,customtype=cosmossdk.io/math.Int" json:"revenue_threshold"`
+	// decimal to determine the transaction fee split between network operators
+	// (validators) and CSR for the NFTs of the tier
+	CsrShares cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=csr_shares,json=csrShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"csr_shares"`
+}
+
+func (m *CSRShareTier) Reset()         { *m = CSRShareTier{} }
+func (m *CSRShareTier) String() string { return proto.CompactTextString(m) }
+func (*CSRShareTier) ProtoMessage()    {}
+func (*CSRShareTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60f3e0cd3160b8d7, []int{1}
+}
+func (m *CSRShareTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CSRShareTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CSRShareTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CSRShareTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CSRShareTier.Merge(m, src)
+}
+func (m *CSRShareTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *CSRShareTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_CSRShareTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CSRShareTier proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "canto.csr.v1.Params")
+	proto.RegisterType((*CSRShareTier)(nil), "canto.csr.v1.CSRShareTier")
 }
 
 func init() { proto.RegisterFile("canto/csr/v1/params.proto", fileDescriptor_60f3e0cd3160b8d7) }
 
 var fileDescriptor_60f3e0cd3160b8d7 = []byte{
-	// 295 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4c, 0x4e, 0xcc, 0x2b,
-	0xc9, 0xd7, 0x4f, 0x2e, 0x2e, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x01, 0x4b, 0xe9, 0x25, 0x17, 0x17, 0xe9, 0x95, 0x19,
-	0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x25, 0xf4, 0x41, 0x2c, 0x88, 0x1a, 0x29, 0xc9, 0xe4,
-	0xfc, 0xe2, 0xdc, 0xfc, 0xe2, 0x78, 0x88, 0x04, 0x84, 0x03, 0x95, 0x12, 0x4c, 0xcc, 0xcd, 0xcc,
-	0xcb, 0xd7, 0x07, 0x93, 0x10, 0x21, 0xa5, 0xe9, 0x8c, 0x5c, 0x6c, 0x01, 0x60, 0x2b, 0x84, 0x64,
-	0xb9, 0xb8, 0x52, 0xf3, 0x12, 0x93, 0x72, 0x52, 0xe3, 0x93, 0x8b, 0x8b, 0x24, 0x18, 0x15, 0x18,
-	0x35, 0x38, 0x82, 0x38, 0x21, 0x22, 0xce, 0xc5, 0x45, 0x42, 0xa1, 0x5c, 0x5c, 0xc9, 0xc5, 0x45,
-	0xf1, 0xc5, 0x19, 0x89, 0x45, 0xa9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x66, 0x27,
-	0xee, 0xc9, 0x33, 0xdc, 0xba, 0x27, 0x2f, 0x0d, 0xb1, 0xa6, 0x38, 0x25, 0x5b, 0x2f, 0x33, 0x5f,
-	0x3f, 0x37, 0xb1, 0x24, 0x43, 0xcf, 0x27, 0x35, 0x3d, 0x31, 0xb9, 0xd2, 0x25, 0x35, 0xf9, 0xd2,
-	0x16, 0x5d, 0x2e, 0xa8, 0x2b, 0x5c, 0x52, 0x93, 0x57, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0xc4, 0x99,
-	0x5c, 0x5c, 0x14, 0x0c, 0x36, 0xc8, 0x4a, 0xbc, 0xeb, 0xf9, 0x06, 0x2d, 0x21, 0x88, 0x97, 0x2b,
-	0xc0, 0x9e, 0x86, 0x38, 0xc7, 0xc9, 0xfd, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f,
-	0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18,
-	0xa2, 0x74, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x9d, 0x41, 0x1a,
-	0x75, 0xfd, 0x52, 0x4b, 0xca, 0xf3, 0x8b, 0xb2, 0x21, 0x3c, 0xfd, 0x32, 0x0b, 0xa8, 0x49, 0x25,
-	0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x9f, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x86,
-	0x23, 0x04, 0xac, 0x58, 0x01, 0x00, 0x00,
+	// 551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x41, 0x6b, 0x13, 0x4f,
+	0x18, 0xc6, 0xb3, 0xff, 0xf6, 0x1f, 0x9b, 0xb1, 0x45, 0xbb, 0x34, 0x76, 0x1b, 0xe9, 0x36, 0xf4,
+	0x14, 0x0a, 0xd9, 0xb5, 0x2a, 0x55, 0x8a, 0x17, 0x93, 0x58, 0x2d, 0xa8, 0xc8, 0xb6, 0x82, 0x08,
+	0x32, 0xcc, 0x4e, 0x5e, 0x77, 0x87, 0x66, 0x77, 0x96, 0x99, 0xd9, 0x68, 0xbe, 0x82, 0x27, 0xbf,
+	0x84, 0xe0, 0xb1, 0x07, 0x3f, 0x44, 0x8f, 0xc5, 0x93, 0x78, 0x28, 0x92, 0x1c, 0xfa, 0x35, 0x64,
+	0x67, 0x36, 0x26, 0xe2, 0x4d, 0xf0, 0xb2, 0xec, 0xcc, 0xef, 0xdd, 0xe7, 0x7d, 0x9e, 0x97, 0x77,
+	0xd1, 0x06, 0x25, 0xa9, 0xe2, 0x3e, 0x95, 0xc2, 0x1f, 0xee, 0xfa, 0x19, 0x11, 0x24, 0x91, 0x5e,
+	0x26, 0xb8, 0xe2, 0xf6, 0xb2, 0x46, 0x1e, 0x95, 0xc2, 0x1b, 0xee, 0x36, 0xd6, 0x22, 0x1e, 0x71,
+	0x0d, 0xfc, 0xe2, 0xcd, 0xd4, 0x34, 0x36, 0x28, 0x97, 0x09, 0x97, 0xd8, 0x00, 0x73, 0x28, 0xd1,
+	0x2a, 0x49, 0x58, 0xca, 0x7d, 0xfd, 0x34, 0x57, 0xdb, 0x9f, 0x16, 0x51, 0xf5, 0x85, 0x6e, 0x61,
+	0x6f, 0x22, 0x04, 0x29, 0x09, 0x07, 0x80, 0xa9, 0x14, 0x8e, 0xd5, 0xb4, 0x5a, 0x4b, 0x41, 0xcd,
+	0xdc, 0x74, 0xa5, 0xb0, 0x5f, 0x22, 0x44, 0xa5, 0xc0, 0x32, 0x26, 0x02, 0xa4, 0xf3, 0x5f, 0xd3,
+	0x6a, 0xd5, 0x3a, 0x7b, 0x67, 0x17, 0x5b, 0x95, 0xef, 0x17, 0x5b, 0x37, 0x4d, 0x1b, 0xd9, 0x3f,
+	0xf1, 0x18, 0xf7, 0x13, 0xa2, 0x62, 0xef, 0x29, 0x44, 0x84, 0x8e, 0x7a, 0x40, 0xbf, 0x7e, 0x69,
+	0xa3, 0xd2, 0x45, 0x0f, 0xe8, 0xe7, 0xcb, 0xd3, 0x1d, 0x2b, 0xa8, 0x51, 0x29, 0x8e, 0xb4, 0x90,
+	0xfd, 0x00, 0x35, 0x92, 0x7c, 0xa0, 0x18, 0xa6, 0x3c, 0x55, 0x82, 0x50, 0x85, 0x89, 0x52, 0x82,
+	0x85, 0xb9, 0x62, 0x3c, 0x75, 0x16, 0xb4, 0x0b, 0x47, 0x57, 0x74, 0xcb, 0x82, 0x87, 0x33, 0x6e,
+	0x3f, 0x43, 0xd7, 0x7e, 0x99, 0xc2, 0x8a, 0x81, 0x90, 0xce, 0x62, 0x73, 0xa1, 0x75, 0xf5, 0x76,
+	0xc3, 0x9b, 0x1f, 0x95, 0xd7, 0x3d, 0x0a, 0x74, 0xbf, 0x63, 0x06, 0xa2, 0x53, 0x2b, 0x5c, 0x1b,
+	0x23, 0x2b, 0x53, 0x23, 0x05, 0x90, 0xf6, 0x2b, 0x23, 0x27, 0x60, 0x08, 0x69, 0x0e, 0x98, 0x92,
+	0xcc, 0xf9, 0x5f, 0x07, 0xbd, 0x55, 0x06, 0xad, 0xff, 0x19, 0xf4, 0x30, 0x55, 0x73, 0x11, 0x0f,
+	0x53, 0x35, 0x53, 0x0e, 0x8c, 0x4e, 0x97, 0x64, 0xf6, 0x1e, 0x5a, 0x17, 0x3c, 0xcc, 0xa5, 0xc2,
+	0x6f, 0x01, 0x70, 0x9f, 0xc9, 0x59, 0xc6, 0xaa, 0xce, 0x58, 0x37, 0xf8, 0x00, 0xa0, 0x37, 0x07,
+	0xed, 0xbb, 0xe8, 0xc6, 0xd4, 0x4d, 0xcc, 0xa4, 0xe2, 0x62, 0x84, 0x21, 0xe3, 0x34, 0x96, 0xce,
+	0x95, 0xa6, 0xd5, 0x5a, 0x0c, 0xd6, 0x4a, 0xfa, 0xc4, 0xc0, 0x47, 0x9a, 0xd9, 0xf7, 0x90, 0x93,
+	0x41, 0xda, 0x67, 0x69, 0xa4, 0xdb, 0x09, 0x50, 0x62, 0x84, 0xc3, 0xbc, 0x1f, 0x81, 0x72, 0x96,
+	0x9a, 0x56, 0x6b, 0x25, 0xa8, 0x97, 0xfc, 0x00, 0x20, 0x28, 0x68, 0x47, 0xc3, 0xfd, 0xf5, 0x0f,
+	0x97, 0xa7, 0x3b, 0xb6, 0x59, 0xc0, 0xf7, 0x7a, 0x05, 0xcd, 0x72, 0x6c, 0x4f, 0x2c, 0xb4, 0x3c,
+	0x3f, 0x44, 0xfb, 0x0d, 0x5a, 0x9d, 0x1a, 0x53, 0xb1, 0x00, 0x19, 0xf3, 0x41, 0xdf, 0xb1, 0xfe,
+	0x72, 0x58, 0xd7, 0x4b, 0xa9, 0xe3, 0xa9, 0xd2, 0x3f, 0xda, 0xb6, 0xfd, 0xcd, 0x22, 0x9f, 0x33,
+	0x9f, 0xef, 0xb7, 0xcd, 0x78, 0x7c, 0x36, 0x76, 0xad, 0xf3, 0xb1, 0x6b, 0xfd, 0x18, 0xbb, 0xd6,
+	0xc7, 0x89, 0x5b, 0x39, 0x9f, 0xb8, 0x95, 0x6f, 0x13, 0xb7, 0xf2, 0xba, 0x1d, 0x31, 0x15, 0xe7,
+	0xa1, 0x47, 0x79, 0xe2, 0x77, 0x8b, 0xcf, 0xdb, 0xcf, 0x41, 0xbd, 0xe3, 0xe2, 0xc4, 0x9c, 0xfc,
+	0xe1, 0xfd, 0x52, 0x4f, 0x8d, 0x32, 0x90, 0x61, 0x55, 0xff, 0x5d, 0x77, 0x7e, 0x0e, 0x00, 0x9d,
+	0x70, 0xd5, 0x96, 0xcc, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -124,6 +244,60 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PendingFeeRetryBudget != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PendingFeeRetryBudget))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RevenueHistoryEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RevenueHistoryEpochs))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RobustFeeDistribution {
+		i--
+		if m.RobustFeeDistribution {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.CsrRevenueCap.Size()
+		i -= size
+		if _, err := m.CsrRevenueCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.CsrShareTiers) > 0 {
+		for iNdEx := len(m.CsrShareTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CsrShareTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MultiContractAttribution {
+		i--
+		if m.MultiContractAttribution {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.CsrShares.Size()
 		i -= size
@@ -147,6 +321,49 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CSRShareTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CSRShareTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSRShareTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CsrShares.Size()
+		i -= size
+		if _, err := m.CsrShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.RevenueThreshold.Size()
+		i -= size
+		if _, err := m.RevenueThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -169,6 +386,39 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.CsrShares.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.MultiContractAttribution {
+		n += 2
+	}
+	if len(m.CsrShareTiers) > 0 {
+		for _, e := range m.CsrShareTiers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.CsrRevenueCap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.RobustFeeDistribution {
+		n += 2
+	}
+	if m.RevenueHistoryEpochs != 0 {
+		n += 1 + sovParams(uint64(m.RevenueHistoryEpochs))
+	}
+	if m.PendingFeeRetryBudget != 0 {
+		n += 1 + sovParams(uint64(m.PendingFeeRetryBudget))
+	}
+	return n
+}
+
+func (m *CSRShareTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RevenueThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.CsrShares.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -261,6 +511,270 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiContractAttribution", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MultiContractAttribution = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CsrShareTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CsrShareTiers = append(m.CsrShareTiers, CSRShareTier{})
+			if err := m.CsrShareTiers[len(m.CsrShareTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CsrRevenueCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CsrRevenueCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RobustFeeDistribution", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RobustFeeDistribution = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevenueHistoryEpochs", wireType)
+			}
+			m.RevenueHistoryEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevenueHistoryEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingFeeRetryBudget", wireType)
+			}
+			m.PendingFeeRetryBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingFeeRetryBudget |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CSRShareTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CSRShareTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CSRShareTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevenueThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RevenueThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CsrShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CsrShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -20,6 +20,10 @@ func TestParamSetPairs(t *testing.T) {
 
 func TestParamsValidate(t *testing.T) {
 	csrShares := sdkmath.LegacyNewDecWithPrec(50, 2)
+	tiers := []CSRShareTier{
+		{RevenueThreshold: sdkmath.NewInt(100), CsrShares: sdkmath.LegacyNewDecWithPrec(25, 2)},
+		{RevenueThreshold: sdkmath.NewInt(500), CsrShares: sdkmath.LegacyNewDecWithPrec(10, 2)},
+	}
 
 	testCases := []struct {
 		name   string
@@ -29,22 +33,27 @@ func TestParamsValidate(t *testing.T) {
 		{"Testing default parameters - pass", DefaultParams(), true},
 		{
 			"Testing another valid set of parameters - pass",
-			NewParams(true, csrShares),
+			NewParams(true, csrShares, false, DefaultCSRShareTiers, DefaultCSRRevenueCap, false, DefaultRevenueHistoryEpochs, DefaultPendingFeeRetryBudget),
 			true,
 		},
 		{
 			"Testing disabling the CSR module - pass",
-			NewParams(false, csrShares),
+			NewParams(false, csrShares, false, DefaultCSRShareTiers, DefaultCSRRevenueCap, false, DefaultRevenueHistoryEpochs, DefaultPendingFeeRetryBudget),
+			true,
+		},
+		{
+			"Testing the multi contract attribution - pass",
+			NewParams(true, csrShares, true, DefaultCSRShareTiers, DefaultCSRRevenueCap, false, DefaultRevenueHistoryEpochs, DefaultPendingFeeRetryBudget),
 			true,
 		},
 		{
 			"Testing all goes to csrShares - pass",
-			Params{true, sdkmath.LegacyNewDecFromInt(sdkmath.NewInt(1))},
+			Params{true, sdkmath.LegacyNewDecFromInt(sdkmath.NewInt(1)), false, DefaultCSRShareTiers, DefaultCSRRevenueCap, false, DefaultRevenueHistoryEpochs, DefaultPendingFeeRetryBudget},
 			true,
 		},
 		{
 			"Testing nothing goes to csrShares - pass",
-			Params{true, sdkmath.LegacyNewDecFromInt(sdkmath.NewInt(0))},
+			Params{true, sdkmath.LegacyNewDecFromInt(sdkmath.NewInt(0)), false, DefaultCSRShareTiers, DefaultCSRRevenueCap, false, DefaultRevenueHistoryEpochs, DefaultPendingFeeRetryBudget},
 			true,
 		},
 		{
@@ -54,19 +63,90 @@ func TestParamsValidate(t *testing.T) {
 		},
 		{
 			"Testing CSR shares going over 100% - fail",
-			Params{true, sdkmath.LegacyNewDecFromInt(sdkmath.NewInt(2))},
+			Params{true, sdkmath.LegacyNewDecFromInt(sdkmath.NewInt(2)), false, DefaultCSRShareTiers, DefaultCSRRevenueCap, false, DefaultRevenueHistoryEpochs, DefaultPendingFeeRetryBudget},
 			false,
 		},
 		{
 			"Testing CSR shares below 0 - fail",
-			Params{true, sdkmath.LegacyNewDecFromInt(sdkmath.NewInt(-1))},
+			Params{true, sdkmath.LegacyNewDecFromInt(sdkmath.NewInt(-1)), false, DefaultCSRShareTiers, DefaultCSRRevenueCap, false, DefaultRevenueHistoryEpochs, DefaultPendingFeeRetryBudget},
 			false,
 		},
 		{
 			"Testing CSR shares below 0 - fail",
-			Params{true, sdkmath.LegacyNewDecFromInt(sdkmath.NewInt(-1))},
+			Params{true, sdkmath.LegacyNewDecFromInt(sdkmath.NewInt(-1)), false, DefaultCSRShareTiers, DefaultCSRRevenueCap, false, DefaultRevenueHistoryEpochs, DefaultPendingFeeRetryBudget},
+			false,
+		},
+		{
+			"Testing the robust fee distribution - pass",
+			NewParams(true, csrShares, false, DefaultCSRShareTiers, DefaultCSRRevenueCap, true, DefaultRevenueHistoryEpochs, DefaultPendingFeeRetryBudget),
+			true,
+		},
+		{
+			"Testing increasing share tiers and a revenue cap - pass",
+			NewParams(true, csrShares, false, tiers, sdkmath.NewInt(1000), false, DefaultRevenueHistoryEpochs, DefaultPendingFeeRetryBudget),
+			true,
+		},
+		{
+			"Testing share tiers with decreasing thresholds - fail",
+			NewParams(true, csrShares, false, []CSRShareTier{tiers[1], tiers[0]}, DefaultCSRRevenueCap, false, DefaultRevenueHistoryEpochs, DefaultPendingFeeRetryBudget),
 			false,
 		},
+		{
+			"Testing share tiers with duplicate thresholds - fail",
+			NewParams(true, csrShares, false, []CSRShareTier{tiers[0], tiers[0]}, DefaultCSRRevenueCap, false, DefaultRevenueHistoryEpochs, DefaultPendingFeeRetryBudget),
+			false,
+		},
+		{
+			"Testing share tier with a zero threshold - fail",
+			NewParams(true, csrShares, false, []CSRShareTier{{RevenueThreshold: sdkmath.ZeroInt(), CsrShares: csrShares}}, DefaultCSRRevenueCap, false, DefaultRevenueHistoryEpochs, DefaultPendingFeeRetryBudget),
+			false,
+		},
+		{
+			"Testing share tiers with increasing shares - fail",
+			NewParams(true, csrShares, false, []CSRShareTier{
+				{RevenueThreshold: sdkmath.NewInt(100), CsrShares: sdkmath.LegacyNewDecWithPrec(10, 2)},
+				{RevenueThreshold: sdkmath.NewInt(500), CsrShares: sdkmath.LegacyNewDecWithPrec(25, 2)},
+			}, DefaultCSRRevenueCap, false, DefaultRevenueHistoryEpochs, DefaultPendingFeeRetryBudget),
+			false,
+		},
+		{
+			"Testing share tiers with equal shares - pass",
+			NewParams(true, csrShares, false, []CSRShareTier{
+				{RevenueThreshold: sdkmath.NewInt(100), CsrShares: csrShares},
+				{RevenueThreshold: sdkmath.NewInt(500), CsrShares: csrShares},
+			}, DefaultCSRRevenueCap, false, DefaultRevenueHistoryEpochs, DefaultPendingFeeRetryBudget),
+			true,
+		},
+		{
+			"Testing share tier above the csr shares - fail",
+			NewParams(true, csrShares, false, []CSRShareTier{{RevenueThreshold: sdkmath.NewInt(100), CsrShares: sdkmath.LegacyNewDecWithPrec(75, 2)}}, DefaultCSRRevenueCap, false, DefaultRevenueHistoryEpochs, DefaultPendingFeeRetryBudget),
+			false,
+		},
+		{
+			"Testing share tier over 100% - fail",
+			NewParams(true, csrShares, false, []CSRShareTier{{RevenueThreshold: sdkmath.NewInt(100), CsrShares: sdkmath.LegacyNewDec(2)}}, DefaultCSRRevenueCap, false, DefaultRevenueHistoryEpochs, DefaultPendingFeeRetryBudget),
+			false,
+		},
+		{
+			"Testing negative revenue cap - fail",
+			NewParams(true, csrShares, false, DefaultCSRShareTiers, sdkmath.NewInt(-1), false, DefaultRevenueHistoryEpochs, DefaultPendingFeeRetryBudget),
+			false,
+		},
+		{
+			"Testing unset revenue cap - pass",
+			NewParams(true, csrShares, false, DefaultCSRShareTiers, sdkmath.Int{}, false, DefaultRevenueHistoryEpochs, DefaultPendingFeeRetryBudget),
+			true,
+		},
+		{
+			"Testing the whole revenue history kept - pass",
+			NewParams(true, csrShares, false, DefaultCSRShareTiers, DefaultCSRRevenueCap, false, 0, DefaultPendingFeeRetryBudget),
+			true,
+		},
+		{
+			"Testing the pending fee retries stopped - pass",
+			NewParams(true, csrShares, false, DefaultCSRShareTiers, DefaultCSRRevenueCap, true, DefaultRevenueHistoryEpochs, 0),
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		err := tc.params.Validate()
@@ -78,3 +158,25 @@ func TestParamsValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestParamsGetCSRShares(t *testing.T) {
+	params := NewParams(true, sdkmath.LegacyNewDecWithPrec(50, 2), false, []CSRShareTier{
+		{RevenueThreshold: sdkmath.NewInt(100), CsrShares: sdkmath.LegacyNewDecWithPrec(25, 2)},
+		{RevenueThreshold: sdkmath.NewInt(500), CsrShares: sdkmath.LegacyNewDecWithPrec(10, 2)},
+	}, DefaultCSRRevenueCap, false, DefaultRevenueHistoryEpochs, DefaultPendingFeeRetryBudget)
+
+	testCases := []struct {
+		revenue int64
+		shares  sdkmath.LegacyDec
+	}{
+		{0, sdkmath.LegacyNewDecWithPrec(50, 2)},
+		{99, sdkmath.LegacyNewDecWithPrec(50, 2)},
+		{100, sdkmath.LegacyNewDecWithPrec(25, 2)},
+		{499, sdkmath.LegacyNewDecWithPrec(25, 2)},
+		{500, sdkmath.LegacyNewDecWithPrec(10, 2)},
+		{10000, sdkmath.LegacyNewDecWithPrec(10, 2)},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.shares, params.GetCSRShares(sdkmath.NewInt(tc.revenue)), tc.revenue)
+	}
+}
@@ -480,6 +480,330 @@ func (m *QueryTurnstileResponse) GetAddress() string {
 	return ""
 }
 
+// QueryCSRRevenueHistoryRequest is the request type for the
+// Query/CSRRevenueHistory RPC method.
+type QueryCSRRevenueHistoryRequest struct {
+	NftId uint64 `protobuf:"varint,1,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCSRRevenueHistoryRequest) Reset()         { *m = QueryCSRRevenueHistoryRequest{} }
+func (m *QueryCSRRevenueHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCSRRevenueHistoryRequest) ProtoMessage()    {}
+func (*QueryCSRRevenueHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a845ddc1dc245388, []int{10}
+}
+func (m *QueryCSRRevenueHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCSRRevenueHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCSRRevenueHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCSRRevenueHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCSRRevenueHistoryRequest.Merge(m, src)
+}
+func (m *QueryCSRRevenueHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCSRRevenueHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCSRRevenueHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCSRRevenueHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryCSRRevenueHistoryRequest) GetNftId() uint64 {
+	if m != nil {
+		return m.NftId
+	}
+	return 0
+}
+
+func (m *QueryCSRRevenueHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCSRRevenueHistoryResponse is the response type for the
+// Query/CSRRevenueHistory RPC method.
+type QueryCSRRevenueHistoryResponse struct {
+	// revenues of the CSR NFT in past day epochs, by ascending epoch number
+	Revenues []CSRRevenue `protobuf:"bytes,1,rep,name=revenues,proto3" json:"revenues"`
+	// revenue of the CSR NFT in the ongoing day epoch
+	Current CSRRevenue `protobuf:"bytes,2,opt,name=current,proto3" json:"current"`
+	// pagination for response
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCSRRevenueHistoryResponse) Reset()         { *m = QueryCSRRevenueHistoryResponse{} }
+func (m *QueryCSRRevenueHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCSRRevenueHistoryResponse) ProtoMessage()    {}
+func (*QueryCSRRevenueHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a845ddc1dc245388, []int{11}
+}
+func (m *QueryCSRRevenueHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCSRRevenueHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCSRRevenueHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCSRRevenueHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCSRRevenueHistoryResponse.Merge(m, src)
+}
+func (m *QueryCSRRevenueHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCSRRevenueHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCSRRevenueHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCSRRevenueHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryCSRRevenueHistoryResponse) GetRevenues() []CSRRevenue {
+	if m != nil {
+		return m.Revenues
+	}
+	return nil
+}
+
+func (m *QueryCSRRevenueHistoryResponse) GetCurrent() CSRRevenue {
+	if m != nil {
+		return m.Current
+	}
+	return CSRRevenue{}
+}
+
+func (m *QueryCSRRevenueHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTopCSRsRequest is the request type for the Query/TopCSRs RPC method.
+type QueryTopCSRsRequest struct {
+	// number of the day epoch
+	Epoch int64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// maximum number of CSR NFTs returned, defaults to 10 and is capped at 100
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryTopCSRsRequest) Reset()         { *m = QueryTopCSRsRequest{} }
+func (m *QueryTopCSRsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopCSRsRequest) ProtoMessage()    {}
+func (*QueryTopCSRsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a845ddc1dc245388, []int{12}
+}
+func (m *QueryTopCSRsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopCSRsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopCSRsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTopCSRsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopCSRsRequest.Merge(m, src)
+}
+func (m *QueryTopCSRsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopCSRsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopCSRsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTopCSRsRequest proto.InternalMessageInfo
+
+func (m *QueryTopCSRsRequest) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *QueryTopCSRsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// QueryTopCSRsResponse is the response type for the Query/TopCSRs RPC method.
+type QueryTopCSRsResponse struct {
+	// revenues of the CSR NFTs by descending revenue
+	Revenues []CSRRevenue `protobuf:"bytes,1,rep,name=revenues,proto3" json:"revenues"`
+}
+
+func (m *QueryTopCSRsResponse) Reset()         { *m = QueryTopCSRsResponse{} }
+func (m *QueryTopCSRsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopCSRsResponse) ProtoMessage()    {}
+func (*QueryTopCSRsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a845ddc1dc245388, []int{13}
+}
+func (m *QueryTopCSRsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopCSRsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopCSRsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTopCSRsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopCSRsResponse.Merge(m, src)
+}
+func (m *QueryTopCSRsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopCSRsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopCSRsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTopCSRsResponse proto.InternalMessageInfo
+
+func (m *QueryTopCSRsResponse) GetRevenues() []CSRRevenue {
+	if m != nil {
+		return m.Revenues
+	}
+	return nil
+}
+
+// QueryPendingFeesRequest is the request type for the Query/PendingFees RPC
+// method.
+type QueryPendingFeesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingFeesRequest) Reset()         { *m = QueryPendingFeesRequest{} }
+func (m *QueryPendingFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingFeesRequest) ProtoMessage()    {}
+func (*QueryPendingFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a845ddc1dc245388, []int{14}
+}
+func (m *QueryPendingFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingFeesRequest.Merge(m, src)
+}
+func (m *QueryPendingFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingFeesRequest proto.InternalMessageInfo
+
+func (m *QueryPendingFeesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingFeesResponse is the response type for the Query/PendingFees RPC
+// method.
+type QueryPendingFeesResponse struct {
+	// csr fees not yet distributed to the Turnstile, by ascending NFT id
+	PendingFees []CSRPendingFee `protobuf:"bytes,1,rep,name=pending_fees,json=pendingFees,proto3" json:"pending_fees"`
+	// pagination for response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingFeesResponse) Reset()         { *m = QueryPendingFeesResponse{} }
+func (m *QueryPendingFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingFeesResponse) ProtoMessage()    {}
+func (*QueryPendingFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a845ddc1dc245388, []int{15}
+}
+func (m *QueryPendingFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingFeesResponse.Merge(m, src)
+}
+func (m *QueryPendingFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingFeesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingFeesResponse) GetPendingFees() []CSRPendingFee {
+	if m != nil {
+		return m.PendingFees
+	}
+	return nil
+}
+
+func (m *QueryPendingFeesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "canto.csr.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "canto.csr.v1.QueryParamsResponse")
@@ -491,51 +815,74 @@ func init() {
 	proto.RegisterType((*QueryCSRByContractResponse)(nil), "canto.csr.v1.QueryCSRByContractResponse")
 	proto.RegisterType((*QueryTurnstileRequest)(nil), "canto.csr.v1.QueryTurnstileRequest")
 	proto.RegisterType((*QueryTurnstileResponse)(nil), "canto.csr.v1.QueryTurnstileResponse")
+	proto.RegisterType((*QueryCSRRevenueHistoryRequest)(nil), "canto.csr.v1.QueryCSRRevenueHistoryRequest")
+	proto.RegisterType((*QueryCSRRevenueHistoryResponse)(nil), "canto.csr.v1.QueryCSRRevenueHistoryResponse")
+	proto.RegisterType((*QueryTopCSRsRequest)(nil), "canto.csr.v1.QueryTopCSRsRequest")
+	proto.RegisterType((*QueryTopCSRsResponse)(nil), "canto.csr.v1.QueryTopCSRsResponse")
+	proto.RegisterType((*QueryPendingFeesRequest)(nil), "canto.csr.v1.QueryPendingFeesRequest")
+	proto.RegisterType((*QueryPendingFeesResponse)(nil), "canto.csr.v1.QueryPendingFeesResponse")
 }
 
 func init() { proto.RegisterFile("canto/csr/v1/query.proto", fileDescriptor_a845ddc1dc245388) }
 
 var fileDescriptor_a845ddc1dc245388 = []byte{
-	// 622 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x4f, 0x13, 0x41,
-	0x1c, 0xc5, 0xbb, 0x50, 0x50, 0xbe, 0x68, 0x22, 0xe3, 0x0a, 0x74, 0x25, 0xdb, 0xb2, 0x1a, 0xa9,
-	0x3f, 0xd8, 0x49, 0x6b, 0x4c, 0x3c, 0xb7, 0x09, 0x0d, 0x17, 0x82, 0x0b, 0x27, 0x6e, 0xd3, 0xed,
-	0xb2, 0x36, 0xd0, 0x9d, 0x65, 0x67, 0x5a, 0x6c, 0x08, 0x17, 0x6f, 0x26, 0x1e, 0x4c, 0xfc, 0xa7,
-	0x38, 0x92, 0x78, 0xd1, 0x8b, 0x31, 0xad, 0x7f, 0x88, 0xd9, 0x99, 0x59, 0xe9, 0xd4, 0xb6, 0xc4,
-	0x5b, 0x67, 0xe7, 0xcd, 0x7b, 0x9f, 0xef, 0xce, 0xdb, 0xc2, 0xba, 0x4f, 0x22, 0x4e, 0xb1, 0xcf,
-	0x12, 0xdc, 0xab, 0xe0, 0xb3, 0x6e, 0x90, 0xf4, 0xdd, 0x38, 0xa1, 0x9c, 0xa2, 0x7b, 0x62, 0xc7,
-	0xf5, 0x59, 0xe2, 0xf6, 0x2a, 0x96, 0x19, 0xd2, 0x90, 0x8a, 0x0d, 0x9c, 0xfe, 0x92, 0x1a, 0x6b,
-	0x23, 0xa4, 0x34, 0x3c, 0x0d, 0x30, 0x89, 0xdb, 0x98, 0x44, 0x11, 0xe5, 0x84, 0xb7, 0x69, 0xc4,
-	0xd4, 0xee, 0x0b, 0x9f, 0xb2, 0x0e, 0x65, 0xb8, 0x49, 0x58, 0x20, 0xad, 0x71, 0xaf, 0xd2, 0x0c,
-	0x38, 0xa9, 0xe0, 0x98, 0x84, 0xed, 0x48, 0x88, 0x95, 0xb6, 0xa0, 0x71, 0xc4, 0x24, 0x21, 0x9d,
-	0xcc, 0x66, 0x55, 0xdb, 0x4a, 0x79, 0xc4, 0x73, 0xc7, 0x04, 0xf4, 0x2e, 0x35, 0xdd, 0x17, 0x62,
-	0x2f, 0x38, 0xeb, 0x06, 0x8c, 0x3b, 0xbb, 0xf0, 0x50, 0x7b, 0xca, 0x62, 0x1a, 0xb1, 0x00, 0x55,
-	0x61, 0x51, 0x9a, 0xae, 0x1b, 0x25, 0xa3, 0xbc, 0x5c, 0x35, 0xdd, 0xd1, 0xf1, 0x5c, 0xa9, 0xae,
-	0xe5, 0xaf, 0x7e, 0x16, 0x73, 0x9e, 0x52, 0x3a, 0x47, 0xf0, 0x40, 0x58, 0xd5, 0x0f, 0xbc, 0xcc,
-	0x1e, 0xed, 0x00, 0xdc, 0xb0, 0x2b, 0xaf, 0x67, 0xae, 0x1c, 0xd4, 0x4d, 0x07, 0x75, 0xe5, 0x3b,
-	0x54, 0x83, 0xba, 0xfb, 0x24, 0x0c, 0xd4, 0x59, 0x6f, 0xe4, 0xa4, 0xf3, 0xc9, 0x80, 0x95, 0x11,
-	0x73, 0x45, 0xf9, 0x12, 0xf2, 0x3e, 0x4b, 0x52, 0xc6, 0xf9, 0xf2, 0x72, 0x75, 0x45, 0x67, 0xac,
-	0x1f, 0x78, 0x0a, 0x50, 0x88, 0x50, 0x43, 0x43, 0x99, 0x13, 0x28, 0x5b, 0xb7, 0xa2, 0xc8, 0x24,
-	0x8d, 0xe5, 0x15, 0x98, 0x19, 0x4a, 0xad, 0xbf, 0xb7, 0x73, 0x98, 0xcd, 0x6a, 0xc2, 0x42, 0x74,
-	0xcc, 0x77, 0x5b, 0x62, 0xcc, 0xbc, 0x27, 0x17, 0x4e, 0x0d, 0x1e, 0x8d, 0xa9, 0x15, 0xfc, 0x73,
-	0x98, 0xf7, 0x59, 0xa2, 0xde, 0xc9, 0x54, 0xf6, 0x54, 0xe3, 0xbc, 0x81, 0xc2, 0x8d, 0x47, 0x9d,
-	0x46, 0x3c, 0x21, 0x3e, 0xcf, 0x62, 0xd7, 0xe1, 0x0e, 0x69, 0xb5, 0x92, 0x80, 0xc9, 0xbb, 0x5a,
-	0xf2, 0xb2, 0xa5, 0xd3, 0x00, 0x6b, 0xd2, 0xb1, 0xff, 0xcf, 0x5f, 0x53, 0x33, 0x1c, 0x76, 0x93,
-	0x88, 0xf1, 0xf6, 0x69, 0x76, 0x45, 0x4e, 0x15, 0x56, 0xc7, 0x37, 0x94, 0xfb, 0x54, 0xaa, 0xea,
-	0x8f, 0x3c, 0x2c, 0x88, 0x43, 0xe8, 0x04, 0x16, 0x65, 0x91, 0x50, 0x49, 0x8f, 0xff, 0xb7, 0xa7,
-	0xd6, 0xe6, 0x0c, 0x85, 0x8c, 0x74, 0x36, 0x3e, 0x7e, 0xfb, 0xfd, 0x75, 0x6e, 0x15, 0x99, 0x58,
-	0x7e, 0x01, 0xb2, 0xfd, 0xea, 0xe3, 0x40, 0x3e, 0xe4, 0xd3, 0xee, 0x20, 0x7b, 0x82, 0xd1, 0x48,
-	0x63, 0xad, 0xe2, 0xd4, 0x7d, 0x15, 0x63, 0x89, 0x18, 0x13, 0x21, 0x3d, 0x46, 0x74, 0xac, 0x07,
-	0x77, 0xb3, 0x7b, 0x46, 0xce, 0x64, 0xa3, 0xd1, 0xca, 0x58, 0x4f, 0x66, 0x6a, 0x54, 0xe0, 0xa6,
-	0x08, 0x7c, 0x8c, 0x0a, 0x7a, 0x60, 0x74, 0xcc, 0xf1, 0x85, 0xe8, 0xd8, 0x25, 0xfa, 0x6c, 0xc0,
-	0x7d, 0xed, 0x96, 0xd1, 0xd6, 0x34, 0xe7, 0xb1, 0xfa, 0x58, 0xe5, 0xdb, 0x85, 0x8a, 0xa3, 0x2c,
-	0x38, 0x1c, 0x54, 0x1a, 0x1b, 0x5c, 0xe9, 0xf0, 0x85, 0xba, 0xe1, 0x4b, 0x74, 0x0e, 0x4b, 0x7f,
-	0x1b, 0x81, 0x26, 0xcd, 0x38, 0x5e, 0x24, 0xeb, 0xe9, 0x6c, 0x91, 0x22, 0x28, 0x0a, 0x82, 0x02,
-	0x5a, 0xd3, 0x09, 0x78, 0x26, 0xac, 0x35, 0xae, 0x06, 0xb6, 0x71, 0x3d, 0xb0, 0x8d, 0x5f, 0x03,
-	0xdb, 0xf8, 0x32, 0xb4, 0x73, 0xd7, 0x43, 0x3b, 0xf7, 0x7d, 0x68, 0xe7, 0x8e, 0xb6, 0xc3, 0x36,
-	0x7f, 0xdf, 0x6d, 0xba, 0x3e, 0xed, 0xe0, 0x7a, 0x7a, 0x78, 0x7b, 0x2f, 0xe0, 0xe7, 0x34, 0x39,
-	0x91, 0x2b, 0xdc, 0x7b, 0x8b, 0x3f, 0x48, 0xb3, 0x7e, 0x1c, 0xb0, 0xe6, 0xa2, 0xf8, 0xcf, 0x7c,
-	0xfd, 0x27, 0x00, 0x00, 0xff, 0xff, 0xf4, 0x10, 0xe7, 0x23, 0xf0, 0x05, 0x00, 0x00,
+	// 885 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0xc7, 0xd7, 0xdd, 0x1f, 0x69, 0x5f, 0x5a, 0x89, 0x1d, 0xbc, 0x9b, 0xc4, 0x6d, 0xbd, 0xa9,
+	0x81, 0x6d, 0xa0, 0xad, 0x47, 0x09, 0x42, 0xaa, 0xb8, 0x91, 0xa0, 0x2c, 0xbd, 0x54, 0xc5, 0xdd,
+	0x53, 0x2f, 0x2b, 0xc7, 0x99, 0xb8, 0x56, 0x37, 0x1e, 0xd7, 0x33, 0x49, 0x89, 0xa2, 0x70, 0xe0,
+	0x86, 0xc4, 0x01, 0x09, 0x89, 0xff, 0x81, 0xff, 0xa4, 0xc7, 0x4a, 0x5c, 0x38, 0x01, 0xda, 0xed,
+	0x1f, 0x82, 0x3c, 0x33, 0x4e, 0xe2, 0xc4, 0x49, 0xa0, 0xf4, 0x96, 0x99, 0xf9, 0xce, 0x7b, 0x9f,
+	0x37, 0xf3, 0xe6, 0xeb, 0x40, 0xd9, 0x73, 0x43, 0x4e, 0xb1, 0xc7, 0x62, 0x3c, 0xac, 0xe3, 0x97,
+	0x03, 0x12, 0x8f, 0xec, 0x28, 0xa6, 0x9c, 0xa2, 0xeb, 0x62, 0xc5, 0xf6, 0x58, 0x6c, 0x0f, 0xeb,
+	0x86, 0xee, 0x53, 0x9f, 0x8a, 0x05, 0x9c, 0xfc, 0x92, 0x1a, 0xe3, 0x96, 0x4f, 0xa9, 0x7f, 0x4e,
+	0xb0, 0x1b, 0x05, 0xd8, 0x0d, 0x43, 0xca, 0x5d, 0x1e, 0xd0, 0x90, 0xa9, 0xd5, 0xcf, 0x3c, 0xca,
+	0xfa, 0x94, 0xe1, 0x8e, 0xcb, 0x88, 0x0c, 0x8d, 0x87, 0xf5, 0x0e, 0xe1, 0x6e, 0x1d, 0x47, 0xae,
+	0x1f, 0x84, 0x42, 0xac, 0xb4, 0x95, 0x0c, 0x47, 0xe4, 0xc6, 0x6e, 0x3f, 0x0d, 0x73, 0x98, 0x59,
+	0x4a, 0x78, 0xc4, 0xbc, 0xa5, 0x03, 0xfa, 0x36, 0x09, 0xfa, 0x44, 0x88, 0x1d, 0xf2, 0x72, 0x40,
+	0x18, 0xb7, 0x1e, 0xc1, 0x87, 0x99, 0x59, 0x16, 0xd1, 0x90, 0x11, 0xd4, 0x80, 0x3d, 0x19, 0xb4,
+	0xac, 0x55, 0xb5, 0x5a, 0xb1, 0xa1, 0xdb, 0xf3, 0xe5, 0xd9, 0x52, 0xdd, 0xdc, 0x79, 0xfd, 0xe7,
+	0xd1, 0x96, 0xa3, 0x94, 0xd6, 0x33, 0xf8, 0x40, 0x84, 0x6a, 0x3d, 0x75, 0xd2, 0xf0, 0xa8, 0x0d,
+	0x30, 0x63, 0x57, 0xb1, 0x8e, 0x6d, 0x59, 0xa8, 0x9d, 0x14, 0x6a, 0xcb, 0x33, 0x54, 0x85, 0xda,
+	0x4f, 0x5c, 0x9f, 0xa8, 0xbd, 0xce, 0xdc, 0x4e, 0xeb, 0x47, 0x0d, 0xf6, 0xe7, 0x82, 0x2b, 0xca,
+	0x7b, 0xb0, 0xe3, 0xb1, 0x38, 0x61, 0xdc, 0xae, 0x15, 0x1b, 0xfb, 0x59, 0xc6, 0xd6, 0x53, 0x47,
+	0x01, 0x0a, 0x11, 0x3a, 0xc9, 0xa0, 0x5c, 0x11, 0x28, 0x77, 0x37, 0xa2, 0xc8, 0x4c, 0x19, 0x96,
+	0xfb, 0xa0, 0xa7, 0x28, 0xcd, 0xd1, 0xe3, 0xf6, 0x69, 0x5a, 0xab, 0x0e, 0xbb, 0x61, 0x8f, 0x3f,
+	0xea, 0x8a, 0x32, 0x77, 0x1c, 0x39, 0xb0, 0x9a, 0x70, 0xb0, 0xa0, 0x56, 0xf0, 0x9f, 0xc2, 0xb6,
+	0xc7, 0x62, 0x75, 0x26, 0x2b, 0xd9, 0x13, 0x8d, 0xf5, 0x05, 0x54, 0x66, 0x31, 0x5a, 0x34, 0xe4,
+	0xb1, 0xeb, 0xf1, 0x34, 0x6d, 0x19, 0x0a, 0x6e, 0xb7, 0x1b, 0x13, 0x26, 0xef, 0xea, 0x9a, 0x93,
+	0x0e, 0xad, 0x13, 0x30, 0xf2, 0xb6, 0xfd, 0xf7, 0xfc, 0x25, 0x55, 0xc3, 0xe9, 0x20, 0x0e, 0x19,
+	0x0f, 0xce, 0xd3, 0x2b, 0xb2, 0x1a, 0x70, 0xb8, 0xb8, 0xa0, 0xa2, 0xaf, 0xa6, 0xfa, 0x1e, 0x6e,
+	0xa7, 0x54, 0x0e, 0x19, 0x92, 0x70, 0x40, 0xbe, 0x09, 0x18, 0xa7, 0xf1, 0x28, 0x2d, 0xe8, 0x00,
+	0xf6, 0xc2, 0x1e, 0x3f, 0x0b, 0xb2, 0x07, 0x89, 0xda, 0x39, 0xf7, 0xf7, 0x2e, 0xad, 0xf4, 0x97,
+	0x06, 0xe6, 0x2a, 0x00, 0x05, 0xff, 0x25, 0x5c, 0x8d, 0xe5, 0x4a, 0xda, 0x5b, 0xe5, 0xa5, 0xf3,
+	0x51, 0x5b, 0xd5, 0x31, 0x4d, 0xf5, 0xe8, 0x21, 0x14, 0xbc, 0x41, 0x1c, 0x93, 0x90, 0x2b, 0xc6,
+	0x4d, 0x5b, 0x53, 0xf9, 0x42, 0x83, 0x6e, 0xbf, 0x7b, 0x83, 0x7e, 0xa5, 0xde, 0xf4, 0x29, 0x8d,
+	0xe6, 0xdf, 0xa2, 0x0e, 0xbb, 0x24, 0xa2, 0xde, 0x73, 0x71, 0xac, 0xdb, 0x8e, 0x1c, 0x24, 0xb3,
+	0xe7, 0x41, 0x3f, 0x90, 0xb4, 0x37, 0x1c, 0x39, 0xb0, 0x1c, 0xd0, 0xb3, 0x21, 0xfe, 0xff, 0xc9,
+	0x58, 0x2e, 0x94, 0xa4, 0xd5, 0x90, 0xb0, 0x1b, 0x84, 0x7e, 0x9b, 0x90, 0xf7, 0x6e, 0x13, 0xbf,
+	0x69, 0x50, 0x5e, 0xce, 0xa1, 0xd8, 0xbf, 0x86, 0xeb, 0x91, 0x9c, 0x3e, 0xeb, 0x91, 0x29, 0xff,
+	0xcd, 0x25, 0xfe, 0xd9, 0x5e, 0x55, 0x42, 0x31, 0x9a, 0x45, 0x7b, 0x6f, 0x36, 0xd2, 0x78, 0x5b,
+	0x80, 0x5d, 0xc1, 0x8a, 0x5e, 0xc0, 0x9e, 0x34, 0x54, 0x54, 0xcd, 0xc2, 0x2c, 0xfb, 0xb5, 0x71,
+	0x67, 0x8d, 0x42, 0x26, 0xb1, 0x6e, 0xfd, 0xf0, 0xfb, 0xdb, 0x5f, 0xae, 0x1c, 0x22, 0x1d, 0xcb,
+	0x2f, 0x81, 0xfc, 0x0a, 0xa8, 0x8f, 0x04, 0xf2, 0x60, 0x27, 0xb9, 0x51, 0x64, 0xe6, 0x04, 0x9a,
+	0xeb, 0x16, 0xe3, 0x68, 0xe5, 0xba, 0x4a, 0x63, 0x88, 0x34, 0x3a, 0x42, 0xd9, 0x34, 0xc2, 0x6b,
+	0x87, 0x70, 0x35, 0xf5, 0x3b, 0x64, 0xe5, 0x07, 0x9a, 0xb7, 0x4e, 0xe3, 0xa3, 0xb5, 0x1a, 0x95,
+	0xf0, 0x8e, 0x48, 0x78, 0x13, 0x55, 0xb2, 0x09, 0xc3, 0x1e, 0xc7, 0x63, 0x61, 0x11, 0x13, 0xf4,
+	0x93, 0x06, 0x37, 0x32, 0x6e, 0x87, 0xee, 0xae, 0x8a, 0xbc, 0x60, 0xa3, 0x46, 0x6d, 0xb3, 0x50,
+	0x71, 0xd4, 0x04, 0x87, 0x85, 0xaa, 0x0b, 0x85, 0x2b, 0x1d, 0x1e, 0x2b, 0xa7, 0x9b, 0xa0, 0x57,
+	0x70, 0x6d, 0xea, 0x8c, 0x28, 0xaf, 0xc6, 0x45, 0x43, 0x35, 0x3e, 0x5e, 0x2f, 0x52, 0x04, 0x47,
+	0x82, 0xa0, 0x82, 0x4a, 0x59, 0x02, 0x3e, 0xcd, 0xf5, 0xab, 0x06, 0xfb, 0x4b, 0xf6, 0x86, 0xee,
+	0xe5, 0x97, 0x98, 0xeb, 0xc2, 0xc6, 0xfd, 0x7f, 0x27, 0x56, 0x44, 0xc7, 0x82, 0xa8, 0x8a, 0xcc,
+	0x2c, 0x91, 0x7a, 0xfb, 0xe2, 0x7e, 0xce, 0x82, 0xee, 0x04, 0x31, 0x28, 0x28, 0x4b, 0x41, 0x79,
+	0x9d, 0x9c, 0x75, 0x2c, 0xc3, 0x5a, 0x27, 0x59, 0xdf, 0x15, 0x9c, 0x46, 0x78, 0x2c, 0x1c, 0x6e,
+	0x82, 0xc6, 0x50, 0x9c, 0xf3, 0x03, 0xf4, 0x49, 0xde, 0x13, 0x5a, 0xf2, 0x24, 0xe3, 0x78, 0x93,
+	0x4c, 0x01, 0xdc, 0x16, 0x00, 0x25, 0x74, 0xb0, 0xf0, 0xdc, 0xa4, 0xb4, 0x79, 0xf2, 0xfa, 0xc2,
+	0xd4, 0xde, 0x5c, 0x98, 0xda, 0xdf, 0x17, 0xa6, 0xf6, 0xf3, 0xa5, 0xb9, 0xf5, 0xe6, 0xd2, 0xdc,
+	0xfa, 0xe3, 0xd2, 0xdc, 0x7a, 0xf6, 0xc0, 0x0f, 0xf8, 0xf3, 0x41, 0xc7, 0xf6, 0x68, 0x1f, 0xb7,
+	0x92, 0xad, 0x0f, 0x1e, 0x13, 0xfe, 0x8a, 0xc6, 0x2f, 0xe4, 0x08, 0x0f, 0x1f, 0xe2, 0xef, 0x64,
+	0x2d, 0xa3, 0x88, 0xb0, 0xce, 0x9e, 0xf8, 0x1b, 0xf7, 0xf9, 0x3f, 0x03, 0x00, 0x82, 0x06, 0xeb,
+	0x03, 0x83, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -560,6 +907,12 @@ type QueryClient interface {
 	CSRByContract(ctx context.Context, in *QueryCSRByContractRequest, opts ...grpc.CallOption) (*QueryCSRByContractResponse, error)
 	// query the turnstile address
 	Turnstile(ctx context.Context, in *QueryTurnstileRequest, opts ...grpc.CallOption) (*QueryTurnstileResponse, error)
+	// query the revenue of a CSR NFT in past day epochs
+	CSRRevenueHistory(ctx context.Context, in *QueryCSRRevenueHistoryRequest, opts ...grpc.CallOption) (*QueryCSRRevenueHistoryResponse, error)
+	// query the CSR NFTs that earned the most revenue in a day epoch
+	TopCSRs(ctx context.Context, in *QueryTopCSRsRequest, opts ...grpc.CallOption) (*QueryTopCSRsResponse, error)
+	// query the csr fees not yet distributed to the Turnstile
+	PendingFees(ctx context.Context, in *QueryPendingFeesRequest, opts ...grpc.CallOption) (*QueryPendingFeesResponse, error)
 }
 
 type queryClient struct {
@@ -615,6 +968,33 @@ func (c *queryClient) Turnstile(ctx context.Context, in *QueryTurnstileRequest,
 	return out, nil
 }
 
+func (c *queryClient) CSRRevenueHistory(ctx context.Context, in *QueryCSRRevenueHistoryRequest, opts ...grpc.CallOption) (*QueryCSRRevenueHistoryResponse, error) {
+	out := new(QueryCSRRevenueHistoryResponse)
+	err := c.cc.Invoke(ctx, "/canto.csr.v1.Query/CSRRevenueHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TopCSRs(ctx context.Context, in *QueryTopCSRsRequest, opts ...grpc.CallOption) (*QueryTopCSRsResponse, error) {
+	out := new(QueryTopCSRsResponse)
+	err := c.cc.Invoke(ctx, "/canto.csr.v1.Query/TopCSRs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingFees(ctx context.Context, in *QueryPendingFeesRequest, opts ...grpc.CallOption) (*QueryPendingFeesResponse, error) {
+	out := new(QueryPendingFeesResponse)
+	err := c.cc.Invoke(ctx, "/canto.csr.v1.Query/PendingFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -627,6 +1007,12 @@ type QueryServer interface {
 	CSRByContract(context.Context, *QueryCSRByContractRequest) (*QueryCSRByContractResponse, error)
 	// query the turnstile address
 	Turnstile(context.Context, *QueryTurnstileRequest) (*QueryTurnstileResponse, error)
+	// query the revenue of a CSR NFT in past day epochs
+	CSRRevenueHistory(context.Context, *QueryCSRRevenueHistoryRequest) (*QueryCSRRevenueHistoryResponse, error)
+	// query the CSR NFTs that earned the most revenue in a day epoch
+	TopCSRs(context.Context, *QueryTopCSRsRequest) (*QueryTopCSRsResponse, error)
+	// query the csr fees not yet distributed to the Turnstile
+	PendingFees(context.Context, *QueryPendingFeesRequest) (*QueryPendingFeesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -648,6 +1034,15 @@ func (*UnimplementedQueryServer) CSRByContract(ctx context.Context, req *QueryCS
 func (*UnimplementedQueryServer) Turnstile(ctx context.Context, req *QueryTurnstileRequest) (*QueryTurnstileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Turnstile not implemented")
 }
+func (*UnimplementedQueryServer) CSRRevenueHistory(ctx context.Context, req *QueryCSRRevenueHistoryRequest) (*QueryCSRRevenueHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CSRRevenueHistory not implemented")
+}
+func (*UnimplementedQueryServer) TopCSRs(ctx context.Context, req *QueryTopCSRsRequest) (*QueryTopCSRsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopCSRs not implemented")
+}
+func (*UnimplementedQueryServer) PendingFees(ctx context.Context, req *QueryPendingFeesRequest) (*QueryPendingFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingFees not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -743,6 +1138,60 @@ func _Query_Turnstile_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CSRRevenueHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCSRRevenueHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CSRRevenueHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/canto.csr.v1.Query/CSRRevenueHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CSRRevenueHistory(ctx, req.(*QueryCSRRevenueHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TopCSRs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopCSRsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TopCSRs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/canto.csr.v1.Query/TopCSRs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TopCSRs(ctx, req.(*QueryTopCSRsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/canto.csr.v1.Query/PendingFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingFees(ctx, req.(*QueryPendingFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "canto.csr.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -767,9 +1216,21 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Turnstile",
 			Handler:    _Query_Turnstile_Handler,
 		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "canto/csr/v1/query.proto",
+		{
+			MethodName: "CSRRevenueHistory",
+			Handler:    _Query_CSRRevenueHistory_Handler,
+		},
+		{
+			MethodName: "TopCSRs",
+			Handler:    _Query_TopCSRs_Handler,
+		},
+		{
+			MethodName: "PendingFees",
+			Handler:    _Query_PendingFees_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "canto/csr/v1/query.proto",
 }
 
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
@@ -1089,6 +1550,259 @@ func (m *QueryTurnstileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCSRRevenueHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCSRRevenueHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCSRRevenueHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.NftId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NftId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCSRRevenueHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCSRRevenueHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCSRRevenueHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Current.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Revenues) > 0 {
+		for iNdEx := len(m.Revenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopCSRsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopCSRsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopCSRsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopCSRsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopCSRsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopCSRsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revenues) > 0 {
+		for iNdEx := len(m.Revenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingFees) > 0 {
+		for iNdEx := len(m.PendingFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1221,23 +1935,122 @@ func (m *QueryTurnstileResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryCSRRevenueHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NftId != 0 {
+		n += 1 + sovQuery(uint64(m.NftId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
+
+func (m *QueryCSRRevenueHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Revenues) > 0 {
+		for _, e := range m.Revenues {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Current.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTopCSRsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryTopCSRsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Revenues) > 0 {
+		for _, e := range m.Revenues {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingFees) > 0 {
+		for _, e := range m.PendingFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
@@ -1253,7 +2066,663 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCSRsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCSRsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCSRsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCSRsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCSRsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCSRsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Csrs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Csrs = append(m.Csrs, CSR{})
+			if err := m.Csrs[len(m.Csrs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCSRByNFTRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCSRByNFTRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCSRByNFTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			m.NftId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NftId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCSRByNFTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCSRByNFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCSRByNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Csr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Csr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCSRByContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCSRByContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCSRByContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCSRByContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCSRByContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCSRByContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Csr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Csr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTurnstileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTurnstileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTurnstileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1277,7 +2746,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTurnstileResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1300,17 +2769,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTurnstileResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTurnstileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1320,24 +2789,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1360,7 +2828,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCSRsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCSRRevenueHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1383,13 +2851,32 @@ func (m *QueryCSRsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCSRsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCSRRevenueHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCSRsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCSRRevenueHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			m.NftId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NftId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1446,7 +2933,7 @@ func (m *QueryCSRsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCSRsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCSRRevenueHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1469,15 +2956,15 @@ func (m *QueryCSRsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCSRsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCSRRevenueHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCSRsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCSRRevenueHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Csrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenues", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1504,12 +2991,45 @@ func (m *QueryCSRsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Csrs = append(m.Csrs, CSR{})
-			if err := m.Csrs[len(m.Csrs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Revenues = append(m.Revenues, CSRRevenue{})
+			if err := m.Revenues[len(m.Revenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1566,7 +3086,7 @@ func (m *QueryCSRsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCSRByNFTRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTopCSRsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1589,17 +3109,17 @@ func (m *QueryCSRByNFTRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCSRByNFTRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopCSRsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCSRByNFTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopCSRsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.NftId = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1609,7 +3129,26 @@ func (m *QueryCSRByNFTRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NftId |= uint64(b&0x7F) << shift
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1635,7 +3174,7 @@ func (m *QueryCSRByNFTRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCSRByNFTResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTopCSRsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1658,15 +3197,15 @@ func (m *QueryCSRByNFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCSRByNFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopCSRsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCSRByNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopCSRsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Csr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenues", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1693,7 +3232,8 @@ func (m *QueryCSRByNFTResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Csr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Revenues = append(m.Revenues, CSRRevenue{})
+			if err := m.Revenues[len(m.Revenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1718,7 +3258,7 @@ func (m *QueryCSRByNFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCSRByContractRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1741,17 +3281,17 @@ func (m *QueryCSRByContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCSRByContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCSRByContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1761,23 +3301,27 @@ func (m *QueryCSRByContractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1800,7 +3344,7 @@ func (m *QueryCSRByContractRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCSRByContractResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1823,15 +3367,15 @@ func (m *QueryCSRByContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCSRByContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCSRByContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Csr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1858,115 +3402,16 @@ func (m *QueryCSRByContractResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Csr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingFees = append(m.PendingFees, CSRPendingFee{})
+			if err := m.PendingFees[len(m.PendingFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTurnstileRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTurnstileRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTurnstileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTurnstileResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTurnstileResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTurnstileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1976,23 +3421,27 @@ func (m *QueryTurnstileResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
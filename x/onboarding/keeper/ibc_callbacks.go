@@ -92,7 +92,13 @@ func (k Keeper) OnRecvPacket(
 	standardCoinBalance := k.bankKeeper.SpendableCoins(ctx, recipient).AmountOf(standardDenom)
 	swappedAmount := sdkmath.ZeroInt()
 
-	if standardCoinBalance.LT(autoSwapThreshold) {
+	// skip the swap if the pool of the transferred coin is paused
+	swapEnabled := true
+	if lptDenom, err := k.coinswapKeeper.GetLptDenomFromDenoms(ctx, transferredCoin.Denom, standardDenom); err == nil {
+		swapEnabled = k.coinswapKeeper.IsSwapEnabled(ctx, lptDenom)
+	}
+
+	if standardCoinBalance.LT(autoSwapThreshold) && swapEnabled {
 		swappedAmount, err = k.coinswapKeeper.TradeInputForExactOutput(ctx, coinswaptypes.Input{Coin: transferredCoin, Address: recipient.String()}, coinswaptypes.Output{Coin: swapCoins, Address: recipient.String()})
 		if err != nil {
 			swappedAmount = sdkmath.ZeroInt()
@@ -318,6 +318,23 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			sdk.NewCoin(uusdcIbcdenom, sdkmath.ZeroInt()),
 			transferAmount,
 		},
+		{
+			"no swap / convert all transferred amount - liquidity pool is paused",
+			func() {
+				err := suite.app.CoinswapKeeper.SetPoolStatus(suite.ctx, "lpt-1", coinswaptypes.POOL_STATUS_SWAPS_PAUSED)
+				suite.Require().NoError(err)
+
+				transferAmount = sdkmath.NewIntWithDecimal(25, 6)
+				transfer := transfertypes.NewFungibleTokenPacketData(denom, transferAmount.String(), secpAddrCosmos, ethsecpAddrcanto, "")
+				bz := transfertypes.ModuleCdc.MustMarshalJSON(&transfer)
+				packet = channeltypes.NewPacket(bz, 100, transfertypes.PortID, sourceChannel, transfertypes.PortID, cantoChannel, timeoutHeight, 0)
+			},
+			true,
+			sdk.NewCoins(sdk.NewCoin("acanto", sdkmath.NewIntWithDecimal(3, 18))),
+			sdk.NewCoin("acanto", sdkmath.NewIntWithDecimal(3, 18)),
+			sdk.NewCoin(uusdcIbcdenom, sdkmath.ZeroInt()),
+			transferAmount,
+		},
 		{
 			"convert fail",
 			func() {
@@ -46,6 +46,8 @@ type Erc20Keeper interface {
 type CoinwapKeeper interface {
 	TradeInputForExactOutput(ctx sdk.Context, input coinswaptypes.Input, output coinswaptypes.Output) (sdkmath.Int, error)
 	GetStandardDenom(ctx sdk.Context) (string, error)
+	GetLptDenomFromDenoms(ctx sdk.Context, denom1, denom2 string) (string, error)
+	IsSwapEnabled(ctx sdk.Context, lptDenom string) bool
 }
 
 // BankKeeper defines the banking keeper that must be fulfilled when